@@ -0,0 +1,63 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"unicode"
+)
+
+// gAccentBase maps the letters which have a diacritic as part of their form,
+// and so have no canonical decomposition, to their base letters
+var gAccentBase = map[rune]rune{
+	'Ø': 'O', 'ø': 'o', // stroke
+	'Đ': 'D', 'đ': 'd',
+	'Ħ': 'H', 'ħ': 'h',
+	'Ł': 'L', 'ł': 'l',
+	'Ŧ': 'T', 'ŧ': 't',
+	'Ƀ': 'B', 'ƀ': 'b',
+	'Ɨ': 'I', 'ɨ': 'i',
+	'Ƶ': 'Z', 'ƶ': 'z',
+	'Ǥ': 'G', 'ǥ': 'g',
+	'Ɍ': 'R', 'ɍ': 'r',
+	'Ɏ': 'Y', 'ɏ': 'y',
+	'Ŀ': 'L', 'ŀ': 'l', // middle dot
+	'Ɓ': 'B', 'ɓ': 'b', // hook
+	'Ƈ': 'C', 'ƈ': 'c',
+	'Ɗ': 'D', 'ɗ': 'd',
+	'Ƒ': 'F', 'ƒ': 'f',
+	'Ɠ': 'G', 'ɠ': 'g',
+	'Ƙ': 'K', 'ƙ': 'k',
+	'Ƥ': 'P', 'ƥ': 'p',
+	'Ƭ': 'T', 'ƭ': 't',
+	'Ȥ': 'Z', 'ȥ': 'z',
+}
+
+// stripAccents removes the nonspacing marks from the decomposed runes given
+// and replaces the letters in gAccentBase with their base letters, reusing
+// the runes slice
+func stripAccents(runes []rune) []rune {
+	out := runes[:0]
+	for _, r := range runes {
+		if r < 0xd8 {
+			// nothing before U+00D8 is an accent or has one
+			out = append(out, r)
+		} else if base, ok := gAccentBase[r]; ok {
+			out = append(out, base)
+		} else if !unicode.Is(unicode.Mn, r) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAccents(t *testing.T) {
+	c.Convey("stripAccents", t, func() {
+		for idx, test := range []struct {
+			input  string
+			output string
+		}{
+			{"resume", "resume"},
+			{"re\u0301sume\u0301", "resume"},
+			{"\u0141o\u0301dz\u0301", "Lodz"},
+			{"\u00f8\u00e6", "o\u00e6"},
+			{"\u0915\u093f", "\u0915\u093f"},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), string(stripAccents([]rune(test.input))), c.ShouldEqual, test.output)
+		}
+	})
+
+	c.Convey("normalizedRune", t, func() {
+		r, size, ok := normalizedRune(AccentFlag, NewInputReader("\u1ec7x"), 0)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(r, c.ShouldEqual, 'e')
+		c.So(size, c.ShouldEqual, 3)
+		r, size, ok = normalizedRune(AccentFlag, NewInputReader([]rune("q\u0301\u0323")), 0)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(r, c.ShouldEqual, 'q')
+		c.So(size, c.ShouldEqual, 3)
+	})
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// gClassEscapes are the single rune backslash escapes of the R spec syntax
+var gClassEscapes = map[rune]rune{
+	'0': 0x00,
+	'a': 0x07,
+	'e': 0x1b,
+	'f': '\f',
+	'n': '\n',
+	'r': '\r',
+	't': '\t',
+	'v': '\v',
+}
+
+// gClassShorthands are the Perl class backslash escapes of the R spec syntax
+var gClassShorthands = map[rune]RuneMatcher{
+	'd': RuneIsDIGIT,
+	's': RuneIsSpace,
+	'w': RuneIsWord,
+}
+
+// ParseClass parses the R character class spec given into a RuneClass, see
+// R for the syntax supported
+//
+// ParseClass returns an error describing the problem and its position when
+// the spec is malformed
+func ParseClass(characters string) (class RuneClass, err error) {
+	var negated bool
+	if class, negated, err = parseClass(characters); err == nil && negated {
+		class = class.Complement()
+	}
+	return
+}
+
+// ParseR is the error returning version of R
+func ParseR(characters string, flags ...string) (m Matcher, err error) {
+	var class RuneClass
+	var negated bool
+	if class, negated, err = parseClass(characters); err == nil {
+		if negated {
+			// a negated spec is matched with the NegatedFlag instead of the
+			// complement of the class so that the negation is applied after
+			// any case or width folding
+			flags = toggleNegated(flags)
+		}
+		m = class.Matcher(flags...)
+	}
+	return
+}
+
+// toggleNegated returns the flags given with the negation flag added, or
+// with all negation flags removed when the flags are already negated
+func toggleNegated(flags []string) (toggled []string) {
+	if _, cfg := ParseFlags(flags...); !cfg.Negated() {
+		return append(flags[:len(flags):len(flags)], "^")
+	}
+	for _, flag := range flags {
+		toggled = append(toggled, strings.ReplaceAll(flag, "^", ""))
+	}
+	return
+}
+
+// parseClass parses the R character class spec given, returning the class
+// without the leading caret negation applied
+func parseClass(characters string) (class RuneClass, negated bool, err error) {
+	p := &cClassSpec{spec: characters, chars: []rune(characters)}
+
+	if len(p.chars) > 1 && p.chars[0] == '^' {
+		// leading caret negates the whole class
+		negated = true
+		p.pos = 1
+	}
+
+	if p.pos < len(p.chars) && p.chars[p.pos] == '-' {
+		// first dash is literal dash
+		class = append(class, RuneRange{Lo: '-', Hi: '-'})
+		p.pos += 1
+	}
+
+	for p.pos < len(p.chars) {
+		start := p.pos
+		var lo rune
+		var set RuneClass
+		if lo, set, err = p.term(); err != nil {
+			return nil, false, err
+		}
+
+		if p.pos+1 < len(p.chars) && p.chars[p.pos] == '-' {
+			// range requires a total of three, the low and high terms and a
+			// dash separator, a trailing dash is a literal dash
+			p.pos += 1
+			var hi rune
+			var other RuneClass
+			if hi, other, err = p.term(); err != nil {
+				return nil, false, err
+			} else if set != nil || other != nil {
+				return nil, false, p.errorf(start, "invalid range, classes cannot be range endpoints")
+			}
+			if lo > hi {
+				// the low rune is greater than the high rune
+				// allow these mistakes? hmm...
+				lo, hi = hi, lo
+			}
+			class = append(class, RuneRange{Lo: lo, Hi: hi})
+			continue
+		}
+
+		if set != nil {
+			class = append(class, set...)
+		} else {
+			class = append(class, RuneRange{Lo: lo, Hi: lo})
+		}
+	}
+
+	return class.normalize(), negated, nil
+}
+
+// cClassSpec is the state of parsing an R spec
+type cClassSpec struct {
+	spec  string
+	chars []rune
+	pos   int
+}
+
+func (p *cClassSpec) errorf(pos int, format string, argv ...interface{}) error {
+	return fmt.Errorf("invalid class spec %q at rune %d: %s", p.spec, pos, fmt.Sprintf(format, argv...))
+}
+
+// term parses the next rune or class of the spec, returning either the rune
+// or the non-nil class
+func (p *cClassSpec) term() (r rune, set RuneClass, err error) {
+	start := p.pos
+	switch r = p.chars[p.pos]; r {
+
+	case '[':
+		if name, size, ok := p.posixName(); ok {
+			matcher, found := LookupAsciiClass[AsciiNames(name)]
+			if !found {
+				return 0, nil, p.errorf(start, "unknown POSIX class %q, valid names are: %q", name, mapKeys(LookupAsciiClass))
+			}
+			p.pos += size
+			return 0, classOfAsciiMatcher(matcher), nil
+		}
+		// not a POSIX bracket expression, just a literal square bracket
+
+	case '\\':
+		p.pos += 1
+		if p.pos >= len(p.chars) {
+			return 0, nil, p.errorf(start, "trailing backslash")
+		}
+		return p.escape(start)
+	}
+
+	p.pos += 1
+	return r, nil, nil
+}
+
+// posixName returns the name and size of the [:name:] expression at the
+// current position
+func (p *cClassSpec) posixName() (name string, size int, ok bool) {
+	if p.pos+1 >= len(p.chars) || p.chars[p.pos+1] != ':' {
+		return
+	}
+	for end := p.pos + 2; end+1 < len(p.chars); end++ {
+		if p.chars[end] == ':' && p.chars[end+1] == ']' {
+			return string(p.chars[p.pos+2 : end]), end + 2 - p.pos, true
+		}
+	}
+	return
+}
+
+// escape parses the backslash escape following the backslash at the start
+// position given
+func (p *cClassSpec) escape(start int) (r rune, set RuneClass, err error) {
+	this := p.chars[p.pos]
+	p.pos += 1
+
+	if escaped, ok := gClassEscapes[this]; ok {
+		return escaped, nil, nil
+	} else if matcher, ok := gClassShorthands[this]; ok {
+		return 0, classOfAsciiMatcher(matcher), nil
+	} else if matcher, ok = gClassShorthands[unicode.ToLower(this)]; ok {
+		return 0, classOfAsciiMatcher(matcher).Complement(), nil
+	}
+
+	switch this {
+
+	case 'x':
+		if p.pos < len(p.chars) && p.chars[p.pos] == '{' {
+			// \x{1F600}
+			for end := p.pos + 1; end < len(p.chars); end++ {
+				if p.chars[end] == '}' {
+					digits := string(p.chars[p.pos+1 : end])
+					p.pos = end + 1
+					return p.hex(start, digits)
+				}
+			}
+			return 0, nil, p.errorf(start, "missing closing brace of \\x{...}")
+		}
+		// \xHH
+		return p.fixedHex(start, 2)
+
+	case 'u':
+		// \uHHHH
+		return p.fixedHex(start, 4)
+
+	case 'p', 'P':
+		var name string
+		if p.pos < len(p.chars) && p.chars[p.pos] == '{' {
+			// \p{Greek}
+			for end := p.pos + 1; end < len(p.chars) && name == ""; end++ {
+				if p.chars[end] == '}' {
+					name = string(p.chars[p.pos+1 : end])
+					p.pos = end + 1
+				}
+			}
+			if name == "" {
+				return 0, nil, p.errorf(start, "missing or empty name of \\%c{...}", this)
+			}
+		} else if p.pos < len(p.chars) {
+			// \pL
+			name = string(p.chars[p.pos])
+			p.pos += 1
+		} else {
+			return 0, nil, p.errorf(start, "missing name of \\%c", this)
+		}
+		table, ee := LookupUnicodeProperty(name)
+		if ee != nil {
+			return 0, nil, p.errorf(start, "%v", ee)
+		}
+		if set = classOfRangeTable(table); this == 'P' {
+			set = set.Complement()
+		}
+		return 0, set, nil
+	}
+
+	if this < utf8.RuneSelf && (RuneIsALNUM(this) || this == ' ') {
+		return 0, nil, p.errorf(start, "unknown escape \\%c", this)
+	}
+
+	// any other escaped rune is literal, ie: \- \\ \] \^
+	return this, nil, nil
+}
+
+// fixedHex parses the exact number of hexadecimal digits given
+func (p *cClassSpec) fixedHex(start, count int) (r rune, set RuneClass, err error) {
+	if p.pos+count > len(p.chars) {
+		return 0, nil, p.errorf(start, "expected %d hexadecimal digits", count)
+	}
+	digits := string(p.chars[p.pos : p.pos+count])
+	p.pos += count
+	return p.hex(start, digits)
+}
+
+// hex parses the hexadecimal digits given into a valid rune
+func (p *cClassSpec) hex(start int, digits string) (r rune, set RuneClass, err error) {
+	value, ee := strconv.ParseUint(digits, 16, 32)
+	if ee != nil {
+		return 0, nil, p.errorf(start, "invalid hexadecimal digits %q", digits)
+	} else if r = rune(value); !utf8.ValidRune(r) {
+		return 0, nil, p.errorf(start, "invalid rune value %q", digits)
+	}
+	return r, nil, nil
+}
+
+// classOfAsciiMatcher returns the RuneClass of the ASCII-only RuneMatcher
+// given, see classOfRuneMatcher for matchers accepting any rune
+func classOfAsciiMatcher(matcher RuneMatcher) (class RuneClass) {
+	for r := rune(0); r < utf8.RuneSelf; r++ {
+		if !matcher(r) {
+			continue
+		} else if last := len(class) - 1; last >= 0 && class[last].Hi+1 == r {
+			class[last].Hi = r
+		} else {
+			class = append(class, RuneRange{Lo: r, Hi: r})
+		}
+	}
+	return
+}
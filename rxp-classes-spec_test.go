@@ -0,0 +1,118 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+	"unicode"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClassSpec(t *testing.T) {
+	c.Convey("ParseClass", t, func() {
+		for idx, test := range []struct {
+			input  string
+			output string
+		}{
+			{``, `[]`},
+			{`xyza-f`, `[a-fx-z]`},
+			{`c-a`, `[a-c]`},
+			{`-ab`, `[\-ab]`},
+			{`ab-`, `[\-ab]`},
+			{`a\-z`, `[\-az]`},
+			{`^`, `[\^]`},
+			{`a^`, `[\^a]`},
+			{`^a-z`, "[\\x{0}-`{-\\x{10FFFF}]"},
+			{`^-`, `[\x{0}-,.-\x{10FFFF}]`},
+			{`\n\t\r\f\v\a\e\0`, `[\x{0}\x{7}\x{9}-\x{D}\x{1B}]`},
+			{`\x41B\x{1F600}`, `[AB😀]`},
+			{`\x{41}-\x{43}`, `[A-C]`},
+			{`\d`, `[0-9]`},
+			{`\w`, `[0-9A-Z_a-z]`},
+			{`\s`, `[\x{9}\x{A}\x{C}\x{D} ]`},
+			{`\d_.`, `[.0-9_]`},
+			{`[:xdigit:]`, `[0-9A-Fa-f]`},
+			{`[:digit:]x`, `[0-9x]`},
+			{`[:alpha`, `[:\[ahlp]`},
+			{`[xyz]`, `[\[\]x-z]`},
+			{`\p{Greek}\P{Greek}`, `[\x{0}-\x{10FFFF}]`},
+			{`\pN`, Union(unicode.N).String()},
+			{`\]\\\^é`, `[\\-\^é]`},
+		} {
+			class, err := ParseClass(test.input)
+			c.SoMsg(fmt.Sprintf("test #%d - %q (error)", idx, test.input), err, c.ShouldBeNil)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), class.String(), c.ShouldEqual, test.output)
+		}
+	})
+
+	c.Convey("negated shorthands", t, func() {
+		for idx, test := range []struct {
+			input  string
+			output string
+		}{
+			{`\D`, `\d`},
+			{`\S`, `\s`},
+			{`\W`, `\w`},
+			{`\P{Greek}`, `\p{Greek}`},
+			{`^\d`, `\d`},
+		} {
+			class, err := ParseClass(test.input)
+			c.SoMsg(fmt.Sprintf("test #%d - %q (error)", idx, test.input), err, c.ShouldBeNil)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), class.String(), c.ShouldEqual, Complement(test.output).String())
+		}
+	})
+
+	c.Convey("errors", t, func() {
+		for idx, test := range []struct {
+			input string
+			error string
+		}{
+			{`a\`, `invalid class spec "a\\" at rune 1: trailing backslash`},
+			{`\q`, `invalid class spec "\\q" at rune 0: unknown escape \q`},
+			{`\1`, `invalid class spec "\\1" at rune 0: unknown escape \1`},
+			{`\xZZ`, `invalid class spec "\\xZZ" at rune 0: invalid hexadecimal digits "ZZ"`},
+			{`\x4`, `invalid class spec "\\x4" at rune 0: expected 2 hexadecimal digits`},
+			{`\u12`, `invalid class spec "\\u12" at rune 0: expected 4 hexadecimal digits`},
+			{`\x{1F600`, `invalid class spec "\\x{1F600" at rune 0: missing closing brace of \x{...}`},
+			{`\x{}`, `invalid class spec "\\x{}" at rune 0: invalid hexadecimal digits ""`},
+			{`\x{110000}`, `invalid class spec "\\x{110000}" at rune 0: invalid rune value "110000"`},
+			{`\x{D800}`, `invalid class spec "\\x{D800}" at rune 0: invalid rune value "D800"`},
+			{`\p{}`, `invalid class spec "\\p{}" at rune 0: missing or empty name of \p{...}`},
+			{`\p`, `invalid class spec "\\p" at rune 0: missing name of \p`},
+			{`a\d-z`, `invalid class spec "a\\d-z" at rune 1: invalid range, classes cannot be range endpoints`},
+			{`a-[:digit:]`, `invalid class spec "a-[:digit:]" at rune 0: invalid range, classes cannot be range endpoints`},
+		} {
+			_, err := ParseClass(test.input)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), err, c.ShouldNotBeNil)
+			if err != nil {
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), err.Error(), c.ShouldEqual, test.error)
+			}
+		}
+
+		_, err := ParseClass(`[:nope:]`)
+		c.So(err, c.ShouldNotBeNil)
+		c.So(err.Error(), c.ShouldStartWith, `invalid class spec "[:nope:]" at rune 0: unknown POSIX class "nope", valid names are:`)
+		_, err = ParseClass(`\p{Nope}`)
+		c.So(err, c.ShouldNotBeNil)
+		c.So(err.Error(), c.ShouldStartWith, `invalid class spec "\\p{Nope}" at rune 0: unknown Unicode property: "Nope"`)
+
+		m, err := ParseR(`\q`)
+		c.So(m, c.ShouldBeNil)
+		c.So(err, c.ShouldNotBeNil)
+		c.So(func() { R(`\q`) }, c.ShouldPanic)
+	})
+}
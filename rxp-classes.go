@@ -0,0 +1,371 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// RuneRange is an inclusive range of runes, from Lo to Hi
+type RuneRange struct {
+	Lo, Hi rune
+}
+
+// RuneClass is a set of runes, stored as a normalized list of RuneRange
+// values which are sorted, do not overlap and are not adjacent to each other
+//
+// RuneClass values are made with the Union, Intersect, Subtract and
+// Complement functions and are turned into a Matcher with the Matcher method
+// or the Pattern.Class builder method. RuneClass values can also be given
+// directly to the options of the compositor Matchers, such as Or and Group
+type RuneClass []RuneRange
+
+// Union returns the RuneClass of all the runes accepted by any one of the
+// operands given
+//
+// Operands can be any of the following:
+//
+//	| Operand             | Description                                    |
+//	|---------------------|------------------------------------------------|
+//	| RuneClass           | an existing rune class                         |
+//	| RuneRange           | an inclusive range of runes                    |
+//	| rune                | a single rune                                  |
+//	| string              | a character class spec, as accepted by R       |
+//	| *unicode.RangeTable | a unicode range table, such as unicode.Greek   |
+//	| RuneMatcher         | any RuneMatcher, tested with every rune (slow) |
+//	| func(rune) bool     | same as RuneMatcher                            |
+//
+// Union will panic if given any other type of operand
+func Union(operands ...interface{}) (class RuneClass) {
+	for idx, operand := range operands {
+		class = append(class, newRuneClass(idx, operand)...)
+	}
+	return class.normalize()
+}
+
+// Intersect returns the RuneClass of the runes accepted by all the operands
+// given, see Union for the list of operand types supported
+//
+// Intersect will panic if given an invalid operand type
+func Intersect(operands ...interface{}) (class RuneClass) {
+	for idx, operand := range operands {
+		if idx == 0 {
+			class = newRuneClass(idx, operand).normalize()
+			continue
+		}
+		class = class.Intersect(newRuneClass(idx, operand))
+	}
+	return
+}
+
+// Subtract returns the RuneClass of the runes accepted by the base operand
+// and not by any of the other operands, see Union for the list of operand
+// types supported
+//
+// Subtract will panic if given an invalid operand type
+func Subtract(base interface{}, operands ...interface{}) (class RuneClass) {
+	class = newRuneClass(0, base).normalize()
+	for idx, operand := range operands {
+		class = class.Subtract(newRuneClass(idx+1, operand))
+	}
+	return
+}
+
+// Complement returns the RuneClass of all the runes not accepted by the
+// operand given, see Union for the list of operand types supported
+//
+// Complement will panic if given an invalid operand type
+func Complement(operand interface{}) RuneClass {
+	return newRuneClass(0, operand).Complement()
+}
+
+// newRuneClass returns the (not normalized) RuneClass of the operand given
+func newRuneClass(idx int, operand interface{}) RuneClass {
+	switch t := operand.(type) {
+	case RuneClass:
+		return t
+	case RuneRange:
+		if t.Lo > t.Hi {
+			t.Lo, t.Hi = t.Hi, t.Lo
+		}
+		return RuneClass{t}
+	case rune:
+		return RuneClass{{Lo: t, Hi: t}}
+	case string:
+		class, err := ParseClass(t)
+		if err != nil {
+			panic(err)
+		}
+		return class
+	case *unicode.RangeTable:
+		return classOfRangeTable(t)
+	case RuneMatcher:
+		return classOfRuneMatcher(t)
+	case func(r rune) bool:
+		return classOfRuneMatcher(t)
+	}
+	panic(fmt.Errorf("invalid class operand #%d: %#+v", idx, operand))
+}
+
+// classOfRangeTable returns the RuneClass of the unicode.RangeTable given
+func classOfRangeTable(table *unicode.RangeTable) (class RuneClass) {
+	if table == nil {
+		return
+	}
+	for _, r16 := range table.R16 {
+		if r16.Stride == 1 {
+			class = append(class, RuneRange{Lo: rune(r16.Lo), Hi: rune(r16.Hi)})
+			continue
+		}
+		for r := rune(r16.Lo); r <= rune(r16.Hi); r += rune(r16.Stride) {
+			class = append(class, RuneRange{Lo: r, Hi: r})
+		}
+	}
+	for _, r32 := range table.R32 {
+		if r32.Stride == 1 {
+			class = append(class, RuneRange{Lo: rune(r32.Lo), Hi: rune(r32.Hi)})
+			continue
+		}
+		for r := rune(r32.Lo); r <= rune(r32.Hi); r += rune(r32.Stride) {
+			class = append(class, RuneRange{Lo: r, Hi: r})
+		}
+	}
+	return class.normalize()
+}
+
+// classOfRuneMatcher returns the RuneClass of the RuneMatcher given, by
+// testing every rune from zero to unicode.MaxRune
+func classOfRuneMatcher(matcher RuneMatcher) (class RuneClass) {
+	lo := rune(-1)
+	for r := rune(0); r <= unicode.MaxRune; r++ {
+		if matcher(r) {
+			if lo < 0 {
+				lo = r
+			}
+		} else if lo >= 0 {
+			class = append(class, RuneRange{Lo: lo, Hi: r - 1})
+			lo = -1
+		}
+	}
+	if lo >= 0 {
+		class = append(class, RuneRange{Lo: lo, Hi: unicode.MaxRune})
+	}
+	return
+}
+
+// normalize sorts and merges the overlapping and adjacent ranges of this
+// RuneClass, returning a new RuneClass
+func (c RuneClass) normalize() (class RuneClass) {
+	if len(c) == 0 {
+		return nil
+	}
+	sorted := make(RuneClass, len(c))
+	copy(sorted, c)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Lo < sorted[j].Lo
+	})
+	for _, rr := range sorted {
+		if last := len(class) - 1; last >= 0 && rr.Lo <= class[last].Hi+1 {
+			// overlapping or adjacent
+			if rr.Hi > class[last].Hi {
+				class[last].Hi = rr.Hi
+			}
+			continue
+		}
+		class = append(class, rr)
+	}
+	return
+}
+
+// Ranges returns a copy of the normalized RuneRange list of this RuneClass
+func (c RuneClass) Ranges() []RuneRange {
+	if len(c) == 0 {
+		return nil
+	}
+	ranges := make([]RuneRange, len(c))
+	copy(ranges, c)
+	return ranges
+}
+
+// Len returns the number of runes in this RuneClass
+func (c RuneClass) Len() (count int) {
+	for _, rr := range c {
+		count += int(rr.Hi-rr.Lo) + 1
+	}
+	return
+}
+
+// Contains returns true if the rune given is a member of this RuneClass,
+// which must be normalized (as all the RuneClass functions and methods return)
+func (c RuneClass) Contains(r rune) bool {
+	lo, hi := 0, len(c)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if rr := c[mid]; r < rr.Lo {
+			hi = mid
+		} else if r > rr.Hi {
+			lo = mid + 1
+		} else {
+			return true
+		}
+	}
+	return false
+}
+
+// Union returns the RuneClass of the runes in this or the other RuneClass
+func (c RuneClass) Union(other RuneClass) RuneClass {
+	return append(RuneClass(c.Ranges()), other...).normalize()
+}
+
+// Intersect returns the RuneClass of the runes in both this and the other
+// RuneClass
+func (c RuneClass) Intersect(other RuneClass) (class RuneClass) {
+	c, other = c.normalize(), other.normalize()
+	for i, j := 0, 0; i < len(c) && j < len(other); {
+		lo, hi := max(c[i].Lo, other[j].Lo), min(c[i].Hi, other[j].Hi)
+		if lo <= hi {
+			class = append(class, RuneRange{Lo: lo, Hi: hi})
+		}
+		if c[i].Hi < other[j].Hi {
+			i += 1
+		} else {
+			j += 1
+		}
+	}
+	return
+}
+
+// Subtract returns the RuneClass of the runes in this RuneClass and not in
+// the other RuneClass
+func (c RuneClass) Subtract(other RuneClass) RuneClass {
+	return c.Intersect(other.normalize().Complement())
+}
+
+// Complement returns the RuneClass of all the runes, from zero to
+// unicode.MaxRune, which are not in this RuneClass
+func (c RuneClass) Complement() (class RuneClass) {
+	next := rune(0)
+	for _, rr := range c.normalize() {
+		if rr.Lo > next {
+			class = append(class, RuneRange{Lo: next, Hi: rr.Lo - 1})
+		}
+		next = rr.Hi + 1
+	}
+	if next <= unicode.MaxRune {
+		class = append(class, RuneRange{Lo: next, Hi: unicode.MaxRune})
+	}
+	return
+}
+
+// RangeTable returns this RuneClass as a new unicode.RangeTable
+func (c RuneClass) RangeTable() (table *unicode.RangeTable) {
+	table = &unicode.RangeTable{}
+	for _, rr := range c {
+		if rr.Hi <= unicode.MaxLatin1 {
+			table.LatinOffset += 1
+		}
+		if rr.Hi <= 0xFFFF {
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(rr.Lo), Hi: uint16(rr.Hi), Stride: 1})
+		} else if rr.Lo > 0xFFFF {
+			table.R32 = append(table.R32, unicode.Range32{Lo: uint32(rr.Lo), Hi: uint32(rr.Hi), Stride: 1})
+		} else {
+			// range crosses the 16-bit boundary
+			table.R16 = append(table.R16, unicode.Range16{Lo: uint16(rr.Lo), Hi: 0xFFFF, Stride: 1})
+			table.R32 = append(table.R32, unicode.Range32{Lo: 0x10000, Hi: uint32(rr.Hi), Stride: 1})
+		}
+	}
+	return
+}
+
+// Matcher returns a new Matcher for the runes in this RuneClass, see
+// WrapMatcher for the flags supported
+func (c RuneClass) Matcher(flags ...string) Matcher {
+	return WrapMatcher(compileRuneClass(c).contains, flags...)
+}
+
+// String returns this RuneClass in the regexp character class notation,
+// for example: [0-9A-Fa-f]
+func (c RuneClass) String() string {
+	var buf strings.Builder
+	buf.WriteByte('[')
+	for _, rr := range c {
+		writeClassRune(&buf, rr.Lo)
+		if rr.Hi > rr.Lo {
+			if rr.Hi > rr.Lo+1 {
+				buf.WriteByte('-')
+			}
+			writeClassRune(&buf, rr.Hi)
+		}
+	}
+	buf.WriteByte(']')
+	return buf.String()
+}
+
+// writeClassRune writes the rune given to the buffer, escaping the class
+// syntax runes and using \x{...} notation for the non-graphic runes
+func writeClassRune(buf *strings.Builder, r rune) {
+	switch {
+	case r == '\\' || r == '-' || r == '[' || r == ']' || r == '^':
+		buf.WriteByte('\\')
+		buf.WriteRune(r)
+	case unicode.IsGraphic(r):
+		buf.WriteRune(r)
+	default:
+		_, _ = fmt.Fprintf(buf, `\x{%X}`, r)
+	}
+}
+
+// cRuneSet is the compiled form of a RuneClass, an ASCII bitmap and the
+// sorted ranges of the runes above ASCII
+type cRuneSet struct {
+	ascii [2]uint64
+	wide  RuneClass
+}
+
+// compileRuneClass returns the cRuneSet of the RuneClass given
+func compileRuneClass(class RuneClass) (set *cRuneSet) {
+	set = &cRuneSet{}
+	for _, rr := range class.normalize() {
+		for r := max(rr.Lo, 0); r <= rr.Hi && r < utf8.RuneSelf; r++ {
+			set.ascii[r>>6] |= 1 << (r & 63)
+		}
+		if rr.Hi >= utf8.RuneSelf {
+			set.wide = append(set.wide, RuneRange{Lo: max(rr.Lo, utf8.RuneSelf), Hi: rr.Hi})
+		}
+	}
+	return
+}
+
+// compileAsciiMatcher returns the compiled version of the ASCII-only
+// RuneMatcher given
+func compileAsciiMatcher(matcher RuneMatcher) RuneMatcher {
+	return compileRuneClass(classOfAsciiMatcher(matcher)).contains
+}
+
+// contains returns true if the rune given is a member of this cRuneSet,
+// using the bitmap for ASCII runes and a binary search of the ranges for
+// all other runes
+func (s *cRuneSet) contains(r rune) bool {
+	if uint32(r) < utf8.RuneSelf {
+		return s.ascii[r>>6]&(1<<(r&63)) != 0
+	} else if len(s.wide) == 0 {
+		return false
+	}
+	return s.wide.Contains(r)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+	"unicode"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestClasses(t *testing.T) {
+	c.Convey("set operations", t, func() {
+		for idx, test := range []struct {
+			class  RuneClass
+			output string
+		}{
+			{Union("a-z", "A-Z", "0-9", '_'), "[0-9A-Z_a-z]"},
+			{Union("c-a", "b-f", "x", RuneRange{Lo: 'z', Hi: 'y'}), "[a-fx-z]"},
+			{Union("a-c", "d-f"), "[a-f]"},
+			{Union(), "[]"},
+			{Intersect("a-z", "m-zA-Z", RuneIsXDIGIT), "[]"},
+			{Intersect("a-z", RuneIsXDIGIT), "[a-f]"},
+			{Intersect(unicode.Greek, unicode.Lu, "ΐ-Σ"), "[Α-ΡΣ]"},
+			{Subtract("a-z", "aeiou"), "[b-df-hj-np-tv-z]"},
+			{Subtract("a-z", "aeiou", "x-z"), "[b-df-hj-np-tvw]"},
+			{Subtract(RuneIsDIGIT, "5"), "[0-46-9]"},
+			{Complement(Complement("a-z")), "[a-z]"},
+			{Complement(Union("\x00-\x7f")), "[\\x{80}-\\x{10FFFF}]"},
+			{Union(`-]\\^[`), "[\\-\\[-\\^]"},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.output), test.class.String(), c.ShouldEqual, test.output)
+		}
+	})
+
+	c.Convey("inspection", t, func() {
+		class := Union("a-f", "0-9", "A-F")
+		c.So(class.Ranges(), c.ShouldEqual, []RuneRange{{'0', '9'}, {'A', 'F'}, {'a', 'f'}})
+		c.So(class.Len(), c.ShouldEqual, 22)
+		c.So(class.Contains('0'), c.ShouldBeTrue)
+		c.So(class.Contains('F'), c.ShouldBeTrue)
+		c.So(class.Contains('g'), c.ShouldBeFalse)
+		c.So(class.Contains(-1), c.ShouldBeFalse)
+		c.So(Complement(class).Len(), c.ShouldEqual, unicode.MaxRune+1-22)
+		c.So(Union(RuneClass{{'x', 'z'}, {'a', 'c'}}).Ranges(), c.ShouldEqual, []RuneRange{{'a', 'c'}, {'x', 'z'}})
+		c.So(Union().Ranges(), c.ShouldBeNil)
+	})
+
+	c.Convey("RangeTable", t, func() {
+		table := Union("a-z", 'é', RuneRange{Lo: 0xfff0, Hi: 0x10010}).RangeTable()
+		for _, r := range []rune{'a', 'z', 'é', 0xfff0, 0xffff, 0x10000, 0x10010} {
+			c.SoMsg(fmt.Sprintf("%q", r), unicode.Is(table, r), c.ShouldBeTrue)
+		}
+		for _, r := range []rune{'A', 'è', 0xffef, 0x10011} {
+			c.SoMsg(fmt.Sprintf("%q", r), unicode.Is(table, r), c.ShouldBeFalse)
+		}
+		c.So(Union(unicode.Greek).Len(), c.ShouldEqual, Union(Union(unicode.Greek).RangeTable()).Len())
+		// LatinOffset counts the R16 entries with Hi <= MaxLatin1
+		c.So(table.LatinOffset, c.ShouldEqual, 2)
+		c.So(Union(RuneRange{Lo: 0x41, Hi: 0x2000}).RangeTable().LatinOffset, c.ShouldEqual, 0)
+		c.So(Union("A-Z", RuneRange{Lo: 0xf0, Hi: 0x100}).RangeTable().LatinOffset, c.ShouldEqual, 1)
+	})
+
+	c.Convey("Matcher", t, func() {
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+			{
+				input:   "hello world",
+				pattern: Pattern{}.Class(Subtract("a-z", "aeiou"), "+", "c"),
+				output:  [][]string{{"h", "h"}, {"ll", "ll"}, {"w", "w"}, {"rld", "rld"}},
+			},
+			{
+				input:   "HELLO",
+				pattern: Pattern{}.Class(Subtract("a-z", "aeiou"), "+", "i", "c"),
+				output:  [][]string{{"H", "H"}, {"LL", "LL"}},
+			},
+			{
+				input:   "ab1",
+				pattern: Pattern{}.Add(Or(Intersect("a-z", RuneIsXDIGIT), "c")),
+				output:  [][]string{{"a", "a"}, {"b", "b"}},
+			},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), test.pattern.FindAllStringSubmatch(test.input, -1), c.ShouldEqual, test.output)
+		}
+	})
+
+	c.Convey("compiled", t, func() {
+		for idx, class := range []RuneClass{
+			nil,
+			Union("a-z"),
+			Union(`\x{0}-\x{7f}`),
+			Union(`?\x{40}-\x{100}`, unicode.Greek),
+			Complement("aeiou"),
+		} {
+			set := compileRuneClass(class)
+			for _, r := range []rune{-1, 0, '?', '@', 'a', 'z', 0x7f, 0x80, 0x100, 0x101, 'Σ', unicode.MaxRune} {
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, r), set.contains(r), c.ShouldEqual, class.Contains(r))
+			}
+		}
+		c.So(compileAsciiMatcher(RuneIsPUNCT)('!'), c.ShouldBeTrue)
+		c.So(compileAsciiMatcher(RuneIsPUNCT)('a'), c.ShouldBeFalse)
+		c.So(compileAsciiMatcher(RuneIsPUNCT)('\u00a1'), c.ShouldBeFalse)
+	})
+
+	c.Convey("panics", t, func() {
+		c.So(func() { Union(1.5) }, c.ShouldPanic)
+		c.So(func() { Subtract("a-z", struct{}{}) }, c.ShouldPanic)
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build ignore
+
+// rxp-confusable-tables-gen generates rxp-confusable-tables.go from the
+// Unicode confusables.txt data file (UTS #39)
+//
+// Usage:
+//
+//	go run rxp-confusable-tables-gen.go [-input <path or url>] [-output <path>]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const gDefaultInput = "https://www.unicode.org/Public/security/latest/confusables.txt"
+
+const gHeader = `// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by rxp-confusable-tables-gen.go; DO NOT EDIT.
+
+package rxp
+`
+
+type cEntry struct {
+	source    rune
+	prototype []rune
+	name      string
+}
+
+func main() {
+	input := flag.String("input", gDefaultInput, "confusables.txt path or url")
+	output := flag.String("output", "rxp-confusable-tables.go", "generated file path")
+	flag.Parse()
+
+	data, err := read(*input)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error reading %v: %v\n", *input, err)
+		os.Exit(1)
+	}
+
+	version, entries, err := parse(data)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error parsing %v: %v\n", *input, err)
+		os.Exit(1)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(gHeader)
+	fmt.Fprintf(&buf, "\n// gConfusableSkeleton maps runes to the prototype of their Unicode\n")
+	fmt.Fprintf(&buf, "// confusables skeleton (UTS #39), from the confusables.txt data file\n")
+	fmt.Fprintf(&buf, "// version %v\n", version)
+	fmt.Fprintf(&buf, "var gConfusableSkeleton = map[rune][]rune{\n")
+	for idx, e := range entries {
+		if idx > 0 && e.source>>8 != entries[idx-1].source>>8 {
+			// separate the blocks of 256 runes to keep the alignment of each block
+			buf.WriteString("\n")
+		}
+		values := make([]string, len(e.prototype))
+		for jdx, r := range e.prototype {
+			values[jdx] = fmt.Sprintf("0x%04x", r)
+		}
+		fmt.Fprintf(&buf, "\t0x%04x: {%v}, // %v\n", e.source, strings.Join(values, ", "), e.name)
+	}
+	buf.WriteString("}\n")
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error formatting source: %v\n", err)
+		os.Exit(1)
+	}
+	if err = os.WriteFile(*output, formatted, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "error writing %v: %v\n", *output, err)
+		os.Exit(1)
+	}
+}
+
+// read returns the contents of the local file or url given
+func read(input string) (data []byte, err error) {
+	if !strings.HasPrefix(input, "https://") && !strings.HasPrefix(input, "http://") {
+		return os.ReadFile(input)
+	}
+	var response *http.Response
+	if response, err = http.Get(input); err != nil {
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status: %v", response.Status)
+	}
+	return io.ReadAll(response.Body)
+}
+
+// parse returns the version and the sorted entries of the confusables.txt
+// data given, each line is of the form:
+//
+//	<source> ; <prototype runes> ; MA # ( <glyphs> ) <source name> → <prototype names> #
+func parse(data []byte) (version string, entries []cEntry, err error) {
+	seen := make(map[rune]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimPrefix(scanner.Text(), "\ufeff")
+		if strings.HasPrefix(text, "#") {
+			if v, ok := strings.CutPrefix(text, "# Version:"); ok {
+				version = strings.TrimSpace(v)
+			}
+			continue
+		}
+
+		content, comment, _ := strings.Cut(text, "#")
+		fields := strings.Split(content, ";")
+		if len(fields) < 3 {
+			continue
+		}
+
+		var e cEntry
+		var source []rune
+		if source, err = parseRunes(fields[0]); err != nil || len(source) != 1 {
+			return "", nil, fmt.Errorf("line %d: invalid source %q", line, fields[0])
+		} else if e.prototype, err = parseRunes(fields[1]); err != nil {
+			return "", nil, fmt.Errorf("line %d: invalid prototype %q", line, fields[1])
+		}
+		if e.source = source[0]; seen[e.source] {
+			continue
+		}
+		seen[e.source] = true
+
+		if _, names, ok := strings.Cut(comment, ")"); ok {
+			e.name, _, _ = strings.Cut(names, "→")
+			e.name = strings.TrimSpace(e.name)
+		}
+		entries = append(entries, e)
+	}
+	if err = scanner.Err(); err != nil {
+		return
+	} else if version == "" {
+		return "", nil, fmt.Errorf("version not found")
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].source < entries[j].source
+	})
+	return
+}
+
+// parseRunes parses a space separated list of hexadecimal code points
+func parseRunes(text string) (runes []rune, err error) {
+	for _, field := range strings.Fields(text) {
+		var v uint64
+		if v, err = strconv.ParseUint(field, 16, 32); err != nil {
+			return nil, err
+		}
+		runes = append(runes, rune(v))
+	}
+	return
+}
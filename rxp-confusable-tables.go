@@ -0,0 +1,5401 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by rxp-confusable-tables-gen.go; DO NOT EDIT.
+
+package rxp
+
+// gConfusableSkeleton maps runes to the prototype of their Unicode
+// confusables skeleton (UTS #39), from the confusables.txt data file
+// version 15.0
+var gConfusableSkeleton = map[rune][]rune{
+	0x0022: {0x0027, 0x0027},         // QUOTATION MARK
+	0x0025: {0x00ba, 0x002f, 0x2080}, // PERCENT SIGN
+	0x0030: {0x004f},                 // DIGIT ZERO
+	0x0031: {0x006c},                 // DIGIT ONE
+	0x0049: {0x006c},                 // LATIN CAPITAL LETTER I
+	0x0060: {0x0027},                 // GRAVE ACCENT
+	0x006d: {0x0072, 0x006e},         // LATIN SMALL LETTER M
+	0x007c: {0x006c},                 // VERTICAL LINE
+	0x00a0: {0x0020},                 // NO-BREAK SPACE
+	0x00a2: {0x0063, 0x0338},         // CENT SIGN
+	0x00a5: {0x0059, 0x0335},         // YEN SIGN
+	0x00af: {0x02c9},                 // MACRON
+	0x00b4: {0x0027},                 // ACUTE ACCENT
+	0x00b5: {0x03bc},                 // MICRO SIGN
+	0x00b8: {0x002c},                 // CEDILLA
+	0x00c6: {0x0041, 0x0045},         // LATIN CAPITAL LETTER AE
+	0x00d0: {0x0044, 0x0335},         // LATIN CAPITAL LETTER ETH
+	0x00d7: {0x0078},                 // MULTIPLICATION SIGN
+	0x00d8: {0x004f, 0x0338},         // LATIN CAPITAL LETTER O WITH STROKE
+	0x00e6: {0x0061, 0x0065},         // LATIN SMALL LETTER AE
+	0x00f0: {0x2202, 0x0335},         // LATIN SMALL LETTER ETH
+	0x00f8: {0x006f, 0x0338},         // LATIN SMALL LETTER O WITH STROKE
+
+	0x0110: {0x0044, 0x0335},         // LATIN CAPITAL LETTER D WITH STROKE
+	0x0111: {0x0064, 0x0335},         // LATIN SMALL LETTER D WITH STROKE
+	0x0126: {0x0048, 0x0335},         // LATIN CAPITAL LETTER H WITH STROKE
+	0x0127: {0x0068, 0x0335},         // LATIN SMALL LETTER H WITH STROKE
+	0x0131: {0x0069},                 // LATIN SMALL LETTER DOTLESS I
+	0x0132: {0x006c, 0x004a},         // LATIN CAPITAL LIGATURE IJ
+	0x0133: {0x0069, 0x006a},         // LATIN SMALL LIGATURE IJ
+	0x013f: {0x006c, 0x00b7},         // LATIN CAPITAL LETTER L WITH MIDDLE DOT
+	0x0140: {0x006c, 0x00b7},         // LATIN SMALL LETTER L WITH MIDDLE DOT
+	0x0141: {0x004c, 0x0338},         // LATIN CAPITAL LETTER L WITH STROKE
+	0x0142: {0x006c, 0x0338},         // LATIN SMALL LETTER L WITH STROKE
+	0x0149: {0x0027, 0x006e},         // LATIN SMALL LETTER N PRECEDED BY APOSTROPHE
+	0x0152: {0x004f, 0x0045},         // LATIN CAPITAL LIGATURE OE
+	0x0153: {0x006f, 0x0065},         // LATIN SMALL LIGATURE OE
+	0x0166: {0x0054, 0x0335},         // LATIN CAPITAL LETTER T WITH STROKE
+	0x0167: {0x0074, 0x0335},         // LATIN SMALL LETTER T WITH STROKE
+	0x017f: {0x0066},                 // LATIN SMALL LETTER LONG S
+	0x0180: {0x0062, 0x0335},         // LATIN SMALL LETTER B WITH STROKE
+	0x0181: {0x0027, 0x0042},         // LATIN CAPITAL LETTER B WITH HOOK
+	0x0182: {0x0062, 0x0304},         // LATIN CAPITAL LETTER B WITH TOPBAR
+	0x0183: {0x0062, 0x0304},         // LATIN SMALL LETTER B WITH TOPBAR
+	0x0184: {0x0062},                 // LATIN CAPITAL LETTER TONE SIX
+	0x0187: {0x0043, 0x0027},         // LATIN CAPITAL LETTER C WITH HOOK
+	0x0189: {0x0044, 0x0335},         // LATIN CAPITAL LETTER AFRICAN D
+	0x018a: {0x0027, 0x0044},         // LATIN CAPITAL LETTER D WITH HOOK
+	0x018c: {0x0064, 0x0304},         // LATIN SMALL LETTER D WITH TOPBAR
+	0x018d: {0x0067},                 // LATIN SMALL LETTER TURNED DELTA
+	0x0191: {0x0046, 0x0326},         // LATIN CAPITAL LETTER F WITH HOOK
+	0x0192: {0x0066, 0x0326},         // LATIN SMALL LETTER F WITH HOOK
+	0x0193: {0x0047, 0x0027},         // LATIN CAPITAL LETTER G WITH HOOK
+	0x0196: {0x006c},                 // LATIN CAPITAL LETTER IOTA
+	0x0197: {0x006c, 0x0335},         // LATIN CAPITAL LETTER I WITH STROKE
+	0x0198: {0x004b, 0x0027},         // LATIN CAPITAL LETTER K WITH HOOK
+	0x0199: {0x006b, 0x0314},         // LATIN SMALL LETTER K WITH HOOK
+	0x019a: {0x006c, 0x0335},         // LATIN SMALL LETTER L WITH BAR
+	0x019d: {0x004e, 0x0326},         // LATIN CAPITAL LETTER N WITH LEFT HOOK
+	0x019e: {0x006e, 0x0329},         // LATIN SMALL LETTER N WITH LONG RIGHT LEG
+	0x019f: {0x004f, 0x0335},         // LATIN CAPITAL LETTER O WITH MIDDLE TILDE
+	0x01a4: {0x0027, 0x0050},         // LATIN CAPITAL LETTER P WITH HOOK
+	0x01a5: {0x0070, 0x0314},         // LATIN SMALL LETTER P WITH HOOK
+	0x01a6: {0x0052},                 // LATIN LETTER YR
+	0x01a7: {0x0032},                 // LATIN CAPITAL LETTER TONE TWO
+	0x01ac: {0x0027, 0x0054},         // LATIN CAPITAL LETTER T WITH HOOK
+	0x01ad: {0x0074, 0x0314},         // LATIN SMALL LETTER T WITH HOOK
+	0x01ae: {0x0054, 0x0328},         // LATIN CAPITAL LETTER T WITH RETROFLEX HOOK
+	0x01b3: {0x0027, 0x0059},         // LATIN CAPITAL LETTER Y WITH HOOK
+	0x01b4: {0x0079, 0x0314},         // LATIN SMALL LETTER Y WITH HOOK
+	0x01b5: {0x005a, 0x0335},         // LATIN CAPITAL LETTER Z WITH STROKE
+	0x01b6: {0x007a, 0x0335},         // LATIN SMALL LETTER Z WITH STROKE
+	0x01b7: {0x0033},                 // LATIN CAPITAL LETTER EZH
+	0x01bb: {0x0032, 0x0335},         // LATIN LETTER TWO WITH STROKE
+	0x01bc: {0x0035},                 // LATIN CAPITAL LETTER TONE FIVE
+	0x01bd: {0x0073},                 // LATIN SMALL LETTER TONE FIVE
+	0x01bf: {0x00fe},                 // LATIN LETTER WYNN
+	0x01c0: {0x006c},                 // LATIN LETTER DENTAL CLICK
+	0x01c1: {0x006c, 0x006c},         // LATIN LETTER LATERAL CLICK
+	0x01c3: {0x0021},                 // LATIN LETTER RETROFLEX CLICK
+	0x01c4: {0x0044, 0x005a, 0x030c}, // LATIN CAPITAL LETTER DZ WITH CARON
+	0x01c5: {0x0044, 0x007a, 0x030c}, // LATIN CAPITAL LETTER D WITH SMALL LETTER Z WITH CARON
+	0x01c6: {0x0064, 0x007a, 0x030c}, // LATIN SMALL LETTER DZ WITH CARON
+	0x01c7: {0x004c, 0x004a},         // LATIN CAPITAL LETTER LJ
+	0x01c8: {0x004c, 0x006a},         // LATIN CAPITAL LETTER L WITH SMALL LETTER J
+	0x01c9: {0x006c, 0x006a},         // LATIN SMALL LETTER LJ
+	0x01ca: {0x004e, 0x004a},         // LATIN CAPITAL LETTER NJ
+	0x01cb: {0x004e, 0x006a},         // LATIN CAPITAL LETTER N WITH SMALL LETTER J
+	0x01cc: {0x006e, 0x006a},         // LATIN SMALL LETTER NJ
+	0x01e4: {0x0047, 0x0335},         // LATIN CAPITAL LETTER G WITH STROKE
+	0x01e5: {0x0067, 0x0335},         // LATIN SMALL LETTER G WITH STROKE
+	0x01f1: {0x0044, 0x005a},         // LATIN CAPITAL LETTER DZ
+	0x01f2: {0x0044, 0x007a},         // LATIN CAPITAL LETTER D WITH SMALL LETTER Z
+	0x01f3: {0x0064, 0x007a},         // LATIN SMALL LETTER DZ
+
+	0x021c: {0x0033},                 // LATIN CAPITAL LETTER YOGH
+	0x0222: {0x0038},                 // LATIN CAPITAL LETTER OU
+	0x0223: {0x0038},                 // LATIN SMALL LETTER OU
+	0x0224: {0x005a, 0x0326},         // LATIN CAPITAL LETTER Z WITH HOOK
+	0x0225: {0x007a, 0x0326},         // LATIN SMALL LETTER Z WITH HOOK
+	0x023c: {0x0063, 0x0338},         // LATIN SMALL LETTER C WITH STROKE
+	0x023e: {0x0054, 0x0338},         // LATIN CAPITAL LETTER T WITH DIAGONAL STROKE
+	0x0241: {0x003f},                 // LATIN CAPITAL LETTER GLOTTAL STOP
+	0x0244: {0x0055, 0x0335},         // LATIN CAPITAL LETTER U BAR
+	0x0246: {0x0045, 0x0338},         // LATIN CAPITAL LETTER E WITH STROKE
+	0x0247: {0x0065, 0x0338},         // LATIN SMALL LETTER E WITH STROKE
+	0x0248: {0x004a, 0x0335},         // LATIN CAPITAL LETTER J WITH STROKE
+	0x0249: {0x006a, 0x0335},         // LATIN SMALL LETTER J WITH STROKE
+	0x024d: {0x0072, 0x0335},         // LATIN SMALL LETTER R WITH STROKE
+	0x024e: {0x0059, 0x0335},         // LATIN CAPITAL LETTER Y WITH STROKE
+	0x024f: {0x0079, 0x0335},         // LATIN SMALL LETTER Y WITH STROKE
+	0x0251: {0x0061},                 // LATIN SMALL LETTER ALPHA
+	0x0253: {0x0062, 0x0314},         // LATIN SMALL LETTER B WITH HOOK
+	0x0256: {0x0064, 0x0328},         // LATIN SMALL LETTER D WITH TAIL
+	0x0257: {0x0064, 0x0314},         // LATIN SMALL LETTER D WITH HOOK
+	0x0259: {0x01dd},                 // LATIN SMALL LETTER SCHWA
+	0x025a: {0x01dd, 0x02de},         // LATIN SMALL LETTER SCHWA WITH HOOK
+	0x025b: {0xa793},                 // LATIN SMALL LETTER OPEN E
+	0x0260: {0x0067, 0x0314},         // LATIN SMALL LETTER G WITH HOOK
+	0x0261: {0x0067},                 // LATIN SMALL LETTER SCRIPT G
+	0x0263: {0x0079},                 // LATIN SMALL LETTER GAMMA
+	0x0266: {0x0068, 0x0314},         // LATIN SMALL LETTER H WITH HOOK
+	0x0268: {0x0069, 0x0335},         // LATIN SMALL LETTER I WITH STROKE
+	0x0269: {0x0069},                 // LATIN SMALL LETTER IOTA
+	0x026a: {0x0069},                 // LATIN LETTER SMALL CAPITAL I
+	0x026b: {0x006c, 0x0334},         // LATIN SMALL LETTER L WITH MIDDLE TILDE
+	0x026d: {0x006c, 0x0328},         // LATIN SMALL LETTER L WITH RETROFLEX HOOK
+	0x026e: {0x006c, 0x021d},         // LATIN SMALL LETTER LEZH
+	0x026f: {0x0077},                 // LATIN SMALL LETTER TURNED M
+	0x0271: {0x0072, 0x006e, 0x0326}, // LATIN SMALL LETTER M WITH HOOK
+	0x0273: {0x006e, 0x0328},         // LATIN SMALL LETTER N WITH RETROFLEX HOOK
+	0x0275: {0x006f, 0x0335},         // LATIN SMALL LETTER BARRED O
+	0x0276: {0x006f, 0x1d07},         // LATIN LETTER SMALL CAPITAL OE
+	0x027c: {0x0072, 0x0329},         // LATIN SMALL LETTER R WITH LONG LEG
+	0x027d: {0x0072, 0x0328},         // LATIN SMALL LETTER R WITH TAIL
+	0x0282: {0x0073, 0x0328},         // LATIN SMALL LETTER S WITH HOOK
+	0x028b: {0x0075},                 // LATIN SMALL LETTER V WITH HOOK
+	0x028f: {0x0079},                 // LATIN LETTER SMALL CAPITAL Y
+	0x0290: {0x007a, 0x0328},         // LATIN SMALL LETTER Z WITH RETROFLEX HOOK
+	0x0292: {0x021d},                 // LATIN SMALL LETTER EZH
+	0x0294: {0x003f},                 // LATIN LETTER GLOTTAL STOP
+	0x02a0: {0x0071, 0x0314},         // LATIN SMALL LETTER Q WITH HOOK
+	0x02a3: {0x0064, 0x007a},         // LATIN SMALL LETTER DZ DIGRAPH
+	0x02a4: {0x0064, 0x021d},         // LATIN SMALL LETTER DEZH DIGRAPH
+	0x02a5: {0x0064, 0x0291},         // LATIN SMALL LETTER DZ DIGRAPH WITH CURL
+	0x02a6: {0x0074, 0x0073},         // LATIN SMALL LETTER TS DIGRAPH
+	0x02a7: {0x0074, 0x0283},         // LATIN SMALL LETTER TESH DIGRAPH
+	0x02a8: {0x0074, 0x0255},         // LATIN SMALL LETTER TC DIGRAPH WITH CURL
+	0x02a9: {0x0066, 0x014b},         // LATIN SMALL LETTER FENG DIGRAPH
+	0x02aa: {0x006c, 0x0073},         // LATIN SMALL LETTER LS DIGRAPH
+	0x02ab: {0x006c, 0x007a},         // LATIN SMALL LETTER LZ DIGRAPH
+	0x02b3: {0x18f4},                 // MODIFIER LETTER SMALL R
+	0x02b9: {0x0027},                 // MODIFIER LETTER PRIME
+	0x02ba: {0x0027, 0x0027},         // MODIFIER LETTER DOUBLE PRIME
+	0x02bb: {0x0027},                 // MODIFIER LETTER TURNED COMMA
+	0x02bc: {0x0027},                 // MODIFIER LETTER APOSTROPHE
+	0x02bd: {0x0027},                 // MODIFIER LETTER REVERSED COMMA
+	0x02be: {0x0027},                 // MODIFIER LETTER RIGHT HALF RING
+	0x02bf: {0x0559},                 // MODIFIER LETTER LEFT HALF RING
+	0x02c2: {0x003c},                 // MODIFIER LETTER LEFT ARROWHEAD
+	0x02c3: {0x003e},                 // MODIFIER LETTER RIGHT ARROWHEAD
+	0x02c4: {0x005e},                 // MODIFIER LETTER UP ARROWHEAD
+	0x02c6: {0x005e},                 // MODIFIER LETTER CIRCUMFLEX ACCENT
+	0x02c8: {0x0027},                 // MODIFIER LETTER VERTICAL LINE
+	0x02ca: {0x0027},                 // MODIFIER LETTER ACUTE ACCENT
+	0x02cb: {0x0027},                 // MODIFIER LETTER GRAVE ACCENT
+	0x02d0: {0x003a},                 // MODIFIER LETTER TRIANGULAR COLON
+	0x02d3: {0x0559},                 // MODIFIER LETTER CENTRED LEFT HALF RING
+	0x02d7: {0x002d},                 // MODIFIER LETTER MINUS SIGN
+	0x02d8: {0x02c7},                 // BREVE
+	0x02d9: {0x0971},                 // DOT ABOVE
+	0x02da: {0x00b0},                 // RING ABOVE
+	0x02db: {0x0069},                 // OGONEK
+	0x02dc: {0x007e},                 // SMALL TILDE
+	0x02dd: {0x0027, 0x0027},         // DOUBLE ACUTE ACCENT
+	0x02e1: {0x18f3},                 // MODIFIER LETTER SMALL L
+	0x02e2: {0x18f5},                 // MODIFIER LETTER SMALL S
+	0x02e4: {0x02c1},                 // MODIFIER LETTER SMALL REVERSED GLOTTAL STOP
+	0x02ee: {0x0027, 0x0027},         // MODIFIER LETTER DOUBLE APOSTROPHE
+	0x02f4: {0x0027},                 // MODIFIER LETTER MIDDLE GRAVE ACCENT
+	0x02f6: {0x0027, 0x0027},         // MODIFIER LETTER MIDDLE DOUBLE ACUTE ACCENT
+	0x02f8: {0x003a},                 // MODIFIER LETTER RAISED COLON
+	0x02fb: {0x02ea},                 // MODIFIER LETTER BEGIN LOW TONE
+
+	0x0305: {0x0304},         // COMBINING OVERLINE
+	0x030c: {0x0306},         // COMBINING CARON
+	0x030d: {0x0670},         // COMBINING VERTICAL LINE ABOVE
+	0x0310: {0x0306, 0x0307}, // COMBINING CANDRABINDU
+	0x0311: {0x0302},         // COMBINING INVERTED BREVE
+	0x0315: {0x0313},         // COMBINING COMMA ABOVE RIGHT
+	0x0317: {0x0650},         // COMBINING ACUTE ACCENT BELOW
+	0x0320: {0x0331},         // COMBINING MINUS SIGN BELOW
+	0x0321: {0x0326},         // COMBINING PALATALIZED HOOK BELOW
+	0x0322: {0x0328},         // COMBINING RETROFLEX HOOK BELOW
+	0x0327: {0x0326},         // COMBINING CEDILLA
+	0x0336: {0x0335},         // COMBINING LONG STROKE OVERLAY
+	0x0337: {0x0338},         // COMBINING SHORT SOLIDUS OVERLAY
+	0x0339: {0x0326},         // COMBINING RIGHT HALF RING BELOW
+	0x0342: {0x0303},         // COMBINING GREEK PERISPOMENI
+	0x0345: {0x0328},         // COMBINING GREEK YPOGEGRAMMENI
+	0x0347: {0x0333},         // COMBINING EQUALS SIGN BELOW
+	0x0357: {0x0350},         // COMBINING RIGHT HALF RING ABOVE
+	0x0358: {0x0307},         // COMBINING DOT ABOVE RIGHT
+	0x0366: {0x030a},         // COMBINING LATIN SMALL LETTER O
+	0x036e: {0x0306},         // COMBINING LATIN SMALL LETTER V
+	0x0370: {0x2c75},         // GREEK CAPITAL LETTER HETA
+	0x0375: {0x02cf},         // GREEK LOWER NUMERAL SIGN
+	0x0376: {0x0418},         // GREEK CAPITAL LETTER PAMPHYLIAN DIGAMMA
+	0x0377: {0x1d0e},         // GREEK SMALL LETTER PAMPHYLIAN DIGAMMA
+	0x037a: {0x0069},         // GREEK YPOGEGRAMMENI
+	0x037b: {0x0254},         // GREEK SMALL REVERSED LUNATE SIGMA SYMBOL
+	0x037d: {0xa73f},         // GREEK SMALL REVERSED DOTTED LUNATE SIGMA SYMBOL
+	0x037f: {0x004a},         // GREEK CAPITAL LETTER YOT
+	0x0384: {0x0027},         // GREEK TONOS
+	0x0391: {0x0041},         // GREEK CAPITAL LETTER ALPHA
+	0x0392: {0x0042},         // GREEK CAPITAL LETTER BETA
+	0x0395: {0x0045},         // GREEK CAPITAL LETTER EPSILON
+	0x0396: {0x005a},         // GREEK CAPITAL LETTER ZETA
+	0x0397: {0x0048},         // GREEK CAPITAL LETTER ETA
+	0x0398: {0x004f, 0x0335}, // GREEK CAPITAL LETTER THETA
+	0x0399: {0x006c},         // GREEK CAPITAL LETTER IOTA
+	0x039a: {0x004b},         // GREEK CAPITAL LETTER KAPPA
+	0x039b: {0x0245},         // GREEK CAPITAL LETTER LAMDA
+	0x039c: {0x004d},         // GREEK CAPITAL LETTER MU
+	0x039d: {0x004e},         // GREEK CAPITAL LETTER NU
+	0x039f: {0x004f},         // GREEK CAPITAL LETTER OMICRON
+	0x03a1: {0x0050},         // GREEK CAPITAL LETTER RHO
+	0x03a3: {0x01a9},         // GREEK CAPITAL LETTER SIGMA
+	0x03a4: {0x0054},         // GREEK CAPITAL LETTER TAU
+	0x03a5: {0x0059},         // GREEK CAPITAL LETTER UPSILON
+	0x03a7: {0x0058},         // GREEK CAPITAL LETTER CHI
+	0x03b1: {0x0061},         // GREEK SMALL LETTER ALPHA
+	0x03b2: {0x00df},         // GREEK SMALL LETTER BETA
+	0x03b3: {0x0079},         // GREEK SMALL LETTER GAMMA
+	0x03b4: {0x1e9f},         // GREEK SMALL LETTER DELTA
+	0x03b5: {0xa793},         // GREEK SMALL LETTER EPSILON
+	0x03b7: {0x006e, 0x0329}, // GREEK SMALL LETTER ETA
+	0x03b8: {0x004f, 0x0335}, // GREEK SMALL LETTER THETA
+	0x03b9: {0x0069},         // GREEK SMALL LETTER IOTA
+	0x03ba: {0x0138},         // GREEK SMALL LETTER KAPPA
+	0x03bd: {0x0076},         // GREEK SMALL LETTER NU
+	0x03bf: {0x006f},         // GREEK SMALL LETTER OMICRON
+	0x03c1: {0x0070},         // GREEK SMALL LETTER RHO
+	0x03c3: {0x006f},         // GREEK SMALL LETTER SIGMA
+	0x03c4: {0x1d1b},         // GREEK SMALL LETTER TAU
+	0x03c5: {0x0075},         // GREEK SMALL LETTER UPSILON
+	0x03c6: {0x0278},         // GREEK SMALL LETTER PHI
+	0x03d0: {0x00df},         // GREEK BETA SYMBOL
+	0x03d1: {0x004f, 0x0335}, // GREEK THETA SYMBOL
+	0x03d2: {0x0059},         // GREEK UPSILON WITH HOOK SYMBOL
+	0x03d5: {0x0278},         // GREEK PHI SYMBOL
+	0x03d6: {0x03c0},         // GREEK PI SYMBOL
+	0x03db: {0x03c2},         // GREEK SMALL LETTER STIGMA
+	0x03dc: {0x0046},         // GREEK LETTER DIGAMMA
+	0x03e8: {0x0032},         // COPTIC CAPITAL LETTER HORI
+	0x03e9: {0x01a8},         // COPTIC SMALL LETTER HORI
+	0x03f0: {0x0138},         // GREEK KAPPA SYMBOL
+	0x03f1: {0x0070},         // GREEK RHO SYMBOL
+	0x03f2: {0x0063},         // GREEK LUNATE SIGMA SYMBOL
+	0x03f3: {0x006a},         // GREEK LETTER YOT
+	0x03f4: {0x004f, 0x0335}, // GREEK CAPITAL THETA SYMBOL
+	0x03f5: {0xa793},         // GREEK LUNATE EPSILON SYMBOL
+	0x03f7: {0x00de},         // GREEK CAPITAL LETTER SHO
+	0x03f8: {0x00fe},         // GREEK SMALL LETTER SHO
+	0x03f9: {0x0043},         // GREEK CAPITAL LUNATE SIGMA SYMBOL
+	0x03fa: {0x004d},         // GREEK CAPITAL LETTER SAN
+	0x03fd: {0x0186},         // GREEK CAPITAL REVERSED LUNATE SIGMA SYMBOL
+	0x03ff: {0xa73e},         // GREEK CAPITAL REVERSED DOTTED LUNATE SIGMA SYMBOL
+
+	0x0404: {0xa792},                 // CYRILLIC CAPITAL LETTER UKRAINIAN IE
+	0x0405: {0x0053},                 // CYRILLIC CAPITAL LETTER DZE
+	0x0406: {0x006c},                 // CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I
+	0x0408: {0x004a},                 // CYRILLIC CAPITAL LETTER JE
+	0x0410: {0x0041},                 // CYRILLIC CAPITAL LETTER A
+	0x0411: {0x0062, 0x0304},         // CYRILLIC CAPITAL LETTER BE
+	0x0412: {0x0042},                 // CYRILLIC CAPITAL LETTER VE
+	0x0413: {0x0393},                 // CYRILLIC CAPITAL LETTER GHE
+	0x0415: {0x0045},                 // CYRILLIC CAPITAL LETTER IE
+	0x0417: {0x0033},                 // CYRILLIC CAPITAL LETTER ZE
+	0x041a: {0x004b},                 // CYRILLIC CAPITAL LETTER KA
+	0x041b: {0x0245},                 // CYRILLIC CAPITAL LETTER EL
+	0x041c: {0x004d},                 // CYRILLIC CAPITAL LETTER EM
+	0x041d: {0x0048},                 // CYRILLIC CAPITAL LETTER EN
+	0x041e: {0x004f},                 // CYRILLIC CAPITAL LETTER O
+	0x041f: {0x03a0},                 // CYRILLIC CAPITAL LETTER PE
+	0x0420: {0x0050},                 // CYRILLIC CAPITAL LETTER ER
+	0x0421: {0x0043},                 // CYRILLIC CAPITAL LETTER ES
+	0x0422: {0x0054},                 // CYRILLIC CAPITAL LETTER TE
+	0x0423: {0x0059},                 // CYRILLIC CAPITAL LETTER U
+	0x0424: {0x03a6},                 // CYRILLIC CAPITAL LETTER EF
+	0x0425: {0x0058},                 // CYRILLIC CAPITAL LETTER HA
+	0x042b: {0x0062, 0x006c},         // CYRILLIC CAPITAL LETTER YERU
+	0x042c: {0x0062},                 // CYRILLIC CAPITAL LETTER SOFT SIGN
+	0x042e: {0x006c, 0x004f},         // CYRILLIC CAPITAL LETTER YU
+	0x0430: {0x0061},                 // CYRILLIC SMALL LETTER A
+	0x0431: {0x0036},                 // CYRILLIC SMALL LETTER BE
+	0x0432: {0x0299},                 // CYRILLIC SMALL LETTER VE
+	0x0433: {0x0072},                 // CYRILLIC SMALL LETTER GHE
+	0x0435: {0x0065},                 // CYRILLIC SMALL LETTER IE
+	0x0437: {0x025c},                 // CYRILLIC SMALL LETTER ZE
+	0x0438: {0x1d0e},                 // CYRILLIC SMALL LETTER I
+	0x043a: {0x0138},                 // CYRILLIC SMALL LETTER KA
+	0x043c: {0x028d},                 // CYRILLIC SMALL LETTER EM
+	0x043d: {0x029c},                 // CYRILLIC SMALL LETTER EN
+	0x043e: {0x006f},                 // CYRILLIC SMALL LETTER O
+	0x043f: {0x03c0},                 // CYRILLIC SMALL LETTER PE
+	0x0440: {0x0070},                 // CYRILLIC SMALL LETTER ER
+	0x0441: {0x0063},                 // CYRILLIC SMALL LETTER ES
+	0x0442: {0x1d1b},                 // CYRILLIC SMALL LETTER TE
+	0x0443: {0x0079},                 // CYRILLIC SMALL LETTER U
+	0x0444: {0x0278},                 // CYRILLIC SMALL LETTER EF
+	0x0445: {0x0078},                 // CYRILLIC SMALL LETTER HA
+	0x044a: {0x02c9, 0x0062},         // CYRILLIC SMALL LETTER HARD SIGN
+	0x044b: {0x0185, 0x0069},         // CYRILLIC SMALL LETTER YERU
+	0x044c: {0x0185},                 // CYRILLIC SMALL LETTER SOFT SIGN
+	0x044f: {0x1d19},                 // CYRILLIC SMALL LETTER YA
+	0x0454: {0xa793},                 // CYRILLIC SMALL LETTER UKRAINIAN IE
+	0x0455: {0x0073},                 // CYRILLIC SMALL LETTER DZE
+	0x0456: {0x0069},                 // CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I
+	0x0458: {0x006a},                 // CYRILLIC SMALL LETTER JE
+	0x045b: {0x0068, 0x0335},         // CYRILLIC SMALL LETTER TSHE
+	0x0461: {0x0077},                 // CYRILLIC SMALL LETTER OMEGA
+	0x0462: {0x0062, 0x0335},         // CYRILLIC CAPITAL LETTER YAT
+	0x0463: {0x0062, 0x0335},         // CYRILLIC SMALL LETTER YAT
+	0x0470: {0x03a8},                 // CYRILLIC CAPITAL LETTER PSI
+	0x0471: {0x03c8},                 // CYRILLIC SMALL LETTER PSI
+	0x0472: {0x004f, 0x0335},         // CYRILLIC CAPITAL LETTER FITA
+	0x0473: {0x006f, 0x0335},         // CYRILLIC SMALL LETTER FITA
+	0x0474: {0x0056},                 // CYRILLIC CAPITAL LETTER IZHITSA
+	0x0475: {0x0076},                 // CYRILLIC SMALL LETTER IZHITSA
+	0x047c: {0x0460, 0x0486, 0x0487}, // CYRILLIC CAPITAL LETTER OMEGA WITH TITLO
+	0x047d: {0x0077, 0x0486, 0x0487}, // CYRILLIC SMALL LETTER OMEGA WITH TITLO
+	0x048a: {0x0418, 0x0326, 0x0300}, // CYRILLIC CAPITAL LETTER SHORT I WITH TAIL
+	0x048b: {0x0438, 0x0326, 0x0306}, // CYRILLIC SMALL LETTER SHORT I WITH TAIL
+	0x048c: {0x0062, 0x0335},         // CYRILLIC CAPITAL LETTER SEMISOFT SIGN
+	0x048d: {0x0062, 0x0335},         // CYRILLIC SMALL LETTER SEMISOFT SIGN
+	0x0490: {0x0393, 0x0027},         // CYRILLIC CAPITAL LETTER GHE WITH UPTURN
+	0x0491: {0x0072, 0x0027},         // CYRILLIC SMALL LETTER GHE WITH UPTURN
+	0x0492: {0x0393, 0x0335},         // CYRILLIC CAPITAL LETTER GHE WITH STROKE
+	0x0493: {0x0072, 0x0335},         // CYRILLIC SMALL LETTER GHE WITH STROKE
+	0x0496: {0x0416, 0x0329},         // CYRILLIC CAPITAL LETTER ZHE WITH DESCENDER
+	0x0497: {0x0436, 0x0329},         // CYRILLIC SMALL LETTER ZHE WITH DESCENDER
+	0x0498: {0x0033, 0x0326},         // CYRILLIC CAPITAL LETTER ZE WITH DESCENDER
+	0x0499: {0x025c, 0x0326},         // CYRILLIC SMALL LETTER ZE WITH DESCENDER
+	0x049a: {0x004b, 0x0329},         // CYRILLIC CAPITAL LETTER KA WITH DESCENDER
+	0x049b: {0x0138, 0x0329},         // CYRILLIC SMALL LETTER KA WITH DESCENDER
+	0x049e: {0x004b, 0x0335},         // CYRILLIC CAPITAL LETTER KA WITH STROKE
+	0x049f: {0x0138, 0x0335},         // CYRILLIC SMALL LETTER KA WITH STROKE
+	0x04a2: {0x0048, 0x0329},         // CYRILLIC CAPITAL LETTER EN WITH DESCENDER
+	0x04a3: {0x029c, 0x0329},         // CYRILLIC SMALL LETTER EN WITH DESCENDER
+	0x04aa: {0x0043, 0x0326},         // CYRILLIC CAPITAL LETTER ES WITH DESCENDER
+	0x04ab: {0x0063, 0x0326},         // CYRILLIC SMALL LETTER ES WITH DESCENDER
+	0x04ac: {0x0054, 0x0329},         // CYRILLIC CAPITAL LETTER TE WITH DESCENDER
+	0x04ad: {0x1d1b, 0x0329},         // CYRILLIC SMALL LETTER TE WITH DESCENDER
+	0x04ae: {0x0059},                 // CYRILLIC CAPITAL LETTER STRAIGHT U
+	0x04af: {0x0079},                 // CYRILLIC SMALL LETTER STRAIGHT U
+	0x04b0: {0x0059, 0x0335},         // CYRILLIC CAPITAL LETTER STRAIGHT U WITH STROKE
+	0x04b1: {0x0079, 0x0335},         // CYRILLIC SMALL LETTER STRAIGHT U WITH STROKE
+	0x04b2: {0x0058, 0x0329},         // CYRILLIC CAPITAL LETTER HA WITH DESCENDER
+	0x04bb: {0x0068},                 // CYRILLIC SMALL LETTER SHHA
+	0x04bd: {0x0065},                 // CYRILLIC SMALL LETTER ABKHASIAN CHE
+	0x04be: {0x04bc, 0x0328},         // CYRILLIC CAPITAL LETTER ABKHASIAN CHE WITH DESCENDER
+	0x04bf: {0x0065, 0x0328},         // CYRILLIC SMALL LETTER ABKHASIAN CHE WITH DESCENDER
+	0x04c0: {0x006c},                 // CYRILLIC LETTER PALOCHKA
+	0x04c5: {0x0245, 0x0326},         // CYRILLIC CAPITAL LETTER EL WITH TAIL
+	0x04c6: {0x043b, 0x0326},         // CYRILLIC SMALL LETTER EL WITH TAIL
+	0x04c7: {0x0048, 0x0326},         // CYRILLIC CAPITAL LETTER EN WITH HOOK
+	0x04c8: {0x029c, 0x0326},         // CYRILLIC SMALL LETTER EN WITH HOOK
+	0x04c9: {0x0048, 0x0326},         // CYRILLIC CAPITAL LETTER EN WITH TAIL
+	0x04ca: {0x029c, 0x0326},         // CYRILLIC SMALL LETTER EN WITH TAIL
+	0x04cb: {0x04b6},                 // CYRILLIC CAPITAL LETTER KHAKASSIAN CHE
+	0x04cc: {0x04b7},                 // CYRILLIC SMALL LETTER KHAKASSIAN CHE
+	0x04cd: {0x004d, 0x0326},         // CYRILLIC CAPITAL LETTER EM WITH TAIL
+	0x04ce: {0x028d, 0x0326},         // CYRILLIC SMALL LETTER EM WITH TAIL
+	0x04cf: {0x0069},                 // CYRILLIC SMALL LETTER PALOCHKA
+	0x04d4: {0x0041, 0x0045},         // CYRILLIC CAPITAL LIGATURE A IE
+	0x04d5: {0x0061, 0x0065},         // CYRILLIC SMALL LIGATURE A IE
+	0x04d8: {0x018f},                 // CYRILLIC CAPITAL LETTER SCHWA
+	0x04d9: {0x01dd},                 // CYRILLIC SMALL LETTER SCHWA
+	0x04e0: {0x0033},                 // CYRILLIC CAPITAL LETTER ABKHASIAN DZE
+	0x04e1: {0x021d},                 // CYRILLIC SMALL LETTER ABKHASIAN DZE
+	0x04e8: {0x004f, 0x0335},         // CYRILLIC CAPITAL LETTER BARRED O
+	0x04e9: {0x006f, 0x0335},         // CYRILLIC SMALL LETTER BARRED O
+
+	0x0501: {0x0064},         // CYRILLIC SMALL LETTER KOMI DE
+	0x050a: {0x01f6},         // CYRILLIC CAPITAL LETTER KOMI NJE
+	0x050c: {0x0047},         // CYRILLIC CAPITAL LETTER KOMI SJE
+	0x050d: {0x0262},         // CYRILLIC SMALL LETTER KOMI SJE
+	0x0510: {0x0190},         // CYRILLIC CAPITAL LETTER REVERSED ZE
+	0x0511: {0xa793},         // CYRILLIC SMALL LETTER REVERSED ZE
+	0x051b: {0x0071},         // CYRILLIC SMALL LETTER QA
+	0x051c: {0x0057},         // CYRILLIC CAPITAL LETTER WE
+	0x051d: {0x0077},         // CYRILLIC SMALL LETTER WE
+	0x053b: {0x12ae},         // ARMENIAN CAPITAL LETTER INI
+	0x0544: {0x1206},         // ARMENIAN CAPITAL LETTER MEN
+	0x054a: {0x1323},         // ARMENIAN CAPITAL LETTER PEH
+	0x054c: {0x1261},         // ARMENIAN CAPITAL LETTER RA
+	0x054d: {0x0055},         // ARMENIAN CAPITAL LETTER SEH
+	0x054f: {0x0053},         // ARMENIAN CAPITAL LETTER TIWN
+	0x0553: {0x03a6},         // ARMENIAN CAPITAL LETTER PIWR
+	0x0555: {0x004f},         // ARMENIAN CAPITAL LETTER OH
+	0x055a: {0x0027},         // ARMENIAN APOSTROPHE
+	0x055d: {0x0027},         // ARMENIAN COMMA
+	0x0561: {0x0077},         // ARMENIAN SMALL LETTER AYB
+	0x0563: {0x0071},         // ARMENIAN SMALL LETTER GIM
+	0x0566: {0x0071},         // ARMENIAN SMALL LETTER ZA
+	0x056e: {0x1e9f},         // ARMENIAN SMALL LETTER CA
+	0x0570: {0x0068},         // ARMENIAN SMALL LETTER HO
+	0x0575: {0x0237},         // ARMENIAN SMALL LETTER YI
+	0x0578: {0x006e},         // ARMENIAN SMALL LETTER VO
+	0x057a: {0x0270},         // ARMENIAN SMALL LETTER PEH
+	0x057c: {0x006e},         // ARMENIAN SMALL LETTER RA
+	0x057d: {0x0075},         // ARMENIAN SMALL LETTER SEH
+	0x0581: {0x0067},         // ARMENIAN SMALL LETTER CO
+	0x0584: {0x0066},         // ARMENIAN SMALL LETTER KEH
+	0x0585: {0x006f},         // ARMENIAN SMALL LETTER OH
+	0x0587: {0x0565, 0x0582}, // ARMENIAN SMALL LIGATURE ECH YIWN
+	0x0589: {0x003a},         // ARMENIAN FULL STOP
+	0x059c: {0x0301},         // HEBREW ACCENT GERESH
+	0x059d: {0x0301},         // HEBREW ACCENT GERESH MUQDAM
+	0x05a4: {0x059a},         // HEBREW ACCENT MAHAPAKH
+	0x05a8: {0x0599},         // HEBREW ACCENT QADMA
+	0x05ad: {0x0596},         // HEBREW ACCENT DEHI
+	0x05ae: {0x0598},         // HEBREW ACCENT ZINOR
+	0x05af: {0x030a},         // HEBREW MARK MASORA CIRCLE
+	0x05b4: {0x0323},         // HEBREW POINT HIRIQ
+	0x05b9: {0x0307},         // HEBREW POINT HOLAM
+	0x05ba: {0x0307},         // HEBREW POINT HOLAM HASER FOR VAV
+	0x05c0: {0x006c},         // HEBREW PUNCTUATION PASEQ
+	0x05c1: {0x0307},         // HEBREW POINT SHIN DOT
+	0x05c2: {0x0307},         // HEBREW POINT SIN DOT
+	0x05c3: {0x003a},         // HEBREW PUNCTUATION SOF PASUQ
+	0x05c4: {0x0307},         // HEBREW MARK UPPER DOT
+	0x05c5: {0x0323},         // HEBREW MARK LOWER DOT
+	0x05d5: {0x006c},         // HEBREW LETTER VAV
+	0x05d8: {0x0076},         // HEBREW LETTER TET
+	0x05d9: {0x0027},         // HEBREW LETTER YOD
+	0x05df: {0x006c},         // HEBREW LETTER FINAL NUN
+	0x05e1: {0x006f},         // HEBREW LETTER SAMEKH
+	0x05f0: {0x006c, 0x006c}, // HEBREW LIGATURE YIDDISH DOUBLE VAV
+	0x05f1: {0x006c, 0x0027}, // HEBREW LIGATURE YIDDISH VAV YOD
+	0x05f2: {0x0027, 0x0027}, // HEBREW LIGATURE YIDDISH DOUBLE YOD
+	0x05f3: {0x0027},         // HEBREW PUNCTUATION GERESH
+	0x05f4: {0x0027, 0x0027}, // HEBREW PUNCTUATION GERSHAYIM
+
+	0x0609: {0x00ba, 0x002f, 0x2080, 0x2080},         // ARABIC-INDIC PER MILLE SIGN
+	0x060a: {0x00ba, 0x002f, 0x2080, 0x2080, 0x2080}, // ARABIC-INDIC PER TEN THOUSAND SIGN
+	0x060d: {0x002c},                                 // ARABIC DATE SEPARATOR
+	0x060f: {0x0639},                                 // ARABIC SIGN MISRA
+	0x0618: {0x0301},                                 // ARABIC SMALL FATHA
+	0x0619: {0x0313},                                 // ARABIC SMALL DAMMA
+	0x061a: {0x0650},                                 // ARABIC SMALL KASRA
+	0x0627: {0x006c},                                 // ARABIC LETTER ALEF
+	0x062b: {0x0649, 0x06db},                         // ARABIC LETTER THEH
+	0x0634: {0x0633, 0x06db},                         // ARABIC LETTER SHEEN
+	0x063d: {0x0649, 0x0302},                         // ARABIC LETTER FARSI YEH WITH INVERTED V
+	0x063f: {0x0649, 0x06db},                         // ARABIC LETTER FARSI YEH WITH THREE DOTS ABOVE
+	0x0647: {0x006f},                                 // ARABIC LETTER HEH
+	0x064a: {0x0649},                                 // ARABIC LETTER YEH
+	0x064b: {0x030b},                                 // ARABIC FATHATAN
+	0x064e: {0x0301},                                 // ARABIC FATHA
+	0x064f: {0x0313},                                 // ARABIC DAMMA
+	0x0652: {0x030a},                                 // ARABIC SUKUN
+	0x0653: {0x0303},                                 // ARABIC MADDAH ABOVE
+	0x0656: {0x0329},                                 // ARABIC SUBSCRIPT ALEF
+	0x0657: {0x0312},                                 // ARABIC INVERTED DAMMA
+	0x0658: {0x0306},                                 // ARABIC MARK NOON GHUNNA
+	0x0659: {0x0304},                                 // ARABIC ZWARAKAY
+	0x065a: {0x0306},                                 // ARABIC VOWEL SIGN SMALL V ABOVE
+	0x065b: {0x0302},                                 // ARABIC VOWEL SIGN INVERTED SMALL V ABOVE
+	0x065c: {0x0323},                                 // ARABIC VOWEL SIGN DOT BELOW
+	0x065d: {0x0314},                                 // ARABIC REVERSED DAMMA
+	0x065f: {0x0655},                                 // ARABIC WAVY HAMZA BELOW
+	0x0660: {0x002e},                                 // ARABIC-INDIC DIGIT ZERO
+	0x0661: {0x006c},                                 // ARABIC-INDIC DIGIT ONE
+	0x0665: {0x006f},                                 // ARABIC-INDIC DIGIT FIVE
+	0x0667: {0x0056},                                 // ARABIC-INDIC DIGIT SEVEN
+	0x0668: {0x0245},                                 // ARABIC-INDIC DIGIT EIGHT
+	0x066a: {0x00ba, 0x002f, 0x2080},                 // ARABIC PERCENT SIGN
+	0x066b: {0x002c},                                 // ARABIC DECIMAL SEPARATOR
+	0x066c: {0x060c},                                 // ARABIC THOUSANDS SEPARATOR
+	0x066d: {0x002a},                                 // ARABIC FIVE POINTED STAR
+	0x066e: {0x0649},                                 // ARABIC LETTER DOTLESS BEH
+	0x066f: {0x06a1},                                 // ARABIC LETTER DOTLESS QAF
+	0x0672: {0x006c, 0x0674},                         // ARABIC LETTER ALEF WITH WAVY HAMZA ABOVE
+	0x0673: {0x006c, 0x0655},                         // ARABIC LETTER ALEF WITH WAVY HAMZA BELOW
+	0x0675: {0x006c, 0x0674},                         // ARABIC LETTER HIGH HAMZA ALEF
+	0x0676: {0x0648, 0x0674},                         // ARABIC LETTER HIGH HAMZA WAW
+	0x0677: {0x0648, 0x0313, 0x0674},                 // ARABIC LETTER U WITH HAMZA ABOVE
+	0x0678: {0x0649, 0x0674},                         // ARABIC LETTER HIGH HAMZA YEH
+	0x0679: {0x0649, 0x0615},                         // ARABIC LETTER TTEH
+	0x067e: {0x0649, 0x06db},                         // ARABIC LETTER PEH
+	0x0681: {0x062d, 0x0654},                         // ARABIC LETTER HAH WITH HAMZA ABOVE
+	0x0685: {0x062d, 0x06db},                         // ARABIC LETTER HAH WITH THREE DOTS ABOVE
+	0x0688: {0x062f, 0x0615},                         // ARABIC LETTER DDAL
+	0x068b: {0x068a, 0x0615},                         // ARABIC LETTER DAL WITH DOT BELOW AND SMALL TAH
+	0x068e: {0x062f, 0x06db},                         // ARABIC LETTER DUL
+	0x0691: {0x0631, 0x0615},                         // ARABIC LETTER RREH
+	0x0692: {0x0631, 0x0306},                         // ARABIC LETTER REH WITH SMALL V
+	0x0698: {0x0631, 0x06db},                         // ARABIC LETTER JEH
+	0x069e: {0x0635, 0x06db},                         // ARABIC LETTER SAD WITH THREE DOTS ABOVE
+	0x069f: {0x0637, 0x06db},                         // ARABIC LETTER TAH WITH THREE DOTS ABOVE
+	0x06a4: {0x06a1, 0x06db},                         // ARABIC LETTER VEH
+	0x06a7: {0x0641},                                 // ARABIC LETTER QAF WITH DOT ABOVE
+	0x06a8: {0x06a1, 0x06db},                         // ARABIC LETTER QAF WITH THREE DOTS ABOVE
+	0x06a9: {0x0643},                                 // ARABIC LETTER KEHEH
+	0x06aa: {0x0643},                                 // ARABIC LETTER SWASH KAF
+	0x06ad: {0x0643, 0x06db},                         // ARABIC LETTER NG
+	0x06b4: {0x06af, 0x06db},                         // ARABIC LETTER GAF WITH THREE DOTS ABOVE
+	0x06b5: {0x0644, 0x0306},                         // ARABIC LETTER LAM WITH SMALL V
+	0x06b7: {0x0644, 0x06db},                         // ARABIC LETTER LAM WITH THREE DOTS ABOVE
+	0x06ba: {0x0649},                                 // ARABIC LETTER NOON GHUNNA
+	0x06bb: {0x0649, 0x0615},                         // ARABIC LETTER RNOON
+	0x06bd: {0x0649, 0x06db},                         // ARABIC LETTER NOON WITH THREE DOTS ABOVE
+	0x06be: {0x006f},                                 // ARABIC LETTER HEH DOACHASHMEE
+	0x06c1: {0x006f},                                 // ARABIC LETTER HEH GOAL
+	0x06c3: {0x0629},                                 // ARABIC LETTER TEH MARBUTA GOAL
+	0x06c6: {0x0648, 0x0306},                         // ARABIC LETTER OE
+	0x06c7: {0x0648, 0x0313},                         // ARABIC LETTER U
+	0x06c8: {0x0648, 0x0670},                         // ARABIC LETTER YU
+	0x06c9: {0x0648, 0x0302},                         // ARABIC LETTER KIRGHIZ YU
+	0x06cb: {0x0648, 0x06db},                         // ARABIC LETTER VE
+	0x06cc: {0x0649},                                 // ARABIC LETTER FARSI YEH
+	0x06ce: {0x0649, 0x0306},                         // ARABIC LETTER YEH WITH SMALL V
+	0x06d0: {0x067b},                                 // ARABIC LETTER E
+	0x06d1: {0x0649, 0x06db},                         // ARABIC LETTER YEH WITH THREE DOTS BELOW
+	0x06d2: {0x0649},                                 // ARABIC LETTER YEH BARREE
+	0x06d4: {0x002d},                                 // ARABIC FULL STOP
+	0x06d5: {0x006f},                                 // ARABIC LETTER AE
+	0x06df: {0x030a},                                 // ARABIC SMALL HIGH ROUNDED ZERO
+	0x06e8: {0x0306, 0x0307},                         // ARABIC SMALL HIGH NOON
+	0x06ec: {0x0307},                                 // ARABIC ROUNDED HIGH STOP WITH FILLED CENTRE
+	0x06ee: {0x062f, 0x0302},                         // ARABIC LETTER DAL WITH INVERTED V
+	0x06ef: {0x0631, 0x0302},                         // ARABIC LETTER REH WITH INVERTED V
+	0x06f0: {0x002e},                                 // EXTENDED ARABIC-INDIC DIGIT ZERO
+	0x06f1: {0x006c},                                 // EXTENDED ARABIC-INDIC DIGIT ONE
+	0x06f2: {0x0662},                                 // EXTENDED ARABIC-INDIC DIGIT TWO
+	0x06f3: {0x0663},                                 // EXTENDED ARABIC-INDIC DIGIT THREE
+	0x06f4: {0x0664},                                 // EXTENDED ARABIC-INDIC DIGIT FOUR
+	0x06f5: {0x006f},                                 // EXTENDED ARABIC-INDIC DIGIT FIVE
+	0x06f6: {0x0666},                                 // EXTENDED ARABIC-INDIC DIGIT SIX
+	0x06f7: {0x0056},                                 // EXTENDED ARABIC-INDIC DIGIT SEVEN
+	0x06f8: {0x0245},                                 // EXTENDED ARABIC-INDIC DIGIT EIGHT
+	0x06f9: {0x0669},                                 // EXTENDED ARABIC-INDIC DIGIT NINE
+	0x06fd: {0x0621, 0x0348},                         // ARABIC SIGN SINDHI AMPERSAND
+	0x06fe: {0x0645, 0x0348},                         // ARABIC SIGN SINDHI POSTPOSITION MEN
+	0x06ff: {0x006f, 0x0302},                         // ARABIC LETTER HEH WITH INVERTED V
+
+	0x0701: {0x002e},         // SYRIAC SUPRALINEAR FULL STOP
+	0x0702: {0x002e},         // SYRIAC SUBLINEAR FULL STOP
+	0x0703: {0x003a},         // SYRIAC SUPRALINEAR COLON
+	0x0704: {0x003a},         // SYRIAC SUBLINEAR COLON
+	0x0740: {0x0307},         // SYRIAC FEMININE DOT
+	0x0741: {0x0307},         // SYRIAC QUSHSHAYA
+	0x0742: {0x073c},         // SYRIAC RUKKAKHA
+	0x0747: {0x0301},         // SYRIAC OBLIQUE LINE ABOVE
+	0x0751: {0x0628, 0x06db}, // ARABIC LETTER BEH WITH DOT BELOW AND THREE DOTS ABOVE
+	0x0756: {0x0649, 0x0306}, // ARABIC LETTER BEH WITH SMALL V
+	0x0762: {0x06ac},         // ARABIC LETTER KEHEH WITH DOT ABOVE
+	0x0763: {0x0643, 0x06db}, // ARABIC LETTER KEHEH WITH THREE DOTS ABOVE
+	0x0767: {0x0754},         // ARABIC LETTER NOON WITH TWO DOTS BELOW
+	0x0768: {0x0646, 0x0615}, // ARABIC LETTER NOON WITH SMALL TAH
+	0x0769: {0x0646, 0x0306}, // ARABIC LETTER NOON WITH SMALL V
+	0x076c: {0x0631, 0x0654}, // ARABIC LETTER REH WITH HAMZA ABOVE
+	0x0771: {0x0697, 0x0615}, // ARABIC LETTER REH WITH SMALL ARABIC LETTER TAH AND TWO DOTS
+	0x0772: {0x062d, 0x0654}, // ARABIC LETTER HAH WITH SMALL ARABIC LETTER TAH ABOVE
+	0x077e: {0x0633, 0x0302}, // ARABIC LETTER SEEN WITH INVERTED V
+	0x07c0: {0x004f},         // NKO DIGIT ZERO
+	0x07ca: {0x006c},         // NKO LETTER A
+	0x07eb: {0x0304},         // NKO COMBINING SHORT HIGH TONE
+	0x07ed: {0x0307},         // NKO COMBINING SHORT RISING TONE
+	0x07ee: {0x0302},         // NKO COMBINING LONG DESCENDING TONE
+	0x07f3: {0x0308},         // NKO COMBINING DOUBLE DOT ABOVE
+	0x07f4: {0x0027},         // NKO HIGH TONE APOSTROPHE
+	0x07f5: {0x0027},         // NKO LOW TONE APOSTROPHE
+	0x07fa: {0x005f},         // NKO LAJANYALAN
+
+	0x08a1: {0x0628, 0x0654},         // ARABIC LETTER BEH WITH HAMZA ABOVE
+	0x08a4: {0x06a2, 0x06db},         // ARABIC LETTER FEH WITH DOT BELOW AND THREE DOTS ABOVE
+	0x08a7: {0x0645, 0x06db},         // ARABIC LETTER MEEM WITH THREE DOTS ABOVE
+	0x08a8: {0x0649, 0x0654},         // ARABIC LETTER YEH WITH TWO DOTS BELOW AND HAMZA ABOVE
+	0x08a9: {0x0754},                 // ARABIC LETTER YEH WITH TWO DOTS BELOW AND DOT ABOVE
+	0x08ae: {0x062f, 0x0324, 0x0323}, // ARABIC LETTER DAL WITH THREE DOTS BELOW
+	0x08af: {0x0635, 0x0324, 0x0323}, // ARABIC LETTER SAD WITH THREE DOTS BELOW
+	0x08b0: {0x06af},                 // ARABIC LETTER GAF WITH INVERTED STROKE
+	0x08b1: {0x0648},                 // ARABIC LETTER STRAIGHT WAW
+	0x08b2: {0x0632, 0x0302},         // ARABIC LETTER ZAIN WITH INVERTED V ABOVE
+	0x08b6: {0x0628, 0x06e2},         // ARABIC LETTER BEH WITH SMALL MEEM ABOVE
+	0x08b7: {0x0649, 0x06db, 0x06e2}, // ARABIC LETTER PEH WITH SMALL MEEM ABOVE
+	0x08b9: {0x0631, 0x0306, 0x0307}, // ARABIC LETTER REH WITH SMALL NOON ABOVE
+	0x08ba: {0x0649, 0x0306, 0x0307}, // ARABIC LETTER YEH WITH TWO DOTS BELOW AND SMALL NOON ABOVE
+	0x08bb: {0x06a1},                 // ARABIC LETTER AFRICAN FEH
+	0x08bc: {0x06a1},                 // ARABIC LETTER AFRICAN QAF
+	0x08bd: {0x0649},                 // ARABIC LETTER AFRICAN NOON
+	0x08e5: {0x064c},                 // ARABIC CURLY DAMMA
+	0x08e8: {0x064c},                 // ARABIC CURLY DAMMATAN
+	0x08ea: {0x0307},                 // ARABIC TONE ONE DOT ABOVE
+	0x08eb: {0x0308},                 // ARABIC TONE TWO DOTS ABOVE
+	0x08ed: {0x0323},                 // ARABIC TONE ONE DOT BELOW
+	0x08ee: {0x0324},                 // ARABIC TONE TWO DOTS BELOW
+	0x08f0: {0x030b},                 // ARABIC OPEN FATHATAN
+	0x08f1: {0x064c},                 // ARABIC OPEN DAMMATAN
+	0x08f2: {0x064d},                 // ARABIC OPEN KASRATAN
+	0x08f3: {0x0313},                 // ARABIC SMALL HIGH WAW
+	0x08f8: {0x0350},                 // ARABIC RIGHT ARROWHEAD ABOVE
+	0x08f9: {0x0354},                 // ARABIC LEFT ARROWHEAD BELOW
+	0x08fa: {0x0355},                 // ARABIC RIGHT ARROWHEAD BELOW
+	0x08ff: {0x0350},                 // ARABIC MARK SIDEWAYS NOON GHUNNA
+
+	0x0900: {0x0352},                 // DEVANAGARI SIGN INVERTED CANDRABINDU
+	0x0901: {0x0306, 0x0307},         // DEVANAGARI SIGN CANDRABINDU
+	0x0902: {0x0307},                 // DEVANAGARI SIGN ANUSVARA
+	0x0903: {0x003a},                 // DEVANAGARI SIGN VISARGA
+	0x0904: {0x0905, 0x0946},         // DEVANAGARI LETTER SHORT A
+	0x0906: {0x0905, 0x093e},         // DEVANAGARI LETTER AA
+	0x0908: {0x0930, 0x094d, 0x0907}, // DEVANAGARI LETTER II
+	0x090d: {0x090f, 0x0945},         // DEVANAGARI LETTER CANDRA E
+	0x090e: {0x090f, 0x0946},         // DEVANAGARI LETTER SHORT E
+	0x0910: {0x090f, 0x0947},         // DEVANAGARI LETTER AI
+	0x0911: {0x0905, 0x0949},         // DEVANAGARI LETTER CANDRA O
+	0x0912: {0x0905, 0x093e, 0x0946}, // DEVANAGARI LETTER SHORT O
+	0x0913: {0x0905, 0x093e, 0x0947}, // DEVANAGARI LETTER O
+	0x0914: {0x0905, 0x093e, 0x0948}, // DEVANAGARI LETTER AU
+	0x093c: {0x0323},                 // DEVANAGARI SIGN NUKTA
+	0x0952: {0x0331},                 // DEVANAGARI STRESS SIGN ANUDATTA
+	0x0953: {0x0300},                 // DEVANAGARI GRAVE ACCENT
+	0x0954: {0x0301},                 // DEVANAGARI ACUTE ACCENT
+	0x0965: {0x0964, 0x0964},         // DEVANAGARI DOUBLE DANDA
+	0x0966: {0x006f},                 // DEVANAGARI DIGIT ZERO
+	0x0967: {0x0669},                 // DEVANAGARI DIGIT ONE
+	0x097d: {0x003f},                 // DEVANAGARI LETTER GLOTTAL STOP
+	0x0981: {0x0306, 0x0307},         // BENGALI SIGN CANDRABINDU
+	0x0986: {0x0985, 0x09be},         // BENGALI LETTER AA
+	0x09bc: {0x0323},                 // BENGALI SIGN NUKTA
+	0x09e0: {0x098b, 0x09c3},         // BENGALI LETTER VOCALIC RR
+	0x09e1: {0x098b, 0x09c3},         // BENGALI LETTER VOCALIC LL
+	0x09e6: {0x004f},                 // BENGALI DIGIT ZERO
+	0x09ea: {0x0038},                 // BENGALI DIGIT FOUR
+	0x09ed: {0x0039},                 // BENGALI DIGIT SEVEN
+
+	0x0a02: {0x0307},                 // GURMUKHI SIGN BINDI
+	0x0a03: {0x0983},                 // GURMUKHI SIGN VISARGA
+	0x0a06: {0x0a05, 0x0a3e},         // GURMUKHI LETTER AA
+	0x0a07: {0x0a72, 0x0a3f},         // GURMUKHI LETTER I
+	0x0a08: {0x0a72, 0x0a40},         // GURMUKHI LETTER II
+	0x0a09: {0x0a73, 0x0a41},         // GURMUKHI LETTER U
+	0x0a0a: {0x0a73, 0x0a42},         // GURMUKHI LETTER UU
+	0x0a0f: {0x0a72, 0x0a47},         // GURMUKHI LETTER EE
+	0x0a10: {0x0a05, 0x0a48},         // GURMUKHI LETTER AI
+	0x0a14: {0x0a05, 0x0a4c},         // GURMUKHI LETTER AU
+	0x0a3c: {0x0323},                 // GURMUKHI SIGN NUKTA
+	0x0a4b: {0x0946},                 // GURMUKHI VOWEL SIGN OO
+	0x0a4d: {0x094d},                 // GURMUKHI SIGN VIRAMA
+	0x0a66: {0x006f},                 // GURMUKHI DIGIT ZERO
+	0x0a67: {0x0039},                 // GURMUKHI DIGIT ONE
+	0x0a6a: {0x0038},                 // GURMUKHI DIGIT FOUR
+	0x0a81: {0x0306, 0x0307},         // GUJARATI SIGN CANDRABINDU
+	0x0a82: {0x0307},                 // GUJARATI SIGN ANUSVARA
+	0x0a83: {0x003a},                 // GUJARATI SIGN VISARGA
+	0x0a86: {0x0a85, 0x0abe},         // GUJARATI LETTER AA
+	0x0a8d: {0x0a85, 0x0ac5},         // GUJARATI VOWEL CANDRA E
+	0x0a8f: {0x0a85, 0x0ac7},         // GUJARATI LETTER E
+	0x0a90: {0x0a85, 0x0ac8},         // GUJARATI LETTER AI
+	0x0a91: {0x0a85, 0x0abe, 0x0ac5}, // GUJARATI VOWEL CANDRA O
+	0x0a93: {0x0a85, 0x0abe, 0x0ac7}, // GUJARATI LETTER O
+	0x0a94: {0x0a85, 0x0abe, 0x0ac8}, // GUJARATI LETTER AU
+	0x0abc: {0x0323},                 // GUJARATI SIGN NUKTA
+	0x0abd: {0x093d},                 // GUJARATI SIGN AVAGRAHA
+	0x0ac1: {0x0941},                 // GUJARATI VOWEL SIGN U
+	0x0ac2: {0x0942},                 // GUJARATI VOWEL SIGN UU
+	0x0acd: {0x094d},                 // GUJARATI SIGN VIRAMA
+	0x0ae6: {0x006f},                 // GUJARATI DIGIT ZERO
+	0x0ae8: {0x0968},                 // GUJARATI DIGIT TWO
+	0x0ae9: {0x0969},                 // GUJARATI DIGIT THREE
+	0x0aea: {0x096a},                 // GUJARATI DIGIT FOUR
+	0x0aee: {0x096e},                 // GUJARATI DIGIT EIGHT
+	0x0af0: {0x0970},                 // GUJARATI ABBREVIATION SIGN
+
+	0x0b01: {0x0306, 0x0307}, // ORIYA SIGN CANDRABINDU
+	0x0b03: {0x0038},         // ORIYA SIGN VISARGA
+	0x0b06: {0x0b05, 0x0b3e}, // ORIYA LETTER AA
+	0x0b20: {0x004f},         // ORIYA LETTER TTHA
+	0x0b3c: {0x0323},         // ORIYA SIGN NUKTA
+	0x0b66: {0x004f},         // ORIYA DIGIT ZERO
+	0x0b68: {0x0039},         // ORIYA DIGIT TWO
+	0x0b82: {0x030a},         // TAMIL SIGN ANUSVARA
+	0x0b8a: {0x0b89, 0x0bb3}, // TAMIL LETTER UU
+	0x0b9c: {0x0b90},         // TAMIL LETTER JA
+	0x0bb0: {0x0b88},         // TAMIL LETTER RA
+	0x0bbe: {0x0b88},         // TAMIL VOWEL SIGN AA
+	0x0bc8: {0x0ba9},         // TAMIL VOWEL SIGN AI
+	0x0bcd: {0x0307},         // TAMIL SIGN VIRAMA
+	0x0bd7: {0x0bb3},         // TAMIL AU LENGTH MARK
+	0x0be6: {0x006f},         // TAMIL DIGIT ZERO
+	0x0be7: {0x0b95},         // TAMIL DIGIT ONE
+	0x0be8: {0x0b89},         // TAMIL DIGIT TWO
+	0x0bea: {0x0b9a},         // TAMIL DIGIT FOUR
+	0x0beb: {0x0b88, 0x0bc1}, // TAMIL DIGIT FIVE
+	0x0bec: {0x0b9a, 0x0bc1}, // TAMIL DIGIT SIX
+	0x0bed: {0x0b8e},         // TAMIL DIGIT SEVEN
+	0x0bee: {0x0b85},         // TAMIL DIGIT EIGHT
+	0x0bf0: {0x0baf},         // TAMIL NUMBER TEN
+	0x0bf2: {0x0b9a, 0x0bc2}, // TAMIL NUMBER ONE THOUSAND
+	0x0bf4: {0x0bae, 0x0bc0}, // TAMIL MONTH SIGN
+	0x0bf5: {0x0bf3},         // TAMIL YEAR SIGN
+	0x0bf7: {0x0b8e, 0x0bb5}, // TAMIL CREDIT SIGN
+	0x0bf8: {0x0bb7},         // TAMIL AS ABOVE SIGN
+	0x0bfa: {0x0ba8, 0x0bc0}, // TAMIL NUMBER SIGN
+
+	0x0c00: {0x0306, 0x0307}, // TELUGU SIGN COMBINING CANDRABINDU ABOVE
+	0x0c02: {0x006f},         // TELUGU SIGN ANUSVARA
+	0x0c03: {0x0983},         // TELUGU SIGN VISARGA
+	0x0c13: {0x0c12, 0x0c55}, // TELUGU LETTER OO
+	0x0c14: {0x0c12, 0x0c4c}, // TELUGU LETTER AU
+	0x0c20: {0x0c30, 0x05bc}, // TELUGU LETTER TTHA
+	0x0c22: {0x0c21, 0x0323}, // TELUGU LETTER DDHA
+	0x0c25: {0x0c27, 0x05bc}, // TELUGU LETTER THA
+	0x0c2d: {0x0c2c, 0x0323}, // TELUGU LETTER BHA
+	0x0c2e: {0x0c35, 0x0c41}, // TELUGU LETTER MA
+	0x0c37: {0x0c35, 0x0323}, // TELUGU LETTER SSA
+	0x0c39: {0x0c35, 0x0c3e}, // TELUGU LETTER HA
+	0x0c42: {0x0c41, 0x0c3e}, // TELUGU VOWEL SIGN UU
+	0x0c44: {0x0c43, 0x0c3e}, // TELUGU VOWEL SIGN VOCALIC RR
+	0x0c60: {0x0c0b, 0x0c3e}, // TELUGU LETTER VOCALIC RR
+	0x0c61: {0x0c0c, 0x0c3e}, // TELUGU LETTER VOCALIC LL
+	0x0c66: {0x006f},         // TELUGU DIGIT ZERO
+	0x0c81: {0x0306, 0x0307}, // KANNADA SIGN CANDRABINDU
+	0x0c82: {0x006f},         // KANNADA SIGN ANUSVARA
+	0x0c83: {0x0983},         // KANNADA SIGN VISARGA
+	0x0c85: {0x0c05},         // KANNADA LETTER A
+	0x0c86: {0x0c06},         // KANNADA LETTER AA
+	0x0c87: {0x0c07},         // KANNADA LETTER I
+	0x0c92: {0x0c12},         // KANNADA LETTER O
+	0x0c93: {0x0c12, 0x0c55}, // KANNADA LETTER OO
+	0x0c94: {0x0c12, 0x0c4c}, // KANNADA LETTER AU
+	0x0c9c: {0x0c1c},         // KANNADA LETTER JA
+	0x0c9e: {0x0c1e},         // KANNADA LETTER NYA
+	0x0ca3: {0x0c23},         // KANNADA LETTER NNA
+	0x0caf: {0x0c2f},         // KANNADA LETTER YA
+	0x0cb1: {0x0c31},         // KANNADA LETTER RRA
+	0x0cb2: {0x0c32},         // KANNADA LETTER LA
+	0x0ce1: {0x0c8c, 0x0cbe}, // KANNADA LETTER VOCALIC LL
+	0x0ce6: {0x006f},         // KANNADA DIGIT ZERO
+	0x0ce7: {0x0c67},         // KANNADA DIGIT ONE
+	0x0ce8: {0x0c68},         // KANNADA DIGIT TWO
+	0x0cef: {0x0c6f},         // KANNADA DIGIT NINE
+
+	0x0d01: {0x0306, 0x0307},         // MALAYALAM SIGN CANDRABINDU
+	0x0d02: {0x006f},                 // MALAYALAM SIGN ANUSVARA
+	0x0d03: {0x0983},                 // MALAYALAM SIGN VISARGA
+	0x0d08: {0x0d07, 0x0d57},         // MALAYALAM LETTER II
+	0x0d09: {0x0b89},                 // MALAYALAM LETTER U
+	0x0d0a: {0x0b89, 0x0d57},         // MALAYALAM LETTER UU
+	0x0d0c: {0x0d28, 0x0d41},         // MALAYALAM LETTER VOCALIC L
+	0x0d10: {0x0d0e, 0x0d46},         // MALAYALAM LETTER AI
+	0x0d13: {0x0d12, 0x0d3e},         // MALAYALAM LETTER OO
+	0x0d14: {0x0d12, 0x0d57},         // MALAYALAM LETTER AU
+	0x0d19: {0x0d28, 0x0d41},         // MALAYALAM LETTER NGA
+	0x0d1c: {0x0b90},                 // MALAYALAM LETTER JA
+	0x0d20: {0x006f},                 // MALAYALAM LETTER TTHA
+	0x0d23: {0x0ba3},                 // MALAYALAM LETTER NNA
+	0x0d31: {0x0d30},                 // MALAYALAM LETTER RRA
+	0x0d34: {0x0bb4},                 // MALAYALAM LETTER LLLA
+	0x0d36: {0x0bb6},                 // MALAYALAM LETTER SHA
+	0x0d3a: {0x0b9f, 0x0bbf},         // MALAYALAM LETTER TTTA
+	0x0d3f: {0x0bbf},                 // MALAYALAM VOWEL SIGN I
+	0x0d40: {0x0bbf},                 // MALAYALAM VOWEL SIGN II
+	0x0d42: {0x0d41},                 // MALAYALAM VOWEL SIGN UU
+	0x0d43: {0x0d41},                 // MALAYALAM VOWEL SIGN VOCALIC R
+	0x0d48: {0x0d46, 0x0d46},         // MALAYALAM VOWEL SIGN AI
+	0x0d4e: {0x0971},                 // MALAYALAM LETTER DOT REPH
+	0x0d5a: {0x0d28, 0x0d4d, 0x0d2e}, // MALAYALAM FRACTION THREE EIGHTIETHS
+	0x0d5f: {0x006f, 0x0d30, 0x006f}, // MALAYALAM LETTER ARCHAIC II
+	0x0d61: {0x0d1e},                 // MALAYALAM LETTER VOCALIC LL
+	0x0d66: {0x006f},                 // MALAYALAM DIGIT ZERO
+	0x0d6a: {0x0d30, 0x0d4d},         // MALAYALAM DIGIT FOUR
+	0x0d6b: {0x0d26, 0x0d4d, 0x0d30}, // MALAYALAM DIGIT FIVE
+	0x0d6c: {0x0d28, 0x0d4d, 0x0d28}, // MALAYALAM DIGIT SIX
+	0x0d6d: {0x0039},                 // MALAYALAM DIGIT SEVEN
+	0x0d6e: {0x0d35, 0x0d4d, 0x0d30}, // MALAYALAM DIGIT EIGHT
+	0x0d6f: {0x0d28, 0x0d4d},         // MALAYALAM DIGIT NINE
+	0x0d76: {0x0d39, 0x0d4d, 0x0d2e}, // MALAYALAM FRACTION ONE SIXTEENTH
+	0x0d79: {0x0d28, 0x0d41},         // MALAYALAM DATE MARK
+	0x0d7b: {0x0d28, 0x0d4d},         // MALAYALAM LETTER CHILLU N
+	0x0d7c: {0x0d30, 0x0d4d},         // MALAYALAM LETTER CHILLU RR
+	0x0d82: {0x006f},                 // SINHALA SIGN ANUSVARAYA
+	0x0d83: {0x0983},                 // SINHALA SIGN VISARGAYA
+	0x0de9: {0x0de8, 0x0dcf},         // SINHALA LITH DIGIT THREE
+	0x0dea: {0x0da2},                 // SINHALA LITH DIGIT FOUR
+	0x0deb: {0x0daf},                 // SINHALA LITH DIGIT FIVE
+	0x0def: {0x0de8, 0x0dd3},         // SINHALA LITH DIGIT NINE
+
+	0x0e03: {0x0e02},         // THAI CHARACTER KHO KHUAT
+	0x0e0b: {0x0e0a},         // THAI CHARACTER SO SO
+	0x0e0f: {0x0e0e},         // THAI CHARACTER TO PATAK
+	0x0e14: {0x0e04},         // THAI CHARACTER DO DEK
+	0x0e15: {0x0e04},         // THAI CHARACTER TO TAO
+	0x0e17: {0x0e11},         // THAI CHARACTER THO THAHAN
+	0x0e21: {0x0e06},         // THAI CHARACTER MO MA
+	0x0e26: {0x0e20},         // THAI CHARACTER LU
+	0x0e33: {0x030a, 0x0e32}, // THAI CHARACTER SARA AM
+	0x0e41: {0x0e40, 0x0e40}, // THAI CHARACTER SARA AE
+	0x0e45: {0x0e32},         // THAI CHARACTER LAKKHANGYAO
+	0x0e4d: {0x030a},         // THAI CHARACTER NIKHAHIT
+	0x0e50: {0x006f},         // THAI DIGIT ZERO
+	0x0e88: {0x0e08},         // LAO LETTER CO
+	0x0e8d: {0x0e22},         // LAO LETTER NYO
+	0x0e9a: {0x0e1a},         // LAO LETTER BO
+	0x0e9b: {0x0e1b},         // LAO LETTER PO
+	0x0e9d: {0x0e1d},         // LAO LETTER FO TAM
+	0x0e9e: {0x0e1e},         // LAO LETTER PHO TAM
+	0x0e9f: {0x0e1f},         // LAO LETTER FO SUNG
+	0x0eb3: {0x030a, 0x0eb2}, // LAO VOWEL SIGN AM
+	0x0eb8: {0x0e38},         // LAO VOWEL SIGN U
+	0x0eb9: {0x0e39},         // LAO VOWEL SIGN UU
+	0x0ec8: {0x0e48},         // LAO TONE MAI EK
+	0x0ec9: {0x0e49},         // LAO TONE MAI THO
+	0x0eca: {0x0e4a},         // LAO TONE MAI TI
+	0x0ecb: {0x0e4b},         // LAO TONE MAI CATAWA
+	0x0ecd: {0x030a},         // LAO NIGGAHITA
+	0x0ed0: {0x006f},         // LAO DIGIT ZERO
+	0x0edc: {0x0eab, 0x0e99}, // LAO HO NO
+	0x0edd: {0x0eab, 0x0ea1}, // LAO HO MO
+
+	0x0f00: {0x0f68, 0x0f7c, 0x0f7e},         // TIBETAN SYLLABLE OM
+	0x0f02: {0x0f60, 0x0f74, 0x0f82, 0x0f7f}, // TIBETAN MARK GTER YIG MGO -UM RNAM BCAD MA
+	0x0f03: {0x0f60, 0x0f74, 0x0f82, 0x0f14}, // TIBETAN MARK GTER YIG MGO -UM GTER TSHEG MA
+	0x0f0c: {0x0f0b},                         // TIBETAN MARK DELIMITER TSHEG BSTAR
+	0x0f0e: {0x0f0d, 0x0f0d},                 // TIBETAN MARK NYIS SHAD
+	0x0f1b: {0x0f1a, 0x0f1a},                 // TIBETAN SIGN RDEL DKAR GNYIS
+	0x0f1e: {0x0f1d, 0x0f1d},                 // TIBETAN SIGN RDEL NAG GNYIS
+	0x0f1f: {0x0f1a, 0x0f1d},                 // TIBETAN SIGN RDEL DKAR RDEL NAG
+	0x0f37: {0x0325},                         // TIBETAN MARK NGAS BZUNG SGOR RTAGS
+	0x0f6a: {0x0f62},                         // TIBETAN LETTER FIXED-FORM RA
+	0x0f77: {0x0fb2, 0x0f71, 0x0f80},         // TIBETAN VOWEL SIGN VOCALIC RR
+	0x0f79: {0x0fb3, 0x0f71, 0x0f80},         // TIBETAN VOWEL SIGN VOCALIC LL
+	0x0fce: {0x0f1d, 0x0f1a},                 // TIBETAN SIGN RDEL NAG RDEL DKAR
+	0x0fd5: {0x5350},                         // RIGHT-FACING SVASTI SIGN
+	0x0fd6: {0x534d},                         // LEFT-FACING SVASTI SIGN
+
+	0x1000: {0x1002, 0x102c},                         // MYANMAR LETTER KA
+	0x1010: {0x006f, 0x102c},                         // MYANMAR LETTER TA
+	0x101d: {0x006f},                                 // MYANMAR LETTER WA
+	0x101f: {0x1015, 0x102c},                         // MYANMAR LETTER HA
+	0x1029: {0x101e, 0x103c},                         // MYANMAR LETTER O
+	0x102a: {0x101e, 0x103c, 0x1031, 0x102c, 0x103a}, // MYANMAR LETTER AU
+	0x1036: {0x030a},                                 // MYANMAR SIGN ANUSVARA
+	0x1038: {0x0983},                                 // MYANMAR SIGN VISARGA
+	0x1040: {0x006f},                                 // MYANMAR DIGIT ZERO
+	0x104b: {0x104a, 0x104a},                         // MYANMAR SIGN SECTION
+	0x1065: {0x1041},                                 // MYANMAR LETTER WESTERN PWO KAREN THA
+	0x1066: {0x1015, 0x103e},                         // MYANMAR LETTER WESTERN PWO KAREN PWA
+	0x106f: {0x1015, 0x102c, 0x103e},                 // MYANMAR LETTER EASTERN PWO KAREN YWA
+	0x1070: {0x1003, 0x103e},                         // MYANMAR LETTER EASTERN PWO KAREN GHWA
+	0x107e: {0x107d, 0x103e},                         // MYANMAR LETTER SHAN FA
+	0x1081: {0x1002, 0x103e},                         // MYANMAR LETTER SHAN HA
+	0x109e: {0x1083, 0x030a},                         // MYANMAR SYMBOL SHAN ONE
+	0x10a0: {0xa786},                                 // GEORGIAN CAPITAL LETTER AN
+	0x10e7: {0x0079},                                 // GEORGIAN LETTER QAR
+	0x10f3: {0x021d},                                 // GEORGIAN LETTER WE
+	0x10ff: {0x006f},                                 // GEORGIAN LETTER LABIAL SIGN
+
+	0x1101: {0x1100, 0x1100},         // HANGUL CHOSEONG SSANGKIYEOK
+	0x1104: {0x1103, 0x1103},         // HANGUL CHOSEONG SSANGTIKEUT
+	0x1108: {0x1107, 0x1107},         // HANGUL CHOSEONG SSANGPIEUP
+	0x110a: {0x1109, 0x1109},         // HANGUL CHOSEONG SSANGSIOS
+	0x110d: {0x110c, 0x110c},         // HANGUL CHOSEONG SSANGCIEUC
+	0x1113: {0x1102, 0x1100},         // HANGUL CHOSEONG NIEUN-KIYEOK
+	0x1114: {0x1102, 0x1102},         // HANGUL CHOSEONG SSANGNIEUN
+	0x1115: {0x1102, 0x1103},         // HANGUL CHOSEONG NIEUN-TIKEUT
+	0x1116: {0x1102, 0x1107},         // HANGUL CHOSEONG NIEUN-PIEUP
+	0x1117: {0x1103, 0x1100},         // HANGUL CHOSEONG TIKEUT-KIYEOK
+	0x1118: {0x1105, 0x1102},         // HANGUL CHOSEONG RIEUL-NIEUN
+	0x1119: {0x1105, 0x1105},         // HANGUL CHOSEONG SSANGRIEUL
+	0x111a: {0x1105, 0x1112},         // HANGUL CHOSEONG RIEUL-HIEUH
+	0x111b: {0x1105, 0x110b},         // HANGUL CHOSEONG KAPYEOUNRIEUL
+	0x111c: {0x1106, 0x1107},         // HANGUL CHOSEONG MIEUM-PIEUP
+	0x111d: {0x1106, 0x110b},         // HANGUL CHOSEONG KAPYEOUNMIEUM
+	0x111e: {0x1107, 0x1100},         // HANGUL CHOSEONG PIEUP-KIYEOK
+	0x111f: {0x1107, 0x1102},         // HANGUL CHOSEONG PIEUP-NIEUN
+	0x1120: {0x1107, 0x1103},         // HANGUL CHOSEONG PIEUP-TIKEUT
+	0x1121: {0x1107, 0x1109},         // HANGUL CHOSEONG PIEUP-SIOS
+	0x1122: {0x1107, 0x1109, 0x1100}, // HANGUL CHOSEONG PIEUP-SIOS-KIYEOK
+	0x1123: {0x1107, 0x1109, 0x1103}, // HANGUL CHOSEONG PIEUP-SIOS-TIKEUT
+	0x1124: {0x1107, 0x1109, 0x1107}, // HANGUL CHOSEONG PIEUP-SIOS-PIEUP
+	0x1125: {0x1107, 0x1109, 0x1109}, // HANGUL CHOSEONG PIEUP-SSANGSIOS
+	0x1126: {0x1107, 0x1109, 0x110c}, // HANGUL CHOSEONG PIEUP-SIOS-CIEUC
+	0x1127: {0x1107, 0x110c},         // HANGUL CHOSEONG PIEUP-CIEUC
+	0x1128: {0x1107, 0x110e},         // HANGUL CHOSEONG PIEUP-CHIEUCH
+	0x1129: {0x1107, 0x1110},         // HANGUL CHOSEONG PIEUP-THIEUTH
+	0x112a: {0x1107, 0x1111},         // HANGUL CHOSEONG PIEUP-PHIEUPH
+	0x112b: {0x1107, 0x110b},         // HANGUL CHOSEONG KAPYEOUNPIEUP
+	0x112c: {0x1107, 0x1107, 0x110b}, // HANGUL CHOSEONG KAPYEOUNSSANGPIEUP
+	0x112d: {0x1109, 0x1100},         // HANGUL CHOSEONG SIOS-KIYEOK
+	0x112e: {0x1109, 0x1102},         // HANGUL CHOSEONG SIOS-NIEUN
+	0x112f: {0x1109, 0x1103},         // HANGUL CHOSEONG SIOS-TIKEUT
+	0x1130: {0x1109, 0x1105},         // HANGUL CHOSEONG SIOS-RIEUL
+	0x1131: {0x1109, 0x1106},         // HANGUL CHOSEONG SIOS-MIEUM
+	0x1132: {0x1109, 0x1107},         // HANGUL CHOSEONG SIOS-PIEUP
+	0x1133: {0x1109, 0x1107, 0x1100}, // HANGUL CHOSEONG SIOS-PIEUP-KIYEOK
+	0x1134: {0x1109, 0x1109, 0x1109}, // HANGUL CHOSEONG SIOS-SSANGSIOS
+	0x1135: {0x1109, 0x110b},         // HANGUL CHOSEONG SIOS-IEUNG
+	0x1136: {0x1109, 0x110c},         // HANGUL CHOSEONG SIOS-CIEUC
+	0x1137: {0x1109, 0x110e},         // HANGUL CHOSEONG SIOS-CHIEUCH
+	0x1138: {0x1109, 0x110f},         // HANGUL CHOSEONG SIOS-KHIEUKH
+	0x1139: {0x1109, 0x1110},         // HANGUL CHOSEONG SIOS-THIEUTH
+	0x113a: {0x1109, 0x1111},         // HANGUL CHOSEONG SIOS-PHIEUPH
+	0x113b: {0x1105, 0x1112},         // HANGUL CHOSEONG SIOS-HIEUH
+	0x113d: {0x113c, 0x113c},         // HANGUL CHOSEONG CHITUEUMSSANGSIOS
+	0x113f: {0x113e, 0x113e},         // HANGUL CHOSEONG CEONGCHIEUMSSANGSIOS
+	0x1141: {0x110b, 0x1100},         // HANGUL CHOSEONG IEUNG-KIYEOK
+	0x1142: {0x110b, 0x1103},         // HANGUL CHOSEONG IEUNG-TIKEUT
+	0x1143: {0x110b, 0x1106},         // HANGUL CHOSEONG IEUNG-MIEUM
+	0x1144: {0x110b, 0x1107},         // HANGUL CHOSEONG IEUNG-PIEUP
+	0x1145: {0x110b, 0x1109},         // HANGUL CHOSEONG IEUNG-SIOS
+	0x1146: {0x110b, 0x1140},         // HANGUL CHOSEONG IEUNG-PANSIOS
+	0x1147: {0x110b, 0x110b},         // HANGUL CHOSEONG SSANGIEUNG
+	0x1148: {0x110b, 0x110c},         // HANGUL CHOSEONG IEUNG-CIEUC
+	0x1149: {0x110b, 0x110e},         // HANGUL CHOSEONG IEUNG-CHIEUCH
+	0x114a: {0x110b, 0x1110},         // HANGUL CHOSEONG IEUNG-THIEUTH
+	0x114b: {0x110b, 0x1111},         // HANGUL CHOSEONG IEUNG-PHIEUPH
+	0x114d: {0x110c, 0x110b},         // HANGUL CHOSEONG CIEUC-IEUNG
+	0x114f: {0x114e, 0x114e},         // HANGUL CHOSEONG CHITUEUMSSANGCIEUC
+	0x1151: {0x1150, 0x1150},         // HANGUL CHOSEONG CEONGCHIEUMSSANGCIEUC
+	0x1152: {0x110e, 0x110f},         // HANGUL CHOSEONG CHIEUCH-KHIEUKH
+	0x1153: {0x110e, 0x1112},         // HANGUL CHOSEONG CHIEUCH-HIEUH
+	0x1156: {0x1111, 0x1107},         // HANGUL CHOSEONG PHIEUPH-PIEUP
+	0x1157: {0x1111, 0x110b},         // HANGUL CHOSEONG KAPYEOUNPHIEUPH
+	0x1158: {0x1112, 0x1112},         // HANGUL CHOSEONG SSANGHIEUH
+	0x115a: {0x1100, 0x1103},         // HANGUL CHOSEONG KIYEOK-TIKEUT
+	0x115b: {0x1102, 0x1109},         // HANGUL CHOSEONG NIEUN-SIOS
+	0x115c: {0x1102, 0x110c},         // HANGUL CHOSEONG NIEUN-CIEUC
+	0x115d: {0x1102, 0x1112},         // HANGUL CHOSEONG NIEUN-HIEUH
+	0x115e: {0x1103, 0x1105},         // HANGUL CHOSEONG TIKEUT-RIEUL
+	0x1162: {0x1161, 0x4e28},         // HANGUL JUNGSEONG AE
+	0x1164: {0x1163, 0x4e28},         // HANGUL JUNGSEONG YAE
+	0x1166: {0x1165, 0x4e28},         // HANGUL JUNGSEONG E
+	0x1168: {0x1167, 0x4e28},         // HANGUL JUNGSEONG YE
+	0x116a: {0x1169, 0x1161},         // HANGUL JUNGSEONG WA
+	0x116b: {0x1169, 0x1161, 0x4e28}, // HANGUL JUNGSEONG WAE
+	0x116c: {0x1169, 0x4e28},         // HANGUL JUNGSEONG OE
+	0x116f: {0x116e, 0x1165},         // HANGUL JUNGSEONG WEO
+	0x1170: {0x116e, 0x1165, 0x4e28}, // HANGUL JUNGSEONG WE
+	0x1171: {0x116e, 0x4e28},         // HANGUL JUNGSEONG WI
+	0x1173: {0x30fc},                 // HANGUL JUNGSEONG EU
+	0x1174: {0x30fc, 0x4e28},         // HANGUL JUNGSEONG YI
+	0x1175: {0x4e28},                 // HANGUL JUNGSEONG I
+	0x1176: {0x1161, 0x1169},         // HANGUL JUNGSEONG A-O
+	0x1177: {0x1161, 0x116e},         // HANGUL JUNGSEONG A-U
+	0x1178: {0x1163, 0x1169},         // HANGUL JUNGSEONG YA-O
+	0x1179: {0x1163, 0x116d},         // HANGUL JUNGSEONG YA-YO
+	0x117a: {0x1165, 0x1169},         // HANGUL JUNGSEONG EO-O
+	0x117b: {0x1165, 0x116e},         // HANGUL JUNGSEONG EO-U
+	0x117c: {0x1165, 0x30fc},         // HANGUL JUNGSEONG EO-EU
+	0x117d: {0x1167, 0x1169},         // HANGUL JUNGSEONG YEO-O
+	0x117e: {0x1167, 0x116e},         // HANGUL JUNGSEONG YEO-U
+	0x117f: {0x1169, 0x1165},         // HANGUL JUNGSEONG O-EO
+	0x1180: {0x1169, 0x1165, 0x4e28}, // HANGUL JUNGSEONG O-E
+	0x1181: {0x1169, 0x1167, 0x4e28}, // HANGUL JUNGSEONG O-YE
+	0x1182: {0x1169, 0x1169},         // HANGUL JUNGSEONG O-O
+	0x1183: {0x1169, 0x116e},         // HANGUL JUNGSEONG O-U
+	0x1184: {0x116d, 0x1163},         // HANGUL JUNGSEONG YO-YA
+	0x1185: {0x116d, 0x1163, 0x4e28}, // HANGUL JUNGSEONG YO-YAE
+	0x1186: {0x116d, 0x1163},         // HANGUL JUNGSEONG YO-YEO
+	0x1187: {0x116d, 0x1169},         // HANGUL JUNGSEONG YO-O
+	0x1188: {0x116d, 0x4e28},         // HANGUL JUNGSEONG YO-I
+	0x1189: {0x116e, 0x1161},         // HANGUL JUNGSEONG U-A
+	0x118a: {0x116e, 0x1161, 0x4e28}, // HANGUL JUNGSEONG U-AE
+	0x118b: {0x116e, 0x1165, 0x30fc}, // HANGUL JUNGSEONG U-EO-EU
+	0x118c: {0x116e, 0x1167, 0x4e28}, // HANGUL JUNGSEONG U-YE
+	0x118d: {0x116e, 0x116e},         // HANGUL JUNGSEONG U-U
+	0x118e: {0x1172, 0x1161},         // HANGUL JUNGSEONG YU-A
+	0x118f: {0x1172, 0x1165},         // HANGUL JUNGSEONG YU-EO
+	0x1190: {0x1172, 0x1165, 0x4e28}, // HANGUL JUNGSEONG YU-E
+	0x1191: {0x1172, 0x1167},         // HANGUL JUNGSEONG YU-YEO
+	0x1192: {0x1172, 0x1167, 0x4e28}, // HANGUL JUNGSEONG YU-YE
+	0x1193: {0x1172, 0x116e},         // HANGUL JUNGSEONG YU-U
+	0x1194: {0x1172, 0x4e28},         // HANGUL JUNGSEONG YU-I
+	0x1195: {0x30fc, 0x116e},         // HANGUL JUNGSEONG EU-U
+	0x1196: {0x30fc, 0x30fc},         // HANGUL JUNGSEONG EU-EU
+	0x1197: {0x30fc, 0x4e28, 0x116e}, // HANGUL JUNGSEONG YI-U
+	0x1198: {0x4e28, 0x1161},         // HANGUL JUNGSEONG I-A
+	0x1199: {0x4e28, 0x1163},         // HANGUL JUNGSEONG I-YA
+	0x119a: {0x4e28, 0x1169},         // HANGUL JUNGSEONG I-O
+	0x119b: {0x4e28, 0x116e},         // HANGUL JUNGSEONG I-U
+	0x119c: {0x4e28, 0x30fc},         // HANGUL JUNGSEONG I-EU
+	0x119d: {0x4e28, 0x119e},         // HANGUL JUNGSEONG I-ARAEA
+	0x119f: {0x119e, 0x1165},         // HANGUL JUNGSEONG ARAEA-EO
+	0x11a0: {0x119e, 0x116e},         // HANGUL JUNGSEONG ARAEA-U
+	0x11a1: {0x119e, 0x4e28},         // HANGUL JUNGSEONG ARAEA-I
+	0x11a2: {0x119e, 0x119e},         // HANGUL JUNGSEONG SSANGARAEA
+	0x11a3: {0x1161, 0x30fc},         // HANGUL JUNGSEONG A-EU
+	0x11a4: {0x1163, 0x116e},         // HANGUL JUNGSEONG YA-U
+	0x11a5: {0x1167, 0x1163},         // HANGUL JUNGSEONG YEO-YA
+	0x11a6: {0x1169, 0x1163},         // HANGUL JUNGSEONG O-YA
+	0x11a7: {0x1169, 0x1163, 0x4e28}, // HANGUL JUNGSEONG O-YAE
+	0x11a8: {0x1100},                 // HANGUL JONGSEONG KIYEOK
+	0x11a9: {0x1100, 0x1100},         // HANGUL JONGSEONG SSANGKIYEOK
+	0x11aa: {0x1100, 0x1109},         // HANGUL JONGSEONG KIYEOK-SIOS
+	0x11ab: {0x1102},                 // HANGUL JONGSEONG NIEUN
+	0x11ac: {0x1102, 0x110c},         // HANGUL JONGSEONG NIEUN-CIEUC
+	0x11ad: {0x1102, 0x1112},         // HANGUL JONGSEONG NIEUN-HIEUH
+	0x11ae: {0x1103},                 // HANGUL JONGSEONG TIKEUT
+	0x11af: {0x1105},                 // HANGUL JONGSEONG RIEUL
+	0x11b0: {0x1105, 0x1100},         // HANGUL JONGSEONG RIEUL-KIYEOK
+	0x11b1: {0x1105, 0x1106},         // HANGUL JONGSEONG RIEUL-MIEUM
+	0x11b2: {0x1105, 0x1107},         // HANGUL JONGSEONG RIEUL-PIEUP
+	0x11b3: {0x1105, 0x1109},         // HANGUL JONGSEONG RIEUL-SIOS
+	0x11b4: {0x1105, 0x1110},         // HANGUL JONGSEONG RIEUL-THIEUTH
+	0x11b5: {0x1105, 0x1111},         // HANGUL JONGSEONG RIEUL-PHIEUPH
+	0x11b6: {0x1105, 0x1112},         // HANGUL JONGSEONG RIEUL-HIEUH
+	0x11b7: {0x1106},                 // HANGUL JONGSEONG MIEUM
+	0x11b8: {0x1107},                 // HANGUL JONGSEONG PIEUP
+	0x11b9: {0x1107, 0x1109},         // HANGUL JONGSEONG PIEUP-SIOS
+	0x11ba: {0x1109},                 // HANGUL JONGSEONG SIOS
+	0x11bb: {0x1109, 0x1109},         // HANGUL JONGSEONG SSANGSIOS
+	0x11bc: {0x110b},                 // HANGUL JONGSEONG IEUNG
+	0x11bd: {0x110c},                 // HANGUL JONGSEONG CIEUC
+	0x11be: {0x110e},                 // HANGUL JONGSEONG CHIEUCH
+	0x11bf: {0x110f},                 // HANGUL JONGSEONG KHIEUKH
+	0x11c0: {0x1110},                 // HANGUL JONGSEONG THIEUTH
+	0x11c1: {0x1111},                 // HANGUL JONGSEONG PHIEUPH
+	0x11c2: {0x1112},                 // HANGUL JONGSEONG HIEUH
+	0x11c3: {0x1100, 0x1105},         // HANGUL JONGSEONG KIYEOK-RIEUL
+	0x11c4: {0x1100, 0x1109, 0x1100}, // HANGUL JONGSEONG KIYEOK-SIOS-KIYEOK
+	0x11c5: {0x1102, 0x1100},         // HANGUL JONGSEONG NIEUN-KIYEOK
+	0x11c6: {0x1102, 0x1103},         // HANGUL JONGSEONG NIEUN-TIKEUT
+	0x11c7: {0x1102, 0x1109},         // HANGUL JONGSEONG NIEUN-SIOS
+	0x11c8: {0x1102, 0x1140},         // HANGUL JONGSEONG NIEUN-PANSIOS
+	0x11c9: {0x1102, 0x1110},         // HANGUL JONGSEONG NIEUN-THIEUTH
+	0x11ca: {0x1103, 0x1100},         // HANGUL JONGSEONG TIKEUT-KIYEOK
+	0x11cb: {0x1103, 0x1105},         // HANGUL JONGSEONG TIKEUT-RIEUL
+	0x11cc: {0x1105, 0x1100, 0x1109}, // HANGUL JONGSEONG RIEUL-KIYEOK-SIOS
+	0x11cd: {0x1105, 0x1102},         // HANGUL JONGSEONG RIEUL-NIEUN
+	0x11ce: {0x1105, 0x1103},         // HANGUL JONGSEONG RIEUL-TIKEUT
+	0x11cf: {0x1105, 0x1103, 0x1112}, // HANGUL JONGSEONG RIEUL-TIKEUT-HIEUH
+	0x11d0: {0x1105, 0x1105},         // HANGUL JONGSEONG SSANGRIEUL
+	0x11d1: {0x1105, 0x1106, 0x1100}, // HANGUL JONGSEONG RIEUL-MIEUM-KIYEOK
+	0x11d2: {0x1105, 0x1106, 0x1109}, // HANGUL JONGSEONG RIEUL-MIEUM-SIOS
+	0x11d3: {0x1105, 0x1107, 0x1109}, // HANGUL JONGSEONG RIEUL-PIEUP-SIOS
+	0x11d4: {0x1105, 0x1107, 0x1112}, // HANGUL JONGSEONG RIEUL-PIEUP-HIEUH
+	0x11d5: {0x1105, 0x1107, 0x110b}, // HANGUL JONGSEONG RIEUL-KAPYEOUNPIEUP
+	0x11d6: {0x1105, 0x1109, 0x1109}, // HANGUL JONGSEONG RIEUL-SSANGSIOS
+	0x11d7: {0x1105, 0x1140},         // HANGUL JONGSEONG RIEUL-PANSIOS
+	0x11d8: {0x1105, 0x110f},         // HANGUL JONGSEONG RIEUL-KHIEUKH
+	0x11d9: {0x1105, 0x1159},         // HANGUL JONGSEONG RIEUL-YEORINHIEUH
+	0x11da: {0x1106, 0x1100},         // HANGUL JONGSEONG MIEUM-KIYEOK
+	0x11db: {0x1106, 0x1105},         // HANGUL JONGSEONG MIEUM-RIEUL
+	0x11dc: {0x1106, 0x1107},         // HANGUL JONGSEONG MIEUM-PIEUP
+	0x11dd: {0x1106, 0x1109},         // HANGUL JONGSEONG MIEUM-SIOS
+	0x11de: {0x1106, 0x1109, 0x1109}, // HANGUL JONGSEONG MIEUM-SSANGSIOS
+	0x11df: {0x1106, 0x1140},         // HANGUL JONGSEONG MIEUM-PANSIOS
+	0x11e0: {0x1106, 0x110e},         // HANGUL JONGSEONG MIEUM-CHIEUCH
+	0x11e1: {0x1106, 0x1112},         // HANGUL JONGSEONG MIEUM-HIEUH
+	0x11e2: {0x1106, 0x110b},         // HANGUL JONGSEONG KAPYEOUNMIEUM
+	0x11e3: {0x1107, 0x1105},         // HANGUL JONGSEONG PIEUP-RIEUL
+	0x11e4: {0x1107, 0x1111},         // HANGUL JONGSEONG PIEUP-PHIEUPH
+	0x11e5: {0x1107, 0x1112},         // HANGUL JONGSEONG PIEUP-HIEUH
+	0x11e6: {0x1107, 0x110b},         // HANGUL JONGSEONG KAPYEOUNPIEUP
+	0x11e7: {0x1109, 0x1100},         // HANGUL JONGSEONG SIOS-KIYEOK
+	0x11e8: {0x1109, 0x1103},         // HANGUL JONGSEONG SIOS-TIKEUT
+	0x11e9: {0x1109, 0x1105},         // HANGUL JONGSEONG SIOS-RIEUL
+	0x11ea: {0x1109, 0x1107},         // HANGUL JONGSEONG SIOS-PIEUP
+	0x11eb: {0x1140},                 // HANGUL JONGSEONG PANSIOS
+	0x11ec: {0x110b, 0x1100},         // HANGUL JONGSEONG IEUNG-KIYEOK
+	0x11ed: {0x110b, 0x1100, 0x1100}, // HANGUL JONGSEONG IEUNG-SSANGKIYEOK
+	0x11ee: {0x110b, 0x110b},         // HANGUL JONGSEONG SSANGIEUNG
+	0x11ef: {0x110b, 0x110f},         // HANGUL JONGSEONG IEUNG-KHIEUKH
+	0x11f0: {0x114c},                 // HANGUL JONGSEONG YESIEUNG
+	0x11f1: {0x110b, 0x1109},         // HANGUL JONGSEONG YESIEUNG-SIOS
+	0x11f2: {0x110b, 0x1140},         // HANGUL JONGSEONG YESIEUNG-PANSIOS
+	0x11f3: {0x1111, 0x1107},         // HANGUL JONGSEONG PHIEUPH-PIEUP
+	0x11f4: {0x1111, 0x110b},         // HANGUL JONGSEONG KAPYEOUNPHIEUPH
+	0x11f5: {0x1112, 0x1102},         // HANGUL JONGSEONG HIEUH-NIEUN
+	0x11f6: {0x1112, 0x1105},         // HANGUL JONGSEONG HIEUH-RIEUL
+	0x11f7: {0x1112, 0x1106},         // HANGUL JONGSEONG HIEUH-MIEUM
+	0x11f8: {0x1112, 0x1107},         // HANGUL JONGSEONG HIEUH-PIEUP
+	0x11f9: {0x1159},                 // HANGUL JONGSEONG YEORINHIEUH
+	0x11fa: {0x1100, 0x1102},         // HANGUL JONGSEONG KIYEOK-NIEUN
+	0x11fb: {0x1100, 0x1107},         // HANGUL JONGSEONG KIYEOK-PIEUP
+	0x11fc: {0x1100, 0x110e},         // HANGUL JONGSEONG KIYEOK-CHIEUCH
+	0x11fd: {0x1100, 0x110f},         // HANGUL JONGSEONG KIYEOK-KHIEUKH
+	0x11fe: {0x1100, 0x1112},         // HANGUL JONGSEONG KIYEOK-HIEUH
+	0x11ff: {0x1102, 0x1102},         // HANGUL JONGSEONG SSANGNIEUN
+
+	0x1200: {0x0055}, // ETHIOPIC SYLLABLE HA
+	0x1223: {0x0270}, // ETHIOPIC SYLLABLE SZAA
+	0x1240: {0x03a6}, // ETHIOPIC SYLLABLE QA
+	0x1260: {0x0548}, // ETHIOPIC SYLLABLE BA
+	0x1294: {0x0571}, // ETHIOPIC SYLLABLE NEE
+	0x12d0: {0x004f}, // ETHIOPIC SYLLABLE PHARYNGEAL A
+
+	0x13a0: {0x0044},         // CHEROKEE LETTER A
+	0x13a1: {0x0052},         // CHEROKEE LETTER E
+	0x13a2: {0x0054},         // CHEROKEE LETTER I
+	0x13a4: {0x004f, 0x0027}, // CHEROKEE LETTER U
+	0x13a5: {0x0069},         // CHEROKEE LETTER V
+	0x13a8: {0x2c75},         // CHEROKEE LETTER GE
+	0x13a9: {0x0059},         // CHEROKEE LETTER GI
+	0x13aa: {0x0041},         // CHEROKEE LETTER GO
+	0x13ab: {0x004a},         // CHEROKEE LETTER GU
+	0x13ac: {0x0045},         // CHEROKEE LETTER GV
+	0x13ae: {0x003f},         // CHEROKEE LETTER HE
+	0x13b0: {0x2c75},         // CHEROKEE LETTER HO
+	0x13b1: {0x0393},         // CHEROKEE LETTER HU
+	0x13b3: {0x0057},         // CHEROKEE LETTER LA
+	0x13b7: {0x004d},         // CHEROKEE LETTER LU
+	0x13bb: {0x0048},         // CHEROKEE LETTER MI
+	0x13bd: {0x0059},         // CHEROKEE LETTER MU
+	0x13be: {0x004f, 0x0335}, // CHEROKEE LETTER NA
+	0x13bf: {0x01ab},         // CHEROKEE LETTER HNA
+	0x13c0: {0x0047},         // CHEROKEE LETTER NAH
+	0x13c2: {0x0068},         // CHEROKEE LETTER NI
+	0x13c3: {0x005a},         // CHEROKEE LETTER NO
+	0x13c7: {0x0460},         // CHEROKEE LETTER QUE
+	0x13cb: {0x0190},         // CHEROKEE LETTER QUV
+	0x13cc: {0x0055, 0x0335}, // CHEROKEE LETTER SA
+	0x13ce: {0x0034},         // CHEROKEE LETTER SE
+	0x13cf: {0x0062},         // CHEROKEE LETTER SI
+	0x13d2: {0x0052},         // CHEROKEE LETTER SV
+	0x13d4: {0x0057},         // CHEROKEE LETTER TA
+	0x13d5: {0x0053},         // CHEROKEE LETTER DE
+	0x13d9: {0x0056},         // CHEROKEE LETTER DO
+	0x13da: {0x0053},         // CHEROKEE LETTER DU
+	0x13de: {0x004c},         // CHEROKEE LETTER TLE
+	0x13df: {0x0043},         // CHEROKEE LETTER TLI
+	0x13e2: {0x0050},         // CHEROKEE LETTER TLV
+	0x13e6: {0x004b},         // CHEROKEE LETTER TSO
+	0x13e7: {0x0064},         // CHEROKEE LETTER TSU
+	0x13eb: {0x004f, 0x0335}, // CHEROKEE LETTER WI
+	0x13ee: {0x0036},         // CHEROKEE LETTER WV
+	0x13f0: {0x00df},         // CHEROKEE LETTER YE
+	0x13f2: {0x0068, 0x0314}, // CHEROKEE LETTER YO
+	0x13f3: {0x0047},         // CHEROKEE LETTER YU
+	0x13f4: {0x0042},         // CHEROKEE LETTER YV
+	0x13fb: {0x0262},         // CHEROKEE SMALL LETTER YU
+	0x13fc: {0x0299},         // CHEROKEE SMALL LETTER YV
+
+	0x1400: {0x003d},                 // CANADIAN SYLLABICS HYPHEN
+	0x1403: {0x0394},                 // CANADIAN SYLLABICS I
+	0x140c: {0x00b7, 0x1401},         // CANADIAN SYLLABICS WE
+	0x140d: {0x1401, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE WE
+	0x140e: {0x00b7, 0x0394},         // CANADIAN SYLLABICS WI
+	0x140f: {0x0394, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE WI
+	0x1410: {0x00b7, 0x1404},         // CANADIAN SYLLABICS WII
+	0x1411: {0x1404, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE WII
+	0x1412: {0x00b7, 0x1405},         // CANADIAN SYLLABICS WO
+	0x1413: {0x1405, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE WO
+	0x1414: {0x00b7, 0x1406},         // CANADIAN SYLLABICS WOO
+	0x1415: {0x1406, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE WOO
+	0x1417: {0x00b7, 0x140a},         // CANADIAN SYLLABICS WA
+	0x1418: {0x140a, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE WA
+	0x1419: {0x00b7, 0x140b},         // CANADIAN SYLLABICS WAA
+	0x141a: {0x140b, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE WAA
+	0x1427: {0x00b7},                 // CANADIAN SYLLABICS FINAL MIDDLE DOT
+	0x142b: {0x1401, 0x1420},         // CANADIAN SYLLABICS EN
+	0x142c: {0x0394, 0x1420},         // CANADIAN SYLLABICS IN
+	0x142d: {0x1405, 0x1420},         // CANADIAN SYLLABICS ON
+	0x142e: {0x140a, 0x1420},         // CANADIAN SYLLABICS AN
+	0x142f: {0x0056},                 // CANADIAN SYLLABICS PE
+	0x1431: {0x0245},                 // CANADIAN SYLLABICS PI
+	0x1433: {0x003e},                 // CANADIAN SYLLABICS PO
+	0x1437: {0x00b7, 0x003e},         // CANADIAN SYLLABICS CARRIER HI
+	0x1438: {0x003c},                 // CANADIAN SYLLABICS PA
+	0x143a: {0x00b7, 0x0056},         // CANADIAN SYLLABICS PWE
+	0x143b: {0x0056, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE PWE
+	0x143c: {0x00b7, 0x0245},         // CANADIAN SYLLABICS PWI
+	0x143d: {0x0245, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE PWI
+	0x143e: {0x00b7, 0x1432},         // CANADIAN SYLLABICS PWII
+	0x143f: {0x1432, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE PWII
+	0x1440: {0x00b7, 0x003e},         // CANADIAN SYLLABICS PWO
+	0x1441: {0x003e, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE PWO
+	0x1442: {0x00b7, 0x1434},         // CANADIAN SYLLABICS PWOO
+	0x1443: {0x1434, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE PWOO
+	0x1444: {0x00b7, 0x003c},         // CANADIAN SYLLABICS PWA
+	0x1445: {0x003c, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE PWA
+	0x1446: {0x00b7, 0x1439},         // CANADIAN SYLLABICS PWAA
+	0x1447: {0x1439, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE PWAA
+	0x144a: {0x0027},                 // CANADIAN SYLLABICS WEST-CREE P
+	0x144c: {0x0055},                 // CANADIAN SYLLABICS TE
+	0x144e: {0x0548},                 // CANADIAN SYLLABICS TI
+	0x1454: {0x00b7, 0x1450},         // CANADIAN SYLLABICS CARRIER DI
+	0x1457: {0x00b7, 0x0055},         // CANADIAN SYLLABICS TWE
+	0x1458: {0x0055, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE TWE
+	0x1459: {0x00b7, 0x0548},         // CANADIAN SYLLABICS TWI
+	0x145a: {0x0548, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE TWI
+	0x145b: {0x00b7, 0x144f},         // CANADIAN SYLLABICS TWII
+	0x145c: {0x144f, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE TWII
+	0x145d: {0x00b7, 0x1450},         // CANADIAN SYLLABICS TWO
+	0x145e: {0x1450, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE TWO
+	0x145f: {0x00b7, 0x1451},         // CANADIAN SYLLABICS TWOO
+	0x1460: {0x1451, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE TWOO
+	0x1461: {0x00b7, 0x1455},         // CANADIAN SYLLABICS TWA
+	0x1462: {0x1455, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE TWA
+	0x1463: {0x00b7, 0x1456},         // CANADIAN SYLLABICS TWAA
+	0x1464: {0x1456, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE TWAA
+	0x1467: {0x0055, 0x0027},         // CANADIAN SYLLABICS TTE
+	0x1468: {0x0548, 0x0027},         // CANADIAN SYLLABICS TTI
+	0x1469: {0x1450, 0x0027},         // CANADIAN SYLLABICS TTO
+	0x146a: {0x1455, 0x0027},         // CANADIAN SYLLABICS TTA
+	0x146d: {0x0050},                 // CANADIAN SYLLABICS KI
+	0x146f: {0x0064},                 // CANADIAN SYLLABICS KO
+	0x1472: {0x0062},                 // CANADIAN SYLLABICS KA
+	0x1473: {0x0062, 0x0307},         // CANADIAN SYLLABICS KAA
+	0x1474: {0x00b7, 0x146b},         // CANADIAN SYLLABICS KWE
+	0x1475: {0x146b, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE KWE
+	0x1476: {0x00b7, 0x0050},         // CANADIAN SYLLABICS KWI
+	0x1477: {0x0070, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE KWI
+	0x1478: {0x00b7, 0x146e},         // CANADIAN SYLLABICS KWII
+	0x1479: {0x146e, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE KWII
+	0x147a: {0x00b7, 0x0064},         // CANADIAN SYLLABICS KWO
+	0x147b: {0x0064, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE KWO
+	0x147c: {0x00b7, 0x1470},         // CANADIAN SYLLABICS KWOO
+	0x147d: {0x1470, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE KWOO
+	0x147e: {0x00b7, 0x0062},         // CANADIAN SYLLABICS KWA
+	0x147f: {0x0062, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE KWA
+	0x1480: {0x00b7, 0x0062, 0x0307}, // CANADIAN SYLLABICS KWAA
+	0x1481: {0x0062, 0x0307, 0x00b7}, // CANADIAN SYLLABICS WEST-CREE KWAA
+	0x1485: {0x146b, 0x0027},         // CANADIAN SYLLABICS SOUTH-SLAVEY KEH
+	0x1486: {0x0050, 0x0027},         // CANADIAN SYLLABICS SOUTH-SLAVEY KIH
+	0x1487: {0x0064, 0x0027},         // CANADIAN SYLLABICS SOUTH-SLAVEY KOH
+	0x1488: {0x0062, 0x0027},         // CANADIAN SYLLABICS SOUTH-SLAVEY KAH
+	0x148d: {0x004a},                 // CANADIAN SYLLABICS CO
+	0x1492: {0x00b7, 0x1489},         // CANADIAN SYLLABICS CWE
+	0x1493: {0x1489, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE CWE
+	0x1494: {0x00b7, 0x148b},         // CANADIAN SYLLABICS CWI
+	0x1495: {0x148b, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE CWI
+	0x1496: {0x00b7, 0x148c},         // CANADIAN SYLLABICS CWII
+	0x1497: {0x148c, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE CWII
+	0x1498: {0x00b7, 0x004a},         // CANADIAN SYLLABICS CWO
+	0x1499: {0x004a, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE CWO
+	0x149a: {0x00b7, 0x148e},         // CANADIAN SYLLABICS CWOO
+	0x149b: {0x148e, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE CWOO
+	0x149c: {0x00b7, 0x1490},         // CANADIAN SYLLABICS CWA
+	0x149d: {0x1490, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE CWA
+	0x149e: {0x00b7, 0x1491},         // CANADIAN SYLLABICS CWAA
+	0x149f: {0x1491, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE CWAA
+	0x14a5: {0x0393},                 // CANADIAN SYLLABICS MI
+	0x14aa: {0x004c},                 // CANADIAN SYLLABICS MA
+	0x14ac: {0x00b7, 0x14a3},         // CANADIAN SYLLABICS MWE
+	0x14ad: {0x14a3, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE MWE
+	0x14ae: {0x00b7, 0x0393},         // CANADIAN SYLLABICS MWI
+	0x14af: {0x0393, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE MWI
+	0x14b0: {0x00b7, 0x14a6},         // CANADIAN SYLLABICS MWII
+	0x14b1: {0x14a6, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE MWII
+	0x14b2: {0x00b7, 0x14a7},         // CANADIAN SYLLABICS MWO
+	0x14b3: {0x14a7, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE MWO
+	0x14b4: {0x00b7, 0x14a8},         // CANADIAN SYLLABICS MWOO
+	0x14b5: {0x14a8, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE MWOO
+	0x14b6: {0x00b7, 0x004c},         // CANADIAN SYLLABICS MWA
+	0x14b7: {0x006c, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE MWA
+	0x14b8: {0x00b7, 0x14ab},         // CANADIAN SYLLABICS MWAA
+	0x14b9: {0x14ab, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE MWAA
+	0x14bf: {0x0032},                 // CANADIAN SYLLABICS SAYISI M
+	0x14c9: {0x00b7, 0x14c0},         // CANADIAN SYLLABICS NWE
+	0x14ca: {0x14c0, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE NWE
+	0x14cb: {0x00b7, 0x14c7},         // CANADIAN SYLLABICS NWA
+	0x14cc: {0x14c7, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE NWA
+	0x14cd: {0x00b7, 0x14c8},         // CANADIAN SYLLABICS NWAA
+	0x14ce: {0x14c8, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE NWAA
+	0x14d1: {0x1421},                 // CANADIAN SYLLABICS CARRIER NG
+	0x14dc: {0x00b7, 0x14d3},         // CANADIAN SYLLABICS LWE
+	0x14dd: {0x14d3, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE LWE
+	0x14de: {0x00b7, 0x14d5},         // CANADIAN SYLLABICS LWI
+	0x14df: {0x14d5, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE LWI
+	0x14e0: {0x00b7, 0x14d6},         // CANADIAN SYLLABICS LWII
+	0x14e1: {0x14d6, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE LWII
+	0x14e2: {0x00b7, 0x14d7},         // CANADIAN SYLLABICS LWO
+	0x14e3: {0x14d7, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE LWO
+	0x14e4: {0x00b7, 0x14d8},         // CANADIAN SYLLABICS LWOO
+	0x14e5: {0x14d8, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE LWOO
+	0x14e6: {0x00b7, 0x14da},         // CANADIAN SYLLABICS LWA
+	0x14e7: {0x14da, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE LWA
+	0x14e8: {0x00b7, 0x14db},         // CANADIAN SYLLABICS LWAA
+	0x14e9: {0x14db, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE LWAA
+	0x14f6: {0x00b7, 0x14ed},         // CANADIAN SYLLABICS SWE
+	0x14f7: {0x14ed, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SWE
+	0x14f8: {0x00b7, 0x14ef},         // CANADIAN SYLLABICS SWI
+	0x14f9: {0x14ef, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SWI
+	0x14fa: {0x00b7, 0x14f0},         // CANADIAN SYLLABICS SWII
+	0x14fb: {0x14f0, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SWII
+	0x14fc: {0x00b7, 0x14f1},         // CANADIAN SYLLABICS SWO
+	0x14fd: {0x14f1, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SWO
+	0x14fe: {0x00b7, 0x14f2},         // CANADIAN SYLLABICS SWOO
+	0x14ff: {0x14f2, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SWOO
+
+	0x1500: {0x00b7, 0x14f4},         // CANADIAN SYLLABICS SWA
+	0x1501: {0x14f4, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SWA
+	0x1502: {0x00b7, 0x14f5},         // CANADIAN SYLLABICS SWAA
+	0x1503: {0x14f5, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SWAA
+	0x150c: {0x150b, 0x003c},         // CANADIAN SYLLABICS NASKAPI SPWA
+	0x150d: {0x150b, 0x1455},         // CANADIAN SYLLABICS NASKAPI STWA
+	0x150e: {0x150b, 0x0062},         // CANADIAN SYLLABICS NASKAPI SKWA
+	0x150f: {0x150b, 0x1490},         // CANADIAN SYLLABICS NASKAPI SCWA
+	0x1517: {0x00b7, 0x1510},         // CANADIAN SYLLABICS SHWE
+	0x1518: {0x1510, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SHWE
+	0x1519: {0x00b7, 0x1511},         // CANADIAN SYLLABICS SHWI
+	0x151a: {0x1511, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SHWI
+	0x151b: {0x00b7, 0x1512},         // CANADIAN SYLLABICS SHWII
+	0x151c: {0x1512, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SHWII
+	0x151d: {0x00b7, 0x1513},         // CANADIAN SYLLABICS SHWO
+	0x151e: {0x1513, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SHWO
+	0x151f: {0x00b7, 0x1514},         // CANADIAN SYLLABICS SHWOO
+	0x1520: {0x1514, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SHWOO
+	0x1521: {0x00b7, 0x1515},         // CANADIAN SYLLABICS SHWA
+	0x1522: {0x1515, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SHWA
+	0x1523: {0x00b7, 0x1516},         // CANADIAN SYLLABICS SHWAA
+	0x1524: {0x1516, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE SHWAA
+	0x152f: {0x00b7, 0x0034},         // CANADIAN SYLLABICS YWE
+	0x1530: {0x0034, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE YWE
+	0x1531: {0x00b7, 0x1528},         // CANADIAN SYLLABICS YWI
+	0x1532: {0x1528, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE YWI
+	0x1533: {0x00b7, 0x1529},         // CANADIAN SYLLABICS YWII
+	0x1534: {0x1529, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE YWII
+	0x1535: {0x00b7, 0x152a},         // CANADIAN SYLLABICS YWO
+	0x1536: {0x152a, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE YWO
+	0x1537: {0x00b7, 0x152b},         // CANADIAN SYLLABICS YWOO
+	0x1538: {0x152b, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE YWOO
+	0x1539: {0x00b7, 0x152d},         // CANADIAN SYLLABICS YWA
+	0x153a: {0x152d, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE YWA
+	0x153b: {0x00b7, 0x152e},         // CANADIAN SYLLABICS YWAA
+	0x153c: {0x152e, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE YWAA
+	0x1540: {0x1429},                 // CANADIAN SYLLABICS WEST-CREE Y
+	0x1541: {0x0078},                 // CANADIAN SYLLABICS SAYISI YI
+	0x154e: {0x00b7, 0x154c},         // CANADIAN SYLLABICS RWAA
+	0x154f: {0x154c, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE RWAA
+	0x155b: {0x00b7, 0x155a},         // CANADIAN SYLLABICS FWAA
+	0x155c: {0x155a, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE FWAA
+	0x1568: {0x00b7, 0x1567},         // CANADIAN SYLLABICS THWAA
+	0x1569: {0x1567, 0x00b7},         // CANADIAN SYLLABICS WEST-CREE THWAA
+	0x1577: {0x1e9f},                 // CANADIAN SYLLABICS NUNAVIK HO
+	0x157c: {0x0048},                 // CANADIAN SYLLABICS NUNAVUT H
+	0x157d: {0x0078},                 // CANADIAN SYLLABICS HK
+	0x157e: {0x1550, 0x146c},         // CANADIAN SYLLABICS QAAI
+	0x157f: {0x1550, 0x0050},         // CANADIAN SYLLABICS QI
+	0x1580: {0x1550, 0x146e},         // CANADIAN SYLLABICS QII
+	0x1581: {0x1550, 0x0064},         // CANADIAN SYLLABICS QO
+	0x1582: {0x1550, 0x1470},         // CANADIAN SYLLABICS QOO
+	0x1583: {0x1550, 0x0062},         // CANADIAN SYLLABICS QA
+	0x1584: {0x1550, 0x0062, 0x0307}, // CANADIAN SYLLABICS QAA
+	0x1585: {0x1550, 0x1483},         // CANADIAN SYLLABICS Q
+	0x1587: {0x0052},                 // CANADIAN SYLLABICS TLHI
+	0x158e: {0x1595, 0x148a},         // CANADIAN SYLLABICS NGAAI
+	0x158f: {0x1595, 0x148b},         // CANADIAN SYLLABICS NGI
+	0x1590: {0x1595, 0x148c},         // CANADIAN SYLLABICS NGII
+	0x1591: {0x1595, 0x004a},         // CANADIAN SYLLABICS NGO
+	0x1592: {0x1595, 0x148e},         // CANADIAN SYLLABICS NGOO
+	0x1593: {0x1595, 0x1490},         // CANADIAN SYLLABICS NGA
+	0x1594: {0x1595, 0x1491},         // CANADIAN SYLLABICS NGAA
+	0x15af: {0x0062},                 // CANADIAN SYLLABICS AIVILIK B
+	0x15b4: {0x0046},                 // CANADIAN SYLLABICS BLACKFOOT WE
+	0x15b5: {0x2132},                 // CANADIAN SYLLABICS BLACKFOOT WI
+	0x15b7: {0xa7fb},                 // CANADIAN SYLLABICS BLACKFOOT WA
+	0x15c4: {0x2c6f},                 // CANADIAN SYLLABICS CARRIER GHU
+	0x15c5: {0x0041},                 // CANADIAN SYLLABICS CARRIER GHO
+	0x15de: {0x0044},                 // CANADIAN SYLLABICS CARRIER THE
+	0x15ea: {0x0044},                 // CANADIAN SYLLABICS CARRIER PE
+	0x15ef: {0x0460},                 // CANADIAN SYLLABICS CARRIER GU
+	0x15f0: {0x004d},                 // CANADIAN SYLLABICS CARRIER GO
+	0x15f7: {0x0042},                 // CANADIAN SYLLABICS CARRIER KHE
+
+	0x1602: {0x1490},         // CANADIAN SYLLABICS CARRIER NU
+	0x1603: {0x1489},         // CANADIAN SYLLABICS CARRIER NO
+	0x1604: {0x14d3},         // CANADIAN SYLLABICS CARRIER NE
+	0x1607: {0x14da},         // CANADIAN SYLLABICS CARRIER NA
+	0x1622: {0x1543},         // CANADIAN SYLLABICS CARRIER LU
+	0x1623: {0x1546},         // CANADIAN SYLLABICS CARRIER LO
+	0x1624: {0x154a},         // CANADIAN SYLLABICS CARRIER LE
+	0x162e: {0x01b1},         // CANADIAN SYLLABICS CARRIER LHU
+	0x162f: {0x03a9},         // CANADIAN SYLLABICS CARRIER LHO
+	0x1634: {0x01b1},         // CANADIAN SYLLABICS CARRIER TLHU
+	0x1635: {0x03a9},         // CANADIAN SYLLABICS CARRIER TLHO
+	0x166d: {0x0058},         // CANADIAN SYLLABICS CHI SIGN
+	0x166e: {0x0078},         // CANADIAN SYLLABICS FULL STOP
+	0x166f: {0x1550, 0x146b}, // CANADIAN SYLLABICS QAI
+	0x1670: {0x1595, 0x1489}, // CANADIAN SYLLABICS NGAI
+	0x1671: {0x1596, 0x148b}, // CANADIAN SYLLABICS NNGI
+	0x1672: {0x1596, 0x148c}, // CANADIAN SYLLABICS NNGII
+	0x1673: {0x1596, 0x004a}, // CANADIAN SYLLABICS NNGO
+	0x1674: {0x1596, 0x148e}, // CANADIAN SYLLABICS NNGOO
+	0x1675: {0x1596, 0x1490}, // CANADIAN SYLLABICS NNGA
+	0x1676: {0x1596, 0x1491}, // CANADIAN SYLLABICS NNGAA
+	0x1677: {0x15a7, 0x00b7}, // CANADIAN SYLLABICS WOODS-CREE THWEE
+	0x1678: {0x15a8, 0x00b7}, // CANADIAN SYLLABICS WOODS-CREE THWI
+	0x1679: {0x15a9, 0x00b7}, // CANADIAN SYLLABICS WOODS-CREE THWII
+	0x167a: {0x15aa, 0x00b7}, // CANADIAN SYLLABICS WOODS-CREE THWO
+	0x167b: {0x15ab, 0x00b7}, // CANADIAN SYLLABICS WOODS-CREE THWOO
+	0x167c: {0x15ac, 0x00b7}, // CANADIAN SYLLABICS WOODS-CREE THWA
+	0x167d: {0x15ad, 0x00b7}, // CANADIAN SYLLABICS WOODS-CREE THWAA
+	0x1680: {0x0020},         // OGHAM SPACE MARK
+	0x16b2: {0x003c},         // RUNIC LETTER KAUNA
+	0x16b7: {0x0058},         // RUNIC LETTER GEBO GYFU G
+	0x16c1: {0x006c},         // RUNIC LETTER ISAZ IS ISS I
+	0x16c2: {0x16bd},         // RUNIC LETTER E
+	0x16cc: {0x0027},         // RUNIC LETTER SHORT-TWIG-SOL S
+	0x16d5: {0x004b},         // RUNIC LETTER OPEN-P
+	0x16d6: {0x004d},         // RUNIC LETTER EHWAZ EH E
+	0x16d8: {0x03a8},         // RUNIC LETTER LONG-BRANCH-MADR M
+	0x16e1: {0x16bc},         // RUNIC LETTER IOR
+	0x16eb: {0x00b7},         // RUNIC SINGLE PUNCTUATION
+	0x16ec: {0x003a},         // RUNIC MULTIPLE PUNCTUATION
+	0x16ed: {0x002b},         // RUNIC CROSS PUNCTUATION
+	0x16f0: {0x03a6},         // RUNIC BELGTHOR SYMBOL
+
+	0x1735: {0x002f}, // PHILIPPINE SINGLE PUNCTUATION
+	0x17a3: {0x17a2}, // KHMER INDEPENDENT VOWEL QAQ
+	0x17b7: {0x0e34}, // KHMER VOWEL SIGN I
+	0x17b8: {0x0e35}, // KHMER VOWEL SIGN II
+	0x17b9: {0x0e36}, // KHMER VOWEL SIGN Y
+	0x17ba: {0x0e37}, // KHMER VOWEL SIGN YY
+	0x17c6: {0x030a}, // KHMER SIGN NIKAHIT
+	0x17cb: {0x0e48}, // KHMER SIGN BANTOC
+	0x17d3: {0x030a}, // KHMER SIGN BATHAMASAT
+	0x17d4: {0x0e2f}, // KHMER SIGN KHAN
+	0x17d5: {0x0e5a}, // KHMER SIGN BARIYOOSAN
+	0x17d9: {0x0e4f}, // KHMER SIGN PHNAEK MUAN
+	0x17da: {0x0e5b}, // KHMER SIGN KOOMUUT
+
+	0x1803: {0x003a},         // MONGOLIAN FULL STOP
+	0x1809: {0x003a},         // MONGOLIAN MANCHU FULL STOP
+	0x1855: {0x1835},         // MONGOLIAN LETTER TODO YA
+	0x1896: {0x185c},         // MONGOLIAN LETTER ALI GALI ZA
+	0x18b3: {0x00b7, 0x18b1}, // CANADIAN SYLLABICS WAY
+	0x18b6: {0x00b7, 0x18b4}, // CANADIAN SYLLABICS PWOY
+	0x18b9: {0x00b7, 0x18b8}, // CANADIAN SYLLABICS KWAY
+	0x18c2: {0x00b7, 0x18c0}, // CANADIAN SYLLABICS SHWOY
+	0x18c6: {0x00b7, 0x14c2}, // CANADIAN SYLLABICS NWI
+	0x18c7: {0x14c2, 0x00b7}, // CANADIAN SYLLABICS OJIBWAY NWI
+	0x18c8: {0x00b7, 0x14c3}, // CANADIAN SYLLABICS NWII
+	0x18c9: {0x14c3, 0x00b7}, // CANADIAN SYLLABICS OJIBWAY NWII
+	0x18ca: {0x00b7, 0x14c4}, // CANADIAN SYLLABICS NWO
+	0x18cb: {0x14c4, 0x00b7}, // CANADIAN SYLLABICS OJIBWAY NWO
+	0x18cc: {0x00b7, 0x14c5}, // CANADIAN SYLLABICS NWOO
+	0x18cd: {0x14c5, 0x00b7}, // CANADIAN SYLLABICS OJIBWAY NWOO
+	0x18ce: {0x00b7, 0x1543}, // CANADIAN SYLLABICS RWEE
+	0x18cf: {0x00b7, 0x1546}, // CANADIAN SYLLABICS RWI
+	0x18d0: {0x00b7, 0x1547}, // CANADIAN SYLLABICS RWII
+	0x18d1: {0x00b7, 0x1548}, // CANADIAN SYLLABICS RWO
+	0x18d2: {0x00b7, 0x1549}, // CANADIAN SYLLABICS RWOO
+	0x18d3: {0x00b7, 0x154b}, // CANADIAN SYLLABICS RWA
+	0x18db: {0x18f5},         // CANADIAN SYLLABICS OJIBWAY SH
+	0x18dc: {0x18df, 0x141e}, // CANADIAN SYLLABICS EASTERN W
+	0x18dd: {0x141e, 0x18df}, // CANADIAN SYLLABICS WESTERN W
+	0x18e0: {0x1543, 0x00b7}, // CANADIAN SYLLABICS R-CREE RWE
+	0x18e3: {0x155e, 0x00b7}, // CANADIAN SYLLABICS THWE
+	0x18e4: {0x1566, 0x00b7}, // CANADIAN SYLLABICS THWA
+	0x18e5: {0x156b, 0x00b7}, // CANADIAN SYLLABICS TTHWE
+	0x18e8: {0x1586, 0x00b7}, // CANADIAN SYLLABICS TLHWE
+	0x18ea: {0x1597, 0x00b7}, // CANADIAN SYLLABICS SAYISI SHWE
+	0x18ed: {0x0460, 0x00b7}, // CANADIAN SYLLABICS CARRIER GWU
+	0x18f0: {0x15f4, 0x00b7}, // CANADIAN SYLLABICS CARRIER GWA
+	0x18f2: {0x161b, 0x00b7}, // CANADIAN SYLLABICS CARRIER JWA
+
+	0x19d0: {0x199e}, // NEW TAI LUE DIGIT ZERO
+	0x19d1: {0x19b1}, // NEW TAI LUE DIGIT ONE
+
+	0x1a80: {0x1a45},         // TAI THAM HORA DIGIT ZERO
+	0x1a90: {0x1a45},         // TAI THAM THAM DIGIT ZERO
+	0x1aa9: {0x1aa8, 0x1aa8}, // TAI THAM SIGN KAANKUU
+	0x1aab: {0x1aaa, 0x1aa8}, // TAI THAM SIGN SATKAANKUU
+	0x1ab4: {0x06db},         // COMBINING TRIPLE DOT
+	0x1ab7: {0x0328},         // COMBINING OPEN MARK BELOW
+
+	0x1b52: {0x1b0d},         // BALINESE DIGIT TWO
+	0x1b53: {0x1b11},         // BALINESE DIGIT THREE
+	0x1b58: {0x1b28},         // BALINESE DIGIT EIGHT
+	0x1b5c: {0x1b50},         // BALINESE WINDU
+	0x1b5f: {0x1b5e, 0x1b5e}, // BALINESE CARIK PAREREN
+
+	0x1c3c: {0x1c3b, 0x1c3b}, // LEPCHA PUNCTUATION NYET THYOOM TA-ROL
+	0x1c7f: {0x1c7e, 0x1c7e}, // OL CHIKI PUNCTUATION DOUBLE MUCAAD
+	0x1cd0: {0x0302},         // VEDIC TONE KARSHANA
+	0x1cd2: {0x0304},         // VEDIC TONE PRENKHA
+	0x1cd3: {0x0027, 0x0027}, // VEDIC SIGN NIHSHVASA
+	0x1cd5: {0x032b},         // VEDIC TONE YAJURVEDIC AGGRAVATED INDEPENDENT SVARITA
+	0x1cd8: {0x032e},         // VEDIC TONE CANDRA BELOW
+	0x1cd9: {0x032d},         // VEDIC TONE YAJURVEDIC KATHAKA INDEPENDENT SVARITA SCHROEDER
+	0x1cda: {0x030e},         // VEDIC TONE DOUBLE SVARITA
+	0x1cdc: {0x0329},         // VEDIC TONE KATHAKA ANUDATTA
+	0x1cdd: {0x0323},         // VEDIC TONE DOT BELOW
+	0x1cde: {0x0324},         // VEDIC TONE TWO DOTS BELOW
+	0x1ced: {0x0316},         // VEDIC SIGN TIRYAK
+
+	0x1d04: {0x0063},                 // LATIN LETTER SMALL CAPITAL C
+	0x1d08: {0x025c},                 // LATIN SMALL LETTER TURNED OPEN E
+	0x1d0b: {0x0138},                 // LATIN LETTER SMALL CAPITAL K
+	0x1d0d: {0x028d},                 // LATIN LETTER SMALL CAPITAL M
+	0x1d0f: {0x006f},                 // LATIN LETTER SMALL CAPITAL O
+	0x1d10: {0x0254},                 // LATIN LETTER SMALL CAPITAL OPEN O
+	0x1d11: {0x006f},                 // LATIN SMALL LETTER SIDEWAYS O
+	0x1d14: {0x01dd, 0x006f},         // LATIN SMALL LETTER TURNED OE
+	0x1d1c: {0x0075},                 // LATIN LETTER SMALL CAPITAL U
+	0x1d20: {0x0076},                 // LATIN LETTER SMALL CAPITAL V
+	0x1d21: {0x0077},                 // LATIN LETTER SMALL CAPITAL W
+	0x1d22: {0x007a},                 // LATIN LETTER SMALL CAPITAL Z
+	0x1d24: {0x01a8},                 // LATIN LETTER VOICED LARYNGEAL SPIRANT
+	0x1d26: {0x0072},                 // GREEK LETTER SMALL CAPITAL GAMMA
+	0x1d27: {0x028c},                 // GREEK LETTER SMALL CAPITAL LAMDA
+	0x1d28: {0x03c0},                 // GREEK LETTER SMALL CAPITAL PI
+	0x1d29: {0x1d18},                 // GREEK LETTER SMALL CAPITAL RHO
+	0x1d2b: {0x043b},                 // CYRILLIC LETTER SMALL CAPITAL EL
+	0x1d3e: {0x18d6},                 // MODIFIER LETTER CAPITAL P
+	0x1d52: {0x00ba},                 // MODIFIER LETTER SMALL O
+	0x1d6b: {0x0075, 0x0065},         // LATIN SMALL LETTER UE
+	0x1d6e: {0x0066, 0x0334},         // LATIN SMALL LETTER F WITH MIDDLE TILDE
+	0x1d6f: {0x0072, 0x006e, 0x0334}, // LATIN SMALL LETTER M WITH MIDDLE TILDE
+	0x1d70: {0x006e, 0x0334},         // LATIN SMALL LETTER N WITH MIDDLE TILDE
+	0x1d72: {0x0072, 0x0334},         // LATIN SMALL LETTER R WITH MIDDLE TILDE
+	0x1d73: {0x027e, 0x0334},         // LATIN SMALL LETTER R WITH FISHHOOK AND MIDDLE TILDE
+	0x1d74: {0x0073, 0x0334},         // LATIN SMALL LETTER S WITH MIDDLE TILDE
+	0x1d75: {0x0074, 0x0334},         // LATIN SMALL LETTER T WITH MIDDLE TILDE
+	0x1d76: {0x007a, 0x0334},         // LATIN SMALL LETTER Z WITH MIDDLE TILDE
+	0x1d78: {0x1d34},                 // MODIFIER LETTER CYRILLIC EN
+	0x1d7b: {0x0069, 0x0335},         // LATIN SMALL CAPITAL LETTER I WITH STROKE
+	0x1d7c: {0x0069, 0x0335},         // LATIN SMALL LETTER IOTA WITH STROKE
+	0x1d7d: {0x0070, 0x0335},         // LATIN SMALL LETTER P WITH STROKE
+	0x1d7e: {0x0075, 0x0335},         // LATIN SMALL CAPITAL LETTER U WITH STROKE
+	0x1d7f: {0x028a, 0x0335},         // LATIN SMALL LETTER UPSILON WITH STROKE
+	0x1d83: {0x0067},                 // LATIN SMALL LETTER G WITH PALATAL HOOK
+	0x1d8c: {0x0079},                 // LATIN SMALL LETTER V WITH PALATAL HOOK
+	0x1d90: {0x024b},                 // LATIN SMALL LETTER ALPHA WITH RETROFLEX HOOK
+	0x1d9f: {0x1d4b},                 // MODIFIER LETTER SMALL REVERSED OPEN E
+	0x1da2: {0x1d4d},                 // MODIFIER LETTER SMALL SCRIPT G
+	0x1dba: {0x18d4},                 // MODIFIER LETTER SMALL TURNED V
+	0x1dbb: {0x1646},                 // MODIFIER LETTER SMALL Z
+	0x1dee: {0x2dec},                 // COMBINING LATIN SMALL LETTER P
+
+	0x1e9a: {0x0061, 0x0309}, // LATIN SMALL LETTER A WITH RIGHT HALF RING
+	0x1e9d: {0x0066},         // LATIN SMALL LETTER LONG S WITH HIGH STROKE
+	0x1eff: {0x0079},         // LATIN SMALL LETTER Y WITH LOOP
+
+	0x1fbd: {0x0027}, // GREEK KORONIS
+	0x1fbf: {0x0027}, // GREEK PSILI
+	0x1fc0: {0x007e}, // GREEK PERISPOMENI
+	0x1ffe: {0x0027}, // GREEK DASIA
+
+	0x2002: {0x0020},                                 // EN SPACE
+	0x2003: {0x0020},                                 // EM SPACE
+	0x2004: {0x0020},                                 // THREE-PER-EM SPACE
+	0x2005: {0x0020},                                 // FOUR-PER-EM SPACE
+	0x2006: {0x0020},                                 // SIX-PER-EM SPACE
+	0x2007: {0x0020},                                 // FIGURE SPACE
+	0x2008: {0x0020},                                 // PUNCTUATION SPACE
+	0x2009: {0x0020},                                 // THIN SPACE
+	0x200a: {0x0020},                                 // HAIR SPACE
+	0x2010: {0x002d},                                 // HYPHEN
+	0x2011: {0x002d},                                 // NON-BREAKING HYPHEN
+	0x2012: {0x002d},                                 // FIGURE DASH
+	0x2013: {0x002d},                                 // EN DASH
+	0x2014: {0x30fc},                                 // EM DASH
+	0x2015: {0x30fc},                                 // HORIZONTAL BAR
+	0x2016: {0x006c, 0x006c},                         // DOUBLE VERTICAL LINE
+	0x2018: {0x0027},                                 // LEFT SINGLE QUOTATION MARK
+	0x2019: {0x0027},                                 // RIGHT SINGLE QUOTATION MARK
+	0x201a: {0x002c},                                 // SINGLE LOW-9 QUOTATION MARK
+	0x201b: {0x0027},                                 // SINGLE HIGH-REVERSED-9 QUOTATION MARK
+	0x201c: {0x0027, 0x0027},                         // LEFT DOUBLE QUOTATION MARK
+	0x201d: {0x0027, 0x0027},                         // RIGHT DOUBLE QUOTATION MARK
+	0x201f: {0x0027, 0x0027},                         // DOUBLE HIGH-REVERSED-9 QUOTATION MARK
+	0x2022: {0x00b7},                                 // BULLET
+	0x2024: {0x002e},                                 // ONE DOT LEADER
+	0x2025: {0x002e, 0x002e},                         // TWO DOT LEADER
+	0x2026: {0x002e, 0x002e, 0x002e},                 // HORIZONTAL ELLIPSIS
+	0x2027: {0x00b7},                                 // HYPHENATION POINT
+	0x2028: {0x0020},                                 // LINE SEPARATOR
+	0x2029: {0x0020},                                 // PARAGRAPH SEPARATOR
+	0x202f: {0x0020},                                 // NARROW NO-BREAK SPACE
+	0x2030: {0x00ba, 0x002f, 0x2080, 0x2080},         // PER MILLE SIGN
+	0x2031: {0x00ba, 0x002f, 0x2080, 0x2080, 0x2080}, // PER TEN THOUSAND SIGN
+	0x2032: {0x0027},                                 // PRIME
+	0x2033: {0x0027, 0x0027},                         // DOUBLE PRIME
+	0x2034: {0x0027, 0x0027, 0x0027},                 // TRIPLE PRIME
+	0x2035: {0x0027},                                 // REVERSED PRIME
+	0x2036: {0x0027, 0x0027},                         // REVERSED DOUBLE PRIME
+	0x2037: {0x0027, 0x0027, 0x0027},                 // REVERSED TRIPLE PRIME
+	0x2039: {0x003c},                                 // SINGLE LEFT-POINTING ANGLE QUOTATION MARK
+	0x203a: {0x003e},                                 // SINGLE RIGHT-POINTING ANGLE QUOTATION MARK
+	0x203c: {0x0021, 0x0021},                         // DOUBLE EXCLAMATION MARK
+	0x203e: {0x02c9},                                 // OVERLINE
+	0x2041: {0x002f},                                 // CARET INSERTION POINT
+	0x2043: {0x002d},                                 // HYPHEN BULLET
+	0x2044: {0x002f},                                 // FRACTION SLASH
+	0x2047: {0x003f, 0x003f},                         // DOUBLE QUESTION MARK
+	0x2048: {0x003f, 0x0021},                         // QUESTION EXCLAMATION MARK
+	0x2049: {0x0021, 0x003f},                         // EXCLAMATION QUESTION MARK
+	0x204e: {0x002a},                                 // LOW ASTERISK
+	0x2052: {0x00ba, 0x002f, 0x2080},                 // COMMERCIAL MINUS SIGN
+	0x2053: {0x007e},                                 // SWUNG DASH
+	0x2057: {0x0027, 0x0027, 0x0027, 0x0027},         // QUADRUPLE PRIME
+	0x205a: {0x003a},                                 // TWO DOT PUNCTUATION
+	0x205d: {0x2d57},                                 // TRICOLON
+	0x205e: {0x2d42},                                 // VERTICAL FOUR DOTS
+	0x205f: {0x0020},                                 // MEDIUM MATHEMATICAL SPACE
+	0x2070: {0x00ba},                                 // SUPERSCRIPT ZERO
+	0x2079: {0xa770},                                 // SUPERSCRIPT NINE
+	0x20a1: {0x0043, 0x20eb},                         // COLON SIGN
+	0x20a4: {0x00a3},                                 // LIRA SIGN
+	0x20a5: {0x0072, 0x006e, 0x0338},                 // MILL SIGN
+	0x20a8: {0x0052, 0x0073},                         // RUPEE SIGN
+	0x20a9: {0x0057, 0x0335},                         // WON SIGN
+	0x20ab: {0x0064, 0x0335, 0x0331},                 // DONG SIGN
+	0x20ac: {0xa792},                                 // EURO SIGN
+	0x20ad: {0x004b, 0x0335},                         // KIP SIGN
+	0x20ae: {0x0054, 0x20eb},                         // TUGRIK SIGN
+	0x20b6: {0x006c, 0x0074},                         // LIVRE TOURNOIS SIGN
+	0x20bd: {0x0554},                                 // RUBLE SIGN
+	0x20db: {0x06db},                                 // COMBINING THREE DOTS ABOVE
+
+	0x2100: {0x0061, 0x002f, 0x0063},         // ACCOUNT OF
+	0x2101: {0x0061, 0x002f, 0x0073},         // ADDRESSED TO THE SUBJECT
+	0x2102: {0x0043},                         // DOUBLE-STRUCK CAPITAL C
+	0x2103: {0x00b0, 0x0043},                 // DEGREE CELSIUS
+	0x2105: {0x0063, 0x002f, 0x006f},         // CARE OF
+	0x2106: {0x0063, 0x002f, 0x0075},         // CADA UNA
+	0x2107: {0x0190},                         // EULER CONSTANT
+	0x2108: {0x042d},                         // SCRUPLE
+	0x2109: {0x00b0, 0x0046},                 // DEGREE FAHRENHEIT
+	0x210a: {0x0067},                         // SCRIPT SMALL G
+	0x210b: {0x0048},                         // SCRIPT CAPITAL H
+	0x210c: {0x0048},                         // BLACK-LETTER CAPITAL H
+	0x210d: {0x0048},                         // DOUBLE-STRUCK CAPITAL H
+	0x210e: {0x0068},                         // PLANCK CONSTANT
+	0x210f: {0x0068, 0x0335},                 // PLANCK CONSTANT OVER TWO PI
+	0x2110: {0x006c},                         // SCRIPT CAPITAL I
+	0x2111: {0x006c},                         // BLACK-LETTER CAPITAL I
+	0x2112: {0x004c},                         // SCRIPT CAPITAL L
+	0x2113: {0x006c},                         // SCRIPT SMALL L
+	0x2115: {0x004e},                         // DOUBLE-STRUCK CAPITAL N
+	0x2116: {0x004e, 0x006f},                 // NUMERO SIGN
+	0x2119: {0x0050},                         // DOUBLE-STRUCK CAPITAL P
+	0x211a: {0x0051},                         // DOUBLE-STRUCK CAPITAL Q
+	0x211b: {0x0052},                         // SCRIPT CAPITAL R
+	0x211c: {0x0052},                         // BLACK-LETTER CAPITAL R
+	0x211d: {0x0052},                         // DOUBLE-STRUCK CAPITAL R
+	0x2121: {0x0054, 0x0045, 0x004c},         // TELEPHONE SIGN
+	0x2124: {0x005a},                         // DOUBLE-STRUCK CAPITAL Z
+	0x2127: {0x01b1},                         // INVERTED OHM SIGN
+	0x2128: {0x005a},                         // BLACK-LETTER CAPITAL Z
+	0x2129: {0x027f},                         // TURNED GREEK SMALL LETTER IOTA
+	0x212c: {0x0042},                         // SCRIPT CAPITAL B
+	0x212d: {0x0043},                         // BLACK-LETTER CAPITAL C
+	0x212e: {0x0065},                         // ESTIMATED SYMBOL
+	0x212f: {0x0065},                         // SCRIPT SMALL E
+	0x2130: {0x0045},                         // SCRIPT CAPITAL E
+	0x2131: {0x0046},                         // SCRIPT CAPITAL F
+	0x2133: {0x004d},                         // SCRIPT CAPITAL M
+	0x2134: {0x006f},                         // SCRIPT SMALL O
+	0x2135: {0x05d0},                         // ALEF SYMBOL
+	0x2136: {0x05d1},                         // BET SYMBOL
+	0x2137: {0x05d2},                         // GIMEL SYMBOL
+	0x2138: {0x05d3},                         // DALET SYMBOL
+	0x2139: {0x0069},                         // INFORMATION SOURCE
+	0x213b: {0x0046, 0x0041, 0x0058},         // FACSIMILE SIGN
+	0x213c: {0x03c0},                         // DOUBLE-STRUCK SMALL PI
+	0x213d: {0x0079},                         // DOUBLE-STRUCK SMALL GAMMA
+	0x213e: {0x0393},                         // DOUBLE-STRUCK CAPITAL GAMMA
+	0x213f: {0x03a0},                         // DOUBLE-STRUCK CAPITAL PI
+	0x2140: {0x01a9},                         // DOUBLE-STRUCK N-ARY SUMMATION
+	0x2141: {0xa4e8},                         // TURNED SANS-SERIF CAPITAL G
+	0x2142: {0xa4f6},                         // TURNED SANS-SERIF CAPITAL L
+	0x2143: {0x16f00},                        // REVERSED SANS-SERIF CAPITAL L
+	0x2145: {0x0044},                         // DOUBLE-STRUCK ITALIC CAPITAL D
+	0x2146: {0x0064},                         // DOUBLE-STRUCK ITALIC SMALL D
+	0x2147: {0x0065},                         // DOUBLE-STRUCK ITALIC SMALL E
+	0x2148: {0x0069},                         // DOUBLE-STRUCK ITALIC SMALL I
+	0x2149: {0x006a},                         // DOUBLE-STRUCK ITALIC SMALL J
+	0x2160: {0x006c},                         // ROMAN NUMERAL ONE
+	0x2161: {0x006c, 0x006c},                 // ROMAN NUMERAL TWO
+	0x2162: {0x006c, 0x006c, 0x006c},         // ROMAN NUMERAL THREE
+	0x2163: {0x006c, 0x0056},                 // ROMAN NUMERAL FOUR
+	0x2164: {0x0056},                         // ROMAN NUMERAL FIVE
+	0x2165: {0x0056, 0x006c},                 // ROMAN NUMERAL SIX
+	0x2166: {0x0056, 0x006c, 0x006c},         // ROMAN NUMERAL SEVEN
+	0x2167: {0x0056, 0x006c, 0x006c, 0x006c}, // ROMAN NUMERAL EIGHT
+	0x2168: {0x006c, 0x0058},                 // ROMAN NUMERAL NINE
+	0x2169: {0x0058},                         // ROMAN NUMERAL TEN
+	0x216a: {0x0058, 0x006c},                 // ROMAN NUMERAL ELEVEN
+	0x216b: {0x0058, 0x006c, 0x006c},         // ROMAN NUMERAL TWELVE
+	0x216c: {0x004c},                         // ROMAN NUMERAL FIFTY
+	0x216d: {0x0043},                         // ROMAN NUMERAL ONE HUNDRED
+	0x216e: {0x0044},                         // ROMAN NUMERAL FIVE HUNDRED
+	0x216f: {0x004d},                         // ROMAN NUMERAL ONE THOUSAND
+	0x2170: {0x0069},                         // SMALL ROMAN NUMERAL ONE
+	0x2171: {0x0069, 0x0069},                 // SMALL ROMAN NUMERAL TWO
+	0x2172: {0x0069, 0x0069, 0x0069},         // SMALL ROMAN NUMERAL THREE
+	0x2173: {0x0069, 0x0076},                 // SMALL ROMAN NUMERAL FOUR
+	0x2174: {0x0076},                         // SMALL ROMAN NUMERAL FIVE
+	0x2175: {0x0076, 0x0069},                 // SMALL ROMAN NUMERAL SIX
+	0x2176: {0x0076, 0x0069, 0x0069},         // SMALL ROMAN NUMERAL SEVEN
+	0x2177: {0x0076, 0x0069, 0x0069, 0x0069}, // SMALL ROMAN NUMERAL EIGHT
+	0x2178: {0x0069, 0x0078},                 // SMALL ROMAN NUMERAL NINE
+	0x2179: {0x0078},                         // SMALL ROMAN NUMERAL TEN
+	0x217a: {0x0078, 0x0069},                 // SMALL ROMAN NUMERAL ELEVEN
+	0x217b: {0x0078, 0x0069, 0x0069},         // SMALL ROMAN NUMERAL TWELVE
+	0x217c: {0x006c},                         // SMALL ROMAN NUMERAL FIFTY
+	0x217d: {0x0063},                         // SMALL ROMAN NUMERAL ONE HUNDRED
+	0x217e: {0x0064},                         // SMALL ROMAN NUMERAL FIVE HUNDRED
+	0x217f: {0x0072, 0x006e},                 // SMALL ROMAN NUMERAL ONE THOUSAND
+	0x2183: {0x0186},                         // ROMAN NUMERAL REVERSED ONE HUNDRED
+	0x2184: {0x0254},                         // LATIN SMALL LETTER REVERSED C
+	0x2191: {0x16cf},                         // UPWARDS ARROW
+	0x2195: {0x16e8},                         // UP DOWN ARROW
+	0x21b5: {0x21b2},                         // DOWNWARDS ARROW WITH CORNER LEFTWARDS
+	0x21ba: {0x1f10e},                        // ANTICLOCKWISE OPEN CIRCLE ARROW
+	0x21be: {0x16da},                         // UPWARDS HARPOON WITH BARB RIGHTWARDS
+	0x21bf: {0x16d0},                         // UPWARDS HARPOON WITH BARB LEFTWARDS
+
+	0x2200: {0x2c6f},                 // FOR ALL
+	0x2203: {0x018e},                 // THERE EXISTS
+	0x2206: {0x0394},                 // INCREMENT
+	0x220f: {0x03a0},                 // N-ARY PRODUCT
+	0x2211: {0x01a9},                 // N-ARY SUMMATION
+	0x2212: {0x002d},                 // MINUS SIGN
+	0x2214: {0x002b, 0x0307},         // DOT PLUS
+	0x2215: {0x002f},                 // DIVISION SLASH
+	0x2216: {0x005c},                 // SET MINUS
+	0x2217: {0x002a},                 // ASTERISK OPERATOR
+	0x2218: {0x00b0},                 // RING OPERATOR
+	0x2219: {0x00b7},                 // BULLET OPERATOR
+	0x221e: {0x006f, 0x006f},         // INFINITY
+	0x2223: {0x006c},                 // DIVIDES
+	0x2225: {0x006c, 0x006c},         // PARALLEL TO
+	0x2228: {0x0076},                 // LOGICAL OR
+	0x2229: {0x0548},                 // INTERSECTION
+	0x222a: {0x0055},                 // UNION
+	0x222b: {0x0283},                 // INTEGRAL
+	0x222c: {0x0283, 0x0283},         // DOUBLE INTEGRAL
+	0x222d: {0x0283, 0x0283, 0x0283}, // TRIPLE INTEGRAL
+	0x222f: {0x222e, 0x222e},         // SURFACE INTEGRAL
+	0x2230: {0x222e, 0x222e, 0x222e}, // VOLUME INTEGRAL
+	0x2236: {0x003a},                 // RATIO
+	0x2238: {0x002d, 0x0307},         // DOT MINUS
+	0x223c: {0x007e},                 // TILDE OPERATOR
+	0x2250: {0x003d, 0x0307},         // APPROACHES THE LIMIT
+	0x2251: {0x003d, 0x0323, 0x0307}, // GEOMETRICALLY EQUAL TO
+	0x2257: {0x003d, 0x030a},         // RING EQUAL TO
+	0x2259: {0x003d, 0x0302},         // ESTIMATES
+	0x225a: {0x003d, 0x0306},         // EQUIANGULAR TO
+	0x225e: {0x003d, 0x036b},         // MEASURED BY
+	0x2263: {0x2261},                 // STRICTLY EQUIVALENT TO
+	0x226a: {0x003c, 0x003c},         // MUCH LESS-THAN
+	0x226b: {0x003e, 0x003e},         // MUCH GREATER-THAN
+	0x2282: {0x1455},                 // SUBSET OF
+	0x2283: {0x1450},                 // SUPERSET OF
+	0x2295: {0x102a8},                // CIRCLED PLUS
+	0x2296: {0x004f, 0x0335},         // CIRCLED MINUS
+	0x2299: {0x0298},                 // CIRCLED DOT OPERATOR
+	0x229d: {0x004f, 0x0335},         // CIRCLED DASH
+	0x22a4: {0x0054},                 // DOWN TACK
+	0x22a5: {0xa4d5},                 // UP TACK
+	0x22c0: {0x2227},                 // N-ARY LOGICAL AND
+	0x22c1: {0x0076},                 // N-ARY LOGICAL OR
+	0x22c2: {0x0548},                 // N-ARY INTERSECTION
+	0x22c3: {0x0055},                 // N-ARY UNION
+	0x22c4: {0x16dc},                 // DIAMOND OPERATOR
+	0x22c5: {0x00b7},                 // DOT OPERATOR
+	0x22c8: {0x16de},                 // BOWTIE
+	0x22d6: {0x003c, 0x00b7},         // LESS-THAN WITH DOT
+	0x22d7: {0x00b7, 0x003e},         // GREATER-THAN WITH DOT
+	0x22d8: {0x003c, 0x003c, 0x003c}, // VERY MUCH LESS-THAN
+	0x22d9: {0x003e, 0x003e, 0x003e}, // VERY MUCH GREATER-THAN
+	0x22ee: {0x2d57},                 // VERTICAL ELLIPSIS
+	0x22ef: {0x00b7, 0x00b7, 0x00b7}, // MIDLINE HORIZONTAL ELLIPSIS
+	0x22f4: {0xa793},                 // SMALL ELEMENT OF WITH VERTICAL BAR AT END OF HORIZONTAL STROKE
+	0x22ff: {0x0045},                 // Z NOTATION BAG MEMBERSHIP
+
+	0x2300: {0x2205},         // DIAMETER SIGN
+	0x2325: {0x2324},         // OPTION KEY
+	0x2341: {0x303c},         // APL FUNCTIONAL SYMBOL QUAD SLASH
+	0x2359: {0x0394, 0x0332}, // APL FUNCTIONAL SYMBOL DELTA UNDERBAR
+	0x235a: {0x16dc, 0x0332}, // APL FUNCTIONAL SYMBOL DIAMOND UNDERBAR
+	0x235c: {0x00b0, 0x0332}, // APL FUNCTIONAL SYMBOL CIRCLE UNDERBAR
+	0x235f: {0x229b},         // APL FUNCTIONAL SYMBOL CIRCLE STAR
+	0x2361: {0x0054, 0x0308}, // APL FUNCTIONAL SYMBOL UP TACK DIAERESIS
+	0x2362: {0x2207, 0x0308}, // APL FUNCTIONAL SYMBOL DEL DIAERESIS
+	0x2363: {0x22c6, 0x0308}, // APL FUNCTIONAL SYMBOL STAR DIAERESIS
+	0x2364: {0x00b0, 0x0308}, // APL FUNCTIONAL SYMBOL JOT DIAERESIS
+	0x2365: {0x0629},         // APL FUNCTIONAL SYMBOL CIRCLE DIAERESIS
+	0x2368: {0x007e, 0x0308}, // APL FUNCTIONAL SYMBOL TILDE DIAERESIS
+	0x2369: {0x1435},         // APL FUNCTIONAL SYMBOL GREATER-THAN DIAERESIS
+	0x236b: {0x2207, 0x0334}, // APL FUNCTIONAL SYMBOL DEL TILDE
+	0x236c: {0x004f, 0x0335}, // APL FUNCTIONAL SYMBOL ZILDE
+	0x2373: {0x0069},         // APL FUNCTIONAL SYMBOL IOTA
+	0x2374: {0x0070},         // APL FUNCTIONAL SYMBOL RHO
+	0x2375: {0x03c9},         // APL FUNCTIONAL SYMBOL OMEGA
+	0x2376: {0x0061, 0x0332}, // APL FUNCTIONAL SYMBOL ALPHA UNDERBAR
+	0x2377: {0xa793, 0x0332}, // APL FUNCTIONAL SYMBOL EPSILON UNDERBAR
+	0x2378: {0x0069, 0x0332}, // APL FUNCTIONAL SYMBOL IOTA UNDERBAR
+	0x2379: {0x03c9, 0x0332}, // APL FUNCTIONAL SYMBOL OMEGA UNDERBAR
+	0x237a: {0x0061},         // APL FUNCTIONAL SYMBOL ALPHA
+	0x237f: {0x16bd},         // VERTICAL LINE WITH MIDDLE DOT
+	0x239c: {0x4e28},         // LEFT PARENTHESIS EXTENSION
+	0x239f: {0x4e28},         // RIGHT PARENTHESIS EXTENSION
+	0x23a2: {0x4e28},         // LEFT SQUARE BRACKET EXTENSION
+	0x23a5: {0x4e28},         // RIGHT SQUARE BRACKET EXTENSION
+	0x23aa: {0x4e28},         // CURLY BRACKET EXTENSION
+	0x23ae: {0x4e28},         // INTEGRAL EXTENSION
+	0x23c1: {0x2355},         // DENTISTRY SYMBOL LIGHT DOWN AND HORIZONTAL WITH CIRCLE
+	0x23c2: {0x234e},         // DENTISTRY SYMBOL LIGHT UP AND HORIZONTAL WITH CIRCLE
+	0x23c3: {0x234b},         // DENTISTRY SYMBOL LIGHT VERTICAL WITH TRIANGLE
+	0x23c6: {0x236d},         // DENTISTRY SYMBOL LIGHT VERTICAL AND WAVE
+	0x23e8: {0x2081, 0x2080}, // DECIMAL EXPONENT SYMBOL
+	0x23fc: {0x23fb},         // POWER ON-OFF SYMBOL
+	0x23fd: {0x006c},         // POWER ON SYMBOL
+	0x23fe: {0x263e},         // POWER SLEEP SYMBOL
+
+	0x244a: {0x005c, 0x005c},                 // OCR DOUBLE BACKSLASH
+	0x2460: {0x2780},                         // CIRCLED DIGIT ONE
+	0x2461: {0x2781},                         // CIRCLED DIGIT TWO
+	0x2462: {0x2782},                         // CIRCLED DIGIT THREE
+	0x2463: {0x2783},                         // CIRCLED DIGIT FOUR
+	0x2464: {0x2784},                         // CIRCLED DIGIT FIVE
+	0x2465: {0x2785},                         // CIRCLED DIGIT SIX
+	0x2466: {0x2786},                         // CIRCLED DIGIT SEVEN
+	0x2467: {0x2787},                         // CIRCLED DIGIT EIGHT
+	0x2468: {0x2788},                         // CIRCLED DIGIT NINE
+	0x2469: {0x2789},                         // CIRCLED NUMBER TEN
+	0x2474: {0x0028, 0x006c, 0x0029},         // PARENTHESIZED DIGIT ONE
+	0x2475: {0x0028, 0x0032, 0x0029},         // PARENTHESIZED DIGIT TWO
+	0x2476: {0x0028, 0x0033, 0x0029},         // PARENTHESIZED DIGIT THREE
+	0x2477: {0x0028, 0x0034, 0x0029},         // PARENTHESIZED DIGIT FOUR
+	0x2478: {0x0028, 0x0035, 0x0029},         // PARENTHESIZED DIGIT FIVE
+	0x2479: {0x0028, 0x0036, 0x0029},         // PARENTHESIZED DIGIT SIX
+	0x247a: {0x0028, 0x0037, 0x0029},         // PARENTHESIZED DIGIT SEVEN
+	0x247b: {0x0028, 0x0038, 0x0029},         // PARENTHESIZED DIGIT EIGHT
+	0x247c: {0x0028, 0x0039, 0x0029},         // PARENTHESIZED DIGIT NINE
+	0x247d: {0x0028, 0x006c, 0x004f, 0x0029}, // PARENTHESIZED NUMBER TEN
+	0x247e: {0x0028, 0x006c, 0x006c, 0x0029}, // PARENTHESIZED NUMBER ELEVEN
+	0x247f: {0x0028, 0x006c, 0x0032, 0x0029}, // PARENTHESIZED NUMBER TWELVE
+	0x2480: {0x0028, 0x006c, 0x0033, 0x0029}, // PARENTHESIZED NUMBER THIRTEEN
+	0x2481: {0x0028, 0x006c, 0x0034, 0x0029}, // PARENTHESIZED NUMBER FOURTEEN
+	0x2482: {0x0028, 0x006c, 0x0035, 0x0029}, // PARENTHESIZED NUMBER FIFTEEN
+	0x2483: {0x0028, 0x006c, 0x0036, 0x0029}, // PARENTHESIZED NUMBER SIXTEEN
+	0x2484: {0x0028, 0x006c, 0x0037, 0x0029}, // PARENTHESIZED NUMBER SEVENTEEN
+	0x2485: {0x0028, 0x006c, 0x0038, 0x0029}, // PARENTHESIZED NUMBER EIGHTEEN
+	0x2486: {0x0028, 0x006c, 0x0039, 0x0029}, // PARENTHESIZED NUMBER NINETEEN
+	0x2487: {0x0028, 0x0032, 0x004f, 0x0029}, // PARENTHESIZED NUMBER TWENTY
+	0x2488: {0x006c, 0x002e},                 // DIGIT ONE FULL STOP
+	0x2489: {0x0032, 0x002e},                 // DIGIT TWO FULL STOP
+	0x248a: {0x0033, 0x002e},                 // DIGIT THREE FULL STOP
+	0x248b: {0x0034, 0x002e},                 // DIGIT FOUR FULL STOP
+	0x248c: {0x0035, 0x002e},                 // DIGIT FIVE FULL STOP
+	0x248d: {0x0036, 0x002e},                 // DIGIT SIX FULL STOP
+	0x248e: {0x0037, 0x002e},                 // DIGIT SEVEN FULL STOP
+	0x248f: {0x0038, 0x002e},                 // DIGIT EIGHT FULL STOP
+	0x2490: {0x0039, 0x002e},                 // DIGIT NINE FULL STOP
+	0x2491: {0x006c, 0x004f, 0x002e},         // NUMBER TEN FULL STOP
+	0x2492: {0x006c, 0x006c, 0x002e},         // NUMBER ELEVEN FULL STOP
+	0x2493: {0x006c, 0x0032, 0x002e},         // NUMBER TWELVE FULL STOP
+	0x2494: {0x006c, 0x0033, 0x002e},         // NUMBER THIRTEEN FULL STOP
+	0x2495: {0x006c, 0x0034, 0x002e},         // NUMBER FOURTEEN FULL STOP
+	0x2496: {0x006c, 0x0035, 0x002e},         // NUMBER FIFTEEN FULL STOP
+	0x2497: {0x006c, 0x0036, 0x002e},         // NUMBER SIXTEEN FULL STOP
+	0x2498: {0x006c, 0x0037, 0x002e},         // NUMBER SEVENTEEN FULL STOP
+	0x2499: {0x006c, 0x0038, 0x002e},         // NUMBER EIGHTEEN FULL STOP
+	0x249a: {0x006c, 0x0039, 0x002e},         // NUMBER NINETEEN FULL STOP
+	0x249b: {0x0032, 0x004f, 0x002e},         // NUMBER TWENTY FULL STOP
+	0x249c: {0x0028, 0x0061, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER A
+	0x249d: {0x0028, 0x0062, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER B
+	0x249e: {0x0028, 0x0063, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER C
+	0x249f: {0x0028, 0x0064, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER D
+	0x24a0: {0x0028, 0x0065, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER E
+	0x24a1: {0x0028, 0x0066, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER F
+	0x24a2: {0x0028, 0x0067, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER G
+	0x24a3: {0x0028, 0x0068, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER H
+	0x24a4: {0x0028, 0x0069, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER I
+	0x24a5: {0x0028, 0x006a, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER J
+	0x24a6: {0x0028, 0x006b, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER K
+	0x24a7: {0x0028, 0x006c, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER L
+	0x24a8: {0x0028, 0x0072, 0x006e, 0x0029}, // PARENTHESIZED LATIN SMALL LETTER M
+	0x24a9: {0x0028, 0x006e, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER N
+	0x24aa: {0x0028, 0x006f, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER O
+	0x24ab: {0x0028, 0x0070, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER P
+	0x24ac: {0x0028, 0x0071, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER Q
+	0x24ad: {0x0028, 0x0072, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER R
+	0x24ae: {0x0028, 0x0073, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER S
+	0x24af: {0x0028, 0x0074, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER T
+	0x24b0: {0x0028, 0x0075, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER U
+	0x24b1: {0x0028, 0x0076, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER V
+	0x24b2: {0x0028, 0x0077, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER W
+	0x24b3: {0x0028, 0x0078, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER X
+	0x24b4: {0x0028, 0x0079, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER Y
+	0x24b5: {0x0028, 0x007a, 0x0029},         // PARENTHESIZED LATIN SMALL LETTER Z
+	0x24b8: {0x00a9},                         // CIRCLED LATIN CAPITAL LETTER C
+	0x24c5: {0x2117},                         // CIRCLED LATIN CAPITAL LETTER P
+	0x24c7: {0x00ae},                         // CIRCLED LATIN CAPITAL LETTER R
+	0x24db: {0x24be},                         // CIRCLED LATIN SMALL LETTER L
+	0x24ea: {0x1f10d},                        // CIRCLED DIGIT ZERO
+
+	0x2500: {0x30fc},  // BOX DRAWINGS LIGHT HORIZONTAL
+	0x2501: {0x30fc},  // BOX DRAWINGS HEAVY HORIZONTAL
+	0x2503: {0x2502},  // BOX DRAWINGS HEAVY VERTICAL
+	0x250f: {0x250c},  // BOX DRAWINGS HEAVY DOWN AND RIGHT
+	0x2523: {0x251c},  // BOX DRAWINGS HEAVY VERTICAL AND RIGHT
+	0x2571: {0x002f},  // BOX DRAWINGS LIGHT DIAGONAL UPPER RIGHT TO LOWER LEFT
+	0x2573: {0x0058},  // BOX DRAWINGS LIGHT DIAGONAL CROSS
+	0x2588: {0x220e},  // FULL BLOCK
+	0x2590: {0x258c},  // RIGHT HALF BLOCK
+	0x2594: {0x02c9},  // UPPER ONE EIGHTH BLOCK
+	0x2597: {0x2596},  // QUADRANT LOWER RIGHT
+	0x259d: {0x2598},  // QUADRANT UPPER RIGHT
+	0x25a0: {0x220e},  // BLACK SQUARE
+	0x25b1: {0x23e5},  // WHITE PARALLELOGRAM
+	0x25b3: {0x0394},  // WHITE UP-POINTING TRIANGLE
+	0x25b7: {0x22b3},  // WHITE RIGHT-POINTING TRIANGLE
+	0x25b8: {0x25b6},  // BLACK RIGHT-POINTING SMALL TRIANGLE
+	0x25ba: {0x25b6},  // BLACK RIGHT-POINTING POINTER
+	0x25bd: {0x102bc}, // WHITE DOWN-POINTING TRIANGLE
+	0x25c1: {0x22b2},  // WHITE LEFT-POINTING TRIANGLE
+	0x25c7: {0x16dc},  // WHITE DIAMOND
+	0x25ca: {0x16dc},  // LOZENGE
+	0x25cb: {0x00b0},  // WHITE CIRCLE
+	0x25ce: {0x233e},  // BULLSEYE
+	0x25e0: {0x2312},  // UPPER HALF CIRCLE
+	0x25e6: {0x00b0},  // WHITE BULLET
+
+	0x2609: {0x0298},                    // SUN
+	0x2610: {0x25a1},                    // BALLOT BOX
+	0x2625: {0x1099e},                   // ANKH
+	0x2630: {0x2cb6},                    // TRIGRAM FOR HEAVEN
+	0x2638: {0x2388},                    // WHEEL OF DHARMA
+	0x264e: {0x224f},                    // LIBRA
+	0x2662: {0x16dc},                    // WHITE DIAMOND SUIT
+	0x2669: {0x1d158, 0x1d165},          // QUARTER NOTE
+	0x266a: {0x1d158, 0x1d165, 0x1d16e}, // EIGHTH NOTE
+	0x26ac: {0x0970},                    // MEDIUM SMALL WHITE CIRCLE
+
+	0x2768: {0x0028},         // MEDIUM LEFT PARENTHESIS ORNAMENT
+	0x2769: {0x0029},         // MEDIUM RIGHT PARENTHESIS ORNAMENT
+	0x276e: {0x003c},         // HEAVY LEFT-POINTING ANGLE QUOTATION MARK ORNAMENT
+	0x276f: {0x003e},         // HEAVY RIGHT-POINTING ANGLE QUOTATION MARK ORNAMENT
+	0x2772: {0x0028},         // LIGHT LEFT TORTOISE SHELL BRACKET ORNAMENT
+	0x2773: {0x0029},         // LIGHT RIGHT TORTOISE SHELL BRACKET ORNAMENT
+	0x2774: {0x007b},         // MEDIUM LEFT CURLY BRACKET ORNAMENT
+	0x2775: {0x007d},         // MEDIUM RIGHT CURLY BRACKET ORNAMENT
+	0x2795: {0x002b},         // HEAVY PLUS SIGN
+	0x2796: {0x002d},         // HEAVY MINUS SIGN
+	0x2797: {0x00f7},         // HEAVY DIVISION SIGN
+	0x27c2: {0xa4d5},         // PERPENDICULAR
+	0x27c8: {0x005c, 0x1455}, // REVERSE SOLIDUS PRECEDING SUBSET
+	0x27c9: {0x1450, 0x002f}, // SUPERSET PRECEDING SOLIDUS
+	0x27cb: {0x002f},         // MATHEMATICAL RISING DIAGONAL
+	0x27cd: {0x005c},         // MATHEMATICAL FALLING DIAGONAL
+	0x27d9: {0x0054},         // LARGE DOWN TACK
+	0x27e8: {0x276c},         // MATHEMATICAL LEFT ANGLE BRACKET
+	0x27e9: {0x276d},         // MATHEMATICAL RIGHT ANGLE BRACKET
+
+	0x292b: {0x0078},         // RISING DIAGONAL CROSSING FALLING DIAGONAL
+	0x292c: {0x0078},         // FALLING DIAGONAL CROSSING RISING DIAGONAL
+	0x2963: {0x16d0, 0x16da}, // UPWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT
+	0x2965: {0x21c3, 0x21c2}, // DOWNWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT
+	0x296e: {0x16d0, 0x21c2}, // UPWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT
+	0x296f: {0x21c3, 0x16da}, // DOWNWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT
+	0x2999: {0x2d42},         // DOTTED FENCE
+	0x29b0: {0x2349},         // REVERSED EMPTY SET
+	0x29be: {0x233e},         // CIRCLED WHITE BULLET
+	0x29c4: {0x303c},         // SQUARED RISING DIAGONAL SLASH
+	0x29c5: {0x2342},         // SQUARED FALLING DIAGONAL SLASH
+	0x29c7: {0x233b},         // SQUARED SMALL CIRCLE
+	0x29d6: {0x102c0},        // WHITE HOURGLASS
+	0x29d9: {0x299a},         // RIGHT WIGGLY FENCE
+	0x29f4: {0x003a, 0x2192}, // RULE-DELAYED
+	0x29f5: {0x005c},         // REVERSE SOLIDUS OPERATOR
+	0x29f6: {0x002f, 0x0304}, // SOLIDUS WITH OVERBAR
+	0x29f8: {0x002f},         // BIG SOLIDUS
+	0x29f9: {0x005c},         // BIG REVERSE SOLIDUS
+
+	0x2a00: {0x0298},                         // N-ARY CIRCLED DOT OPERATOR
+	0x2a01: {0x102a8},                        // N-ARY CIRCLED PLUS OPERATOR
+	0x2a02: {0x2297},                         // N-ARY CIRCLED TIMES OPERATOR
+	0x2a03: {0x228d},                         // N-ARY UNION OPERATOR WITH DOT
+	0x2a04: {0x228e},                         // N-ARY UNION OPERATOR WITH PLUS
+	0x2a05: {0x2293},                         // N-ARY SQUARE INTERSECTION OPERATOR
+	0x2a06: {0x2294},                         // N-ARY SQUARE UNION OPERATOR
+	0x2a0c: {0x0283, 0x0283, 0x0283, 0x0283}, // QUADRUPLE INTEGRAL OPERATOR
+	0x2a1d: {0x16de},                         // JOIN
+	0x2a20: {0x003e, 0x003e},                 // Z NOTATION SCHEMA PIPING
+	0x2a21: {0x16da},                         // Z NOTATION SCHEMA PROJECTION
+	0x2a22: {0x002b, 0x030a},                 // PLUS SIGN WITH SMALL CIRCLE ABOVE
+	0x2a23: {0x002b, 0x0302},                 // PLUS SIGN WITH CIRCUMFLEX ACCENT ABOVE
+	0x2a24: {0x002b, 0x0303},                 // PLUS SIGN WITH TILDE ABOVE
+	0x2a25: {0x002b, 0x0323},                 // PLUS SIGN WITH DOT BELOW
+	0x2a26: {0x002b, 0x0330},                 // PLUS SIGN WITH TILDE BELOW
+	0x2a27: {0x002b, 0x2082},                 // PLUS SIGN WITH SUBSCRIPT TWO
+	0x2a29: {0x002d, 0x0313},                 // MINUS SIGN WITH COMMA ABOVE
+	0x2a2a: {0x002d, 0x0323},                 // MINUS SIGN WITH DOT BELOW
+	0x2a2f: {0x0078},                         // VECTOR OR CROSS PRODUCT
+	0x2a30: {0x0078, 0x0307},                 // MULTIPLICATION SIGN WITH DOT ABOVE
+	0x2a3d: {0x2319},                         // RIGHTHAND INTERIOR PRODUCT
+	0x2a3e: {0x2a1f},                         // Z NOTATION RELATIONAL COMPOSITION
+	0x2a3f: {0x2210},                         // AMALGAMATION OR COPRODUCT
+	0x2a6a: {0x007e, 0x0307},                 // TILDE OPERATOR WITH DOT ABOVE
+	0x2a6e: {0x003d, 0x20f0},                 // EQUALS WITH ASTERISK
+	0x2a74: {0x003a, 0x003a, 0x003d},         // DOUBLE COLON EQUAL
+	0x2a75: {0x003d, 0x003d},                 // TWO CONSECUTIVE EQUALS SIGNS
+	0x2a76: {0x003d, 0x003d, 0x003d},         // THREE CONSECUTIVE EQUALS SIGNS
+	0x2aa5: {0x003e, 0x003c},                 // GREATER-THAN BESIDE LESS-THAN
+	0x2aaa: {0x15d5},                         // SMALLER THAN
+	0x2aab: {0x15d2},                         // LARGER THAN
+	0x2ad7: {0x1450, 0x1455},                 // SUPERSET BESIDE SUBSET
+	0x2afb: {0x002f, 0x002f, 0x002f},         // TRIPLE SOLIDUS BINARY RELATION
+	0x2afd: {0x002f, 0x002f},                 // DOUBLE SOLIDUS OPERATOR
+
+	0x2bec: {0x219e}, // LEFTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+	0x2bed: {0x219f}, // UPWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+	0x2bee: {0x21a0}, // RIGHTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+	0x2bef: {0x21a1}, // DOWNWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS
+
+	0x2c67: {0x0048, 0x0329}, // LATIN CAPITAL LETTER H WITH DESCENDER
+	0x2c69: {0x004b, 0x0329}, // LATIN CAPITAL LETTER K WITH DESCENDER
+	0x2c84: {0x0393},         // COPTIC CAPITAL LETTER GAMMA
+	0x2c85: {0x0072},         // COPTIC SMALL LETTER GAMMA
+	0x2c86: {0x0394},         // COPTIC CAPITAL LETTER DALDA
+	0x2c88: {0xa792},         // COPTIC CAPITAL LETTER EIE
+	0x2c89: {0xa793},         // COPTIC SMALL LETTER EIE
+	0x2c8e: {0x0048},         // COPTIC CAPITAL LETTER HATE
+	0x2c92: {0x006c},         // COPTIC CAPITAL LETTER IAUDA
+	0x2c94: {0x004b},         // COPTIC CAPITAL LETTER KAPA
+	0x2c95: {0x0138},         // COPTIC SMALL LETTER KAPA
+	0x2c96: {0x03bb},         // COPTIC CAPITAL LETTER LAULA
+	0x2c98: {0x004d},         // COPTIC CAPITAL LETTER MI
+	0x2c9a: {0x004e},         // COPTIC CAPITAL LETTER NI
+	0x2c9e: {0x004f},         // COPTIC CAPITAL LETTER O
+	0x2c9f: {0x006f},         // COPTIC SMALL LETTER O
+	0x2ca0: {0x03a0},         // COPTIC CAPITAL LETTER PI
+	0x2ca2: {0x0050},         // COPTIC CAPITAL LETTER RO
+	0x2ca3: {0x0070},         // COPTIC SMALL LETTER RO
+	0x2ca4: {0x0043},         // COPTIC CAPITAL LETTER SIMA
+	0x2ca5: {0x0063},         // COPTIC SMALL LETTER SIMA
+	0x2ca6: {0x0054},         // COPTIC CAPITAL LETTER TAU
+	0x2ca8: {0x0059},         // COPTIC CAPITAL LETTER UA
+	0x2caa: {0x03a6},         // COPTIC CAPITAL LETTER FI
+	0x2cab: {0x0278},         // COPTIC SMALL LETTER FI
+	0x2cac: {0x0058},         // COPTIC CAPITAL LETTER KHI
+	0x2cad: {0x03c7},         // COPTIC SMALL LETTER KHI
+	0x2cae: {0x03a8},         // COPTIC CAPITAL LETTER PSI
+	0x2cb1: {0x03c9},         // COPTIC SMALL LETTER OOU
+	0x2cb4: {0x003c, 0x00b7}, // COPTIC CAPITAL LETTER OLD COPTIC AIN
+	0x2cba: {0x002d},         // COPTIC CAPITAL LETTER DIALECT-P NI
+	0x2cbc: {0x0428},         // COPTIC CAPITAL LETTER CRYPTOGRAMMIC NI
+	0x2cbd: {0x0448},         // COPTIC SMALL LETTER CRYPTOGRAMMIC NI
+	0x2cc6: {0x002f},         // COPTIC CAPITAL LETTER OLD COPTIC ESH
+	0x2cca: {0x0039},         // COPTIC CAPITAL LETTER DIALECT-P HORI
+	0x2ccc: {0x0033},         // COPTIC CAPITAL LETTER OLD COPTIC HORI
+	0x2ccd: {0x021d},         // COPTIC SMALL LETTER OLD COPTIC HORI
+	0x2cd0: {0x004c},         // COPTIC CAPITAL LETTER L-SHAPED HA
+	0x2cd1: {0x029f},         // COPTIC SMALL LETTER L-SHAPED HA
+	0x2cd2: {0x0036},         // COPTIC CAPITAL LETTER OLD COPTIC HEI
+	0x2cdc: {0x03ec},         // COPTIC CAPITAL LETTER OLD NUBIAN SHIMA
+	0x2ce4: {0x03d7},         // COPTIC SYMBOL KAI
+	0x2ce9: {0x2627},         // COPTIC SYMBOL KHI RO
+	0x2cf9: {0x005c, 0x005c}, // COPTIC OLD NUBIAN FULL STOP
+
+	0x2d31: {0x004f, 0x0335},         // TIFINAGH LETTER YAB
+	0x2d37: {0x0245},                 // TIFINAGH LETTER YAD
+	0x2d38: {0x0056},                 // TIFINAGH LETTER YADH
+	0x2d39: {0x0045},                 // TIFINAGH LETTER YADD
+	0x2d3a: {0x018e},                 // TIFINAGH LETTER YADDH
+	0x2d41: {0x004f, 0x0338},         // TIFINAGH LETTER BERBER ACADEMY YAH
+	0x2d48: {0x00b7, 0x00b7, 0x00b7}, // TIFINAGH LETTER TUAREG YAQ
+	0x2d49: {0x01a9},                 // TIFINAGH LETTER YI
+	0x2d4f: {0x006c},                 // TIFINAGH LETTER YAN
+	0x2d51: {0x0021},                 // TIFINAGH LETTER TUAREG YANG
+	0x2d54: {0x004f},                 // TIFINAGH LETTER YAR
+	0x2d55: {0x0051},                 // TIFINAGH LETTER YARR
+	0x2d59: {0x0298},                 // TIFINAGH LETTER YAS
+	0x2d5d: {0x0058},                 // TIFINAGH LETTER YATH
+	0x2d60: {0x0394},                 // TIFINAGH LETTER YAV
+	0x2d63: {0x16ef},                 // TIFINAGH LETTER YAZ
+	0x2de8: {0x1ddf},                 // COMBINING CYRILLIC LETTER EM
+	0x2dea: {0x030a},                 // COMBINING CYRILLIC LETTER O
+	0x2ded: {0x0368},                 // COMBINING CYRILLIC LETTER ES
+	0x2def: {0x036f},                 // COMBINING CYRILLIC LETTER HA
+	0x2df6: {0x0363},                 // COMBINING CYRILLIC LETTER A
+	0x2df7: {0x0364},                 // COMBINING CYRILLIC LETTER IE
+
+	0x2e1a: {0x002d, 0x0308}, // HYPHEN WITH DIAERESIS
+	0x2e1e: {0x007e, 0x0307}, // TILDE WITH DOT ABOVE
+	0x2e1f: {0x007e, 0x0323}, // TILDE WITH DOT BELOW
+	0x2e26: {0x1455},         // LEFT SIDEWAYS U BRACKET
+	0x2e27: {0x1450},         // RIGHT SIDEWAYS U BRACKET
+	0x2e28: {0x0028, 0x0028}, // LEFT DOUBLE PARENTHESIS
+	0x2e29: {0x0029, 0x0029}, // RIGHT DOUBLE PARENTHESIS
+	0x2e2a: {0x2235},         // TWO DOTS OVER ONE DOT PUNCTUATION
+	0x2e2b: {0x2234},         // ONE DOT OVER TWO DOTS PUNCTUATION
+	0x2e2c: {0x2237},         // SQUARED FOUR DOT PUNCTUATION
+	0x2e2e: {0x061f},         // REVERSED QUESTION MARK
+	0x2e30: {0x00b0},         // RING POINT
+	0x2e31: {0x00b7},         // WORD SEPARATOR MIDDLE DOT
+	0x2e32: {0x060c},         // TURNED COMMA
+	0x2e35: {0x061b},         // TURNED SEMICOLON
+	0x2e39: {0x1e9f},         // TOP HALF SECTION SIGN
+	0x2e3d: {0x2d42},         // VERTICAL SIX DOTS
+	0x2e3f: {0x00b6},         // CAPITULUM
+	0x2e40: {0x003d},         // DOUBLE HYPHEN
+	0x2e82: {0x4e5b},         // CJK RADICAL SECOND ONE
+	0x2e83: {0x4e5a},         // CJK RADICAL SECOND TWO
+	0x2e85: {0x4ebb},         // CJK RADICAL PERSON
+	0x2e89: {0x5202},         // CJK RADICAL KNIFE TWO
+	0x2e8b: {0x353e},         // CJK RADICAL SEAL
+	0x2e8e: {0x5140},         // CJK RADICAL LAME ONE
+	0x2e8f: {0x5c23},         // CJK RADICAL LAME TWO
+	0x2e90: {0x5c22},         // CJK RADICAL LAME THREE
+	0x2e92: {0x5df3},         // CJK RADICAL SNAKE
+	0x2e93: {0x5e7a},         // CJK RADICAL THREAD
+	0x2e94: {0x5f51},         // CJK RADICAL SNOUT ONE
+	0x2e96: {0x5fc4},         // CJK RADICAL HEART ONE
+	0x2e97: {0x38fa},         // CJK RADICAL HEART TWO
+	0x2e98: {0x624c},         // CJK RADICAL HAND
+	0x2e99: {0x6535},         // CJK RADICAL RAP
+	0x2e9b: {0x65e1},         // CJK RADICAL CHOKE
+	0x2e9e: {0x6b7a},         // CJK RADICAL DEATH
+	0x2e9f: {0x6bcd},         // CJK RADICAL MOTHER
+	0x2ea0: {0x6c11},         // CJK RADICAL CIVILIAN
+	0x2ea1: {0x6c35},         // CJK RADICAL WATER ONE
+	0x2ea2: {0x6c3a},         // CJK RADICAL WATER TWO
+	0x2ea3: {0x706c},         // CJK RADICAL FIRE
+	0x2ea4: {0x722b},         // CJK RADICAL PAW ONE
+	0x2ea6: {0x4e2c},         // CJK RADICAL SIMPLIFIED HALF TREE TRUNK
+	0x2ea8: {0x72ad},         // CJK RADICAL DOG
+	0x2eab: {0x7f52},         // CJK RADICAL EYE
+	0x2ead: {0x793b},         // CJK RADICAL SPIRIT TWO
+	0x2eaf: {0x7cf9},         // CJK RADICAL SILK
+	0x2eb1: {0x7f53},         // CJK RADICAL NET ONE
+	0x2eb2: {0x7f52},         // CJK RADICAL NET TWO
+	0x2eb9: {0x8002},         // CJK RADICAL OLD
+	0x2eba: {0x8080},         // CJK RADICAL BRUSH ONE
+	0x2ebe: {0x8279},         // CJK RADICAL GRASS ONE
+	0x2ebf: {0x8279},         // CJK RADICAL GRASS TWO
+	0x2ec0: {0x8279},         // CJK RADICAL GRASS THREE
+	0x2ec1: {0x864e},         // CJK RADICAL TIGER
+	0x2ec2: {0x8864},         // CJK RADICAL CLOTHES
+	0x2ec3: {0x8980},         // CJK RADICAL WEST ONE
+	0x2ec4: {0x897f},         // CJK RADICAL WEST TWO
+	0x2ec5: {0x89c1},         // CJK RADICAL C-SIMPLIFIED SEE
+	0x2ec8: {0x8ba0},         // CJK RADICAL C-SIMPLIFIED SPEECH
+	0x2ec9: {0x8d1d},         // CJK RADICAL C-SIMPLIFIED SHELL
+	0x2ecb: {0x8f66},         // CJK RADICAL C-SIMPLIFIED CART
+	0x2ecc: {0x8fb6},         // CJK RADICAL SIMPLIFIED WALK
+	0x2ecd: {0x8fb6},         // CJK RADICAL WALK ONE
+	0x2ecf: {0x961d},         // CJK RADICAL CITY
+	0x2ed0: {0x9485},         // CJK RADICAL C-SIMPLIFIED GOLD
+	0x2ed1: {0x9577},         // CJK RADICAL LONG ONE
+	0x2ed2: {0x9578},         // CJK RADICAL LONG TWO
+	0x2ed3: {0x957f},         // CJK RADICAL C-SIMPLIFIED LONG
+	0x2ed4: {0x95e8},         // CJK RADICAL C-SIMPLIFIED GATE
+	0x2ed6: {0x961d},         // CJK RADICAL MOUND TWO
+	0x2ed8: {0x9752},         // CJK RADICAL BLUE
+	0x2ed9: {0x97e6},         // CJK RADICAL C-SIMPLIFIED TANNED LEATHER
+	0x2eda: {0x9875},         // CJK RADICAL C-SIMPLIFIED LEAF
+	0x2edb: {0x98ce},         // CJK RADICAL C-SIMPLIFIED WIND
+	0x2edc: {0x98de},         // CJK RADICAL C-SIMPLIFIED FLY
+	0x2edd: {0x98df},         // CJK RADICAL EAT ONE
+	0x2edf: {0x98e0},         // CJK RADICAL EAT THREE
+	0x2ee0: {0x9963},         // CJK RADICAL C-SIMPLIFIED EAT
+	0x2ee2: {0x9a6c},         // CJK RADICAL C-SIMPLIFIED HORSE
+	0x2ee4: {0x9b3c},         // CJK RADICAL GHOST
+	0x2ee5: {0x9c7c},         // CJK RADICAL C-SIMPLIFIED FISH
+	0x2ee8: {0x9ea6},         // CJK RADICAL SIMPLIFIED WHEAT
+	0x2ee9: {0x9ec4},         // CJK RADICAL SIMPLIFIED YELLOW
+	0x2eeb: {0x6589},         // CJK RADICAL J-SIMPLIFIED EVEN
+	0x2eec: {0x9f50},         // CJK RADICAL C-SIMPLIFIED EVEN
+	0x2eed: {0x6b6f},         // CJK RADICAL J-SIMPLIFIED TOOTH
+	0x2eee: {0x9f7f},         // CJK RADICAL C-SIMPLIFIED TOOTH
+	0x2eef: {0x7adc},         // CJK RADICAL J-SIMPLIFIED DRAGON
+	0x2ef0: {0x9f99},         // CJK RADICAL C-SIMPLIFIED DRAGON
+	0x2ef2: {0x4e80},         // CJK RADICAL J-SIMPLIFIED TURTLE
+	0x2ef3: {0x9f9f},         // CJK RADICAL C-SIMPLIFIED TURTLE
+
+	0x2f00: {0x30fc}, // KANGXI RADICAL ONE
+	0x2f01: {0x4e28}, // KANGXI RADICAL LINE
+	0x2f02: {0x005c}, // KANGXI RADICAL DOT
+	0x2f03: {0x002f}, // KANGXI RADICAL SLASH
+	0x2f04: {0x4e59}, // KANGXI RADICAL SECOND
+	0x2f05: {0x4e85}, // KANGXI RADICAL HOOK
+	0x2f06: {0x4e8c}, // KANGXI RADICAL TWO
+	0x2f07: {0x4ea0}, // KANGXI RADICAL LID
+	0x2f08: {0x4eba}, // KANGXI RADICAL MAN
+	0x2f09: {0x513f}, // KANGXI RADICAL LEGS
+	0x2f0a: {0x5165}, // KANGXI RADICAL ENTER
+	0x2f0b: {0x516b}, // KANGXI RADICAL EIGHT
+	0x2f0c: {0x5182}, // KANGXI RADICAL DOWN BOX
+	0x2f0d: {0x5196}, // KANGXI RADICAL COVER
+	0x2f0e: {0x51ab}, // KANGXI RADICAL ICE
+	0x2f0f: {0x51e0}, // KANGXI RADICAL TABLE
+	0x2f10: {0x51f5}, // KANGXI RADICAL OPEN BOX
+	0x2f11: {0x5200}, // KANGXI RADICAL KNIFE
+	0x2f12: {0x529b}, // KANGXI RADICAL POWER
+	0x2f13: {0x52f9}, // KANGXI RADICAL WRAP
+	0x2f14: {0x5315}, // KANGXI RADICAL SPOON
+	0x2f15: {0x531a}, // KANGXI RADICAL RIGHT OPEN BOX
+	0x2f16: {0x5338}, // KANGXI RADICAL HIDING ENCLOSURE
+	0x2f17: {0x5341}, // KANGXI RADICAL TEN
+	0x2f18: {0x535c}, // KANGXI RADICAL DIVINATION
+	0x2f19: {0x5369}, // KANGXI RADICAL SEAL
+	0x2f1a: {0x5382}, // KANGXI RADICAL CLIFF
+	0x2f1b: {0x53b6}, // KANGXI RADICAL PRIVATE
+	0x2f1c: {0x53c8}, // KANGXI RADICAL AGAIN
+	0x2f1d: {0x53e3}, // KANGXI RADICAL MOUTH
+	0x2f1e: {0x53e3}, // KANGXI RADICAL ENCLOSURE
+	0x2f1f: {0x571f}, // KANGXI RADICAL EARTH
+	0x2f20: {0x571f}, // KANGXI RADICAL SCHOLAR
+	0x2f21: {0x5902}, // KANGXI RADICAL GO
+	0x2f22: {0x590a}, // KANGXI RADICAL GO SLOWLY
+	0x2f23: {0x5915}, // KANGXI RADICAL EVENING
+	0x2f24: {0x5927}, // KANGXI RADICAL BIG
+	0x2f25: {0x5973}, // KANGXI RADICAL WOMAN
+	0x2f26: {0x5b50}, // KANGXI RADICAL CHILD
+	0x2f27: {0x5b80}, // KANGXI RADICAL ROOF
+	0x2f28: {0x5bf8}, // KANGXI RADICAL INCH
+	0x2f29: {0x5c0f}, // KANGXI RADICAL SMALL
+	0x2f2a: {0x5c22}, // KANGXI RADICAL LAME
+	0x2f2b: {0x5c38}, // KANGXI RADICAL CORPSE
+	0x2f2c: {0x5c6e}, // KANGXI RADICAL SPROUT
+	0x2f2d: {0x5c71}, // KANGXI RADICAL MOUNTAIN
+	0x2f2e: {0x5ddb}, // KANGXI RADICAL RIVER
+	0x2f2f: {0x5de5}, // KANGXI RADICAL WORK
+	0x2f30: {0x5df1}, // KANGXI RADICAL ONESELF
+	0x2f31: {0x5dfe}, // KANGXI RADICAL TURBAN
+	0x2f32: {0x5e72}, // KANGXI RADICAL DRY
+	0x2f33: {0x5e7a}, // KANGXI RADICAL SHORT THREAD
+	0x2f34: {0x5e7f}, // KANGXI RADICAL DOTTED CLIFF
+	0x2f35: {0x5ef4}, // KANGXI RADICAL LONG STRIDE
+	0x2f36: {0x5efe}, // KANGXI RADICAL TWO HANDS
+	0x2f37: {0x5f0b}, // KANGXI RADICAL SHOOT
+	0x2f38: {0x5f13}, // KANGXI RADICAL BOW
+	0x2f39: {0x5f50}, // KANGXI RADICAL SNOUT
+	0x2f3a: {0x5f61}, // KANGXI RADICAL BRISTLE
+	0x2f3b: {0x5f73}, // KANGXI RADICAL STEP
+	0x2f3c: {0x5fc3}, // KANGXI RADICAL HEART
+	0x2f3d: {0x6208}, // KANGXI RADICAL HALBERD
+	0x2f3e: {0x6236}, // KANGXI RADICAL DOOR
+	0x2f3f: {0x624b}, // KANGXI RADICAL HAND
+	0x2f40: {0x652f}, // KANGXI RADICAL BRANCH
+	0x2f41: {0x6534}, // KANGXI RADICAL RAP
+	0x2f42: {0x6587}, // KANGXI RADICAL SCRIPT
+	0x2f43: {0x6597}, // KANGXI RADICAL DIPPER
+	0x2f44: {0x65a4}, // KANGXI RADICAL AXE
+	0x2f45: {0x65b9}, // KANGXI RADICAL SQUARE
+	0x2f46: {0x65e0}, // KANGXI RADICAL NOT
+	0x2f47: {0x65e5}, // KANGXI RADICAL SUN
+	0x2f48: {0x66f0}, // KANGXI RADICAL SAY
+	0x2f49: {0x6708}, // KANGXI RADICAL MOON
+	0x2f4a: {0x6728}, // KANGXI RADICAL TREE
+	0x2f4b: {0x6b20}, // KANGXI RADICAL LACK
+	0x2f4c: {0x6b62}, // KANGXI RADICAL STOP
+	0x2f4d: {0x6b79}, // KANGXI RADICAL DEATH
+	0x2f4e: {0x6bb3}, // KANGXI RADICAL WEAPON
+	0x2f4f: {0x6bcb}, // KANGXI RADICAL DO NOT
+	0x2f50: {0x6bd4}, // KANGXI RADICAL COMPARE
+	0x2f51: {0x6bdb}, // KANGXI RADICAL FUR
+	0x2f52: {0x6c0f}, // KANGXI RADICAL CLAN
+	0x2f53: {0x6c14}, // KANGXI RADICAL STEAM
+	0x2f54: {0x6c34}, // KANGXI RADICAL WATER
+	0x2f55: {0x706b}, // KANGXI RADICAL FIRE
+	0x2f56: {0x722a}, // KANGXI RADICAL CLAW
+	0x2f57: {0x7236}, // KANGXI RADICAL FATHER
+	0x2f58: {0x723b}, // KANGXI RADICAL DOUBLE X
+	0x2f59: {0x723f}, // KANGXI RADICAL HALF TREE TRUNK
+	0x2f5a: {0x7247}, // KANGXI RADICAL SLICE
+	0x2f5b: {0x7259}, // KANGXI RADICAL FANG
+	0x2f5c: {0x725b}, // KANGXI RADICAL COW
+	0x2f5d: {0x72ac}, // KANGXI RADICAL DOG
+	0x2f5e: {0x7384}, // KANGXI RADICAL PROFOUND
+	0x2f5f: {0x7389}, // KANGXI RADICAL JADE
+	0x2f60: {0x74dc}, // KANGXI RADICAL MELON
+	0x2f61: {0x74e6}, // KANGXI RADICAL TILE
+	0x2f62: {0x7518}, // KANGXI RADICAL SWEET
+	0x2f63: {0x751f}, // KANGXI RADICAL LIFE
+	0x2f64: {0x7528}, // KANGXI RADICAL USE
+	0x2f65: {0x7530}, // KANGXI RADICAL FIELD
+	0x2f66: {0x758b}, // KANGXI RADICAL BOLT OF CLOTH
+	0x2f67: {0x7592}, // KANGXI RADICAL SICKNESS
+	0x2f68: {0x7676}, // KANGXI RADICAL DOTTED TENT
+	0x2f69: {0x767d}, // KANGXI RADICAL WHITE
+	0x2f6a: {0x76ae}, // KANGXI RADICAL SKIN
+	0x2f6b: {0x76bf}, // KANGXI RADICAL DISH
+	0x2f6c: {0x76ee}, // KANGXI RADICAL EYE
+	0x2f6d: {0x77db}, // KANGXI RADICAL SPEAR
+	0x2f6e: {0x77e2}, // KANGXI RADICAL ARROW
+	0x2f6f: {0x77f3}, // KANGXI RADICAL STONE
+	0x2f70: {0x793a}, // KANGXI RADICAL SPIRIT
+	0x2f71: {0x79b8}, // KANGXI RADICAL TRACK
+	0x2f72: {0x79be}, // KANGXI RADICAL GRAIN
+	0x2f73: {0x7a74}, // KANGXI RADICAL CAVE
+	0x2f74: {0x7acb}, // KANGXI RADICAL STAND
+	0x2f75: {0x7af9}, // KANGXI RADICAL BAMBOO
+	0x2f76: {0x7c73}, // KANGXI RADICAL RICE
+	0x2f77: {0x7cf8}, // KANGXI RADICAL SILK
+	0x2f78: {0x7f36}, // KANGXI RADICAL JAR
+	0x2f79: {0x7f51}, // KANGXI RADICAL NET
+	0x2f7a: {0x7f8a}, // KANGXI RADICAL SHEEP
+	0x2f7b: {0x7fbd}, // KANGXI RADICAL FEATHER
+	0x2f7c: {0x8001}, // KANGXI RADICAL OLD
+	0x2f7d: {0x800c}, // KANGXI RADICAL AND
+	0x2f7e: {0x8012}, // KANGXI RADICAL PLOW
+	0x2f7f: {0x8033}, // KANGXI RADICAL EAR
+	0x2f80: {0x807f}, // KANGXI RADICAL BRUSH
+	0x2f81: {0x8089}, // KANGXI RADICAL MEAT
+	0x2f82: {0x81e3}, // KANGXI RADICAL MINISTER
+	0x2f83: {0x81ea}, // KANGXI RADICAL SELF
+	0x2f84: {0x81f3}, // KANGXI RADICAL ARRIVE
+	0x2f85: {0x81fc}, // KANGXI RADICAL MORTAR
+	0x2f86: {0x820c}, // KANGXI RADICAL TONGUE
+	0x2f87: {0x821b}, // KANGXI RADICAL OPPOSE
+	0x2f88: {0x821f}, // KANGXI RADICAL BOAT
+	0x2f89: {0x826e}, // KANGXI RADICAL STOPPING
+	0x2f8a: {0x8272}, // KANGXI RADICAL COLOR
+	0x2f8b: {0x8278}, // KANGXI RADICAL GRASS
+	0x2f8c: {0x864d}, // KANGXI RADICAL TIGER
+	0x2f8d: {0x866b}, // KANGXI RADICAL INSECT
+	0x2f8e: {0x8840}, // KANGXI RADICAL BLOOD
+	0x2f8f: {0x884c}, // KANGXI RADICAL WALK ENCLOSURE
+	0x2f90: {0x8863}, // KANGXI RADICAL CLOTHES
+	0x2f91: {0x897e}, // KANGXI RADICAL WEST
+	0x2f92: {0x898b}, // KANGXI RADICAL SEE
+	0x2f93: {0x89d2}, // KANGXI RADICAL HORN
+	0x2f94: {0x8a00}, // KANGXI RADICAL SPEECH
+	0x2f95: {0x8c37}, // KANGXI RADICAL VALLEY
+	0x2f96: {0x8c46}, // KANGXI RADICAL BEAN
+	0x2f97: {0x8c55}, // KANGXI RADICAL PIG
+	0x2f98: {0x8c78}, // KANGXI RADICAL BADGER
+	0x2f99: {0x8c9d}, // KANGXI RADICAL SHELL
+	0x2f9a: {0x8d64}, // KANGXI RADICAL RED
+	0x2f9b: {0x8d70}, // KANGXI RADICAL RUN
+	0x2f9c: {0x8db3}, // KANGXI RADICAL FOOT
+	0x2f9d: {0x8eab}, // KANGXI RADICAL BODY
+	0x2f9e: {0x8eca}, // KANGXI RADICAL CART
+	0x2f9f: {0x8f9b}, // KANGXI RADICAL BITTER
+	0x2fa0: {0x8fb0}, // KANGXI RADICAL MORNING
+	0x2fa1: {0x8fb5}, // KANGXI RADICAL WALK
+	0x2fa2: {0x9091}, // KANGXI RADICAL CITY
+	0x2fa3: {0x9149}, // KANGXI RADICAL WINE
+	0x2fa4: {0x91c6}, // KANGXI RADICAL DISTINGUISH
+	0x2fa5: {0x91cc}, // KANGXI RADICAL VILLAGE
+	0x2fa6: {0x91d1}, // KANGXI RADICAL GOLD
+	0x2fa7: {0x9577}, // KANGXI RADICAL LONG
+	0x2fa8: {0x9580}, // KANGXI RADICAL GATE
+	0x2fa9: {0x961c}, // KANGXI RADICAL MOUND
+	0x2faa: {0x96b6}, // KANGXI RADICAL SLAVE
+	0x2fab: {0x96b9}, // KANGXI RADICAL SHORT TAILED BIRD
+	0x2fac: {0x96e8}, // KANGXI RADICAL RAIN
+	0x2fad: {0x9751}, // KANGXI RADICAL BLUE
+	0x2fae: {0x975e}, // KANGXI RADICAL WRONG
+	0x2faf: {0x9762}, // KANGXI RADICAL FACE
+	0x2fb0: {0x9769}, // KANGXI RADICAL LEATHER
+	0x2fb1: {0x97cb}, // KANGXI RADICAL TANNED LEATHER
+	0x2fb2: {0x97ed}, // KANGXI RADICAL LEEK
+	0x2fb3: {0x97f3}, // KANGXI RADICAL SOUND
+	0x2fb4: {0x9801}, // KANGXI RADICAL LEAF
+	0x2fb5: {0x98a8}, // KANGXI RADICAL WIND
+	0x2fb6: {0x98db}, // KANGXI RADICAL FLY
+	0x2fb7: {0x98df}, // KANGXI RADICAL EAT
+	0x2fb8: {0x9996}, // KANGXI RADICAL HEAD
+	0x2fb9: {0x9999}, // KANGXI RADICAL FRAGRANT
+	0x2fba: {0x99ac}, // KANGXI RADICAL HORSE
+	0x2fbb: {0x9aa8}, // KANGXI RADICAL BONE
+	0x2fbc: {0x9ad8}, // KANGXI RADICAL TALL
+	0x2fbd: {0x9adf}, // KANGXI RADICAL HAIR
+	0x2fbe: {0x9b25}, // KANGXI RADICAL FIGHT
+	0x2fbf: {0x9b2f}, // KANGXI RADICAL SACRIFICIAL WINE
+	0x2fc0: {0x9b32}, // KANGXI RADICAL CAULDRON
+	0x2fc1: {0x9b3c}, // KANGXI RADICAL GHOST
+	0x2fc2: {0x9b5a}, // KANGXI RADICAL FISH
+	0x2fc3: {0x9ce5}, // KANGXI RADICAL BIRD
+	0x2fc4: {0x9e75}, // KANGXI RADICAL SALT
+	0x2fc5: {0x9e7f}, // KANGXI RADICAL DEER
+	0x2fc6: {0x9ea5}, // KANGXI RADICAL WHEAT
+	0x2fc7: {0x9ebb}, // KANGXI RADICAL HEMP
+	0x2fc8: {0x9ec3}, // KANGXI RADICAL YELLOW
+	0x2fc9: {0x9ecd}, // KANGXI RADICAL MILLET
+	0x2fca: {0x9ed1}, // KANGXI RADICAL BLACK
+	0x2fcb: {0x9ef9}, // KANGXI RADICAL EMBROIDERY
+	0x2fcc: {0x9efd}, // KANGXI RADICAL FROG
+	0x2fcd: {0x9f0e}, // KANGXI RADICAL TRIPOD
+	0x2fce: {0x9f13}, // KANGXI RADICAL DRUM
+	0x2fcf: {0x9f20}, // KANGXI RADICAL RAT
+	0x2fd0: {0x9f3b}, // KANGXI RADICAL NOSE
+	0x2fd1: {0x9f4a}, // KANGXI RADICAL EVEN
+	0x2fd2: {0x9f52}, // KANGXI RADICAL TOOTH
+	0x2fd3: {0x9f8d}, // KANGXI RADICAL DRAGON
+	0x2fd4: {0x9f9c}, // KANGXI RADICAL TURTLE
+	0x2fd5: {0x9fa0}, // KANGXI RADICAL FLUTE
+
+	0x3002: {0x02f3},         // IDEOGRAPHIC FULL STOP
+	0x3003: {0x0027, 0x0027}, // DITTO MARK
+	0x3007: {0x004f},         // IDEOGRAPHIC NUMBER ZERO
+	0x3008: {0x276c},         // LEFT ANGLE BRACKET
+	0x3009: {0x276d},         // RIGHT ANGLE BRACKET
+	0x3012: {0x20b8},         // POSTAL MARK
+	0x3014: {0x0028},         // LEFT TORTOISE SHELL BRACKET
+	0x3015: {0x0029},         // RIGHT TORTOISE SHELL BRACKET
+	0x301a: {0x27e6},         // LEFT WHITE SQUARE BRACKET
+	0x301b: {0x27e7},         // RIGHT WHITE SQUARE BRACKET
+	0x302c: {0x0309},         // IDEOGRAPHIC DEPARTING TONE MARK
+	0x302d: {0x0325},         // IDEOGRAPHIC ENTERING TONE MARK
+	0x3033: {0x002f},         // VERTICAL KANA REPEAT MARK UPPER HALF
+	0x3036: {0x20b8},         // CIRCLED POSTAL MARK
+	0x3038: {0x5341},         // HANGZHOU NUMERAL TEN
+	0x3039: {0x5344},         // HANGZHOU NUMERAL TWENTY
+	0x303a: {0x5345},         // HANGZHOU NUMERAL THIRTY
+	0x304f: {0x276c},         // HIRAGANA LETTER KU
+	0x309a: {0x030a},         // COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	0x309b: {0xff9e},         // KATAKANA-HIRAGANA VOICED SOUND MARK
+	0x309c: {0xff9f},         // KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK
+	0x30a0: {0x003d},         // KATAKANA-HIRAGANA DOUBLE HYPHEN
+	0x30a4: {0x4ebb},         // KATAKANA LETTER I
+	0x30a8: {0x5de5},         // KATAKANA LETTER E
+	0x30ab: {0x529b},         // KATAKANA LETTER KA
+	0x30bf: {0x5915},         // KATAKANA LETTER TA
+	0x30c8: {0x535c},         // KATAKANA LETTER TO
+	0x30cb: {0x4e8c},         // KATAKANA LETTER NI
+	0x30ce: {0x002f},         // KATAKANA LETTER NO
+	0x30cf: {0x516b},         // KATAKANA LETTER HA
+	0x30d8: {0x3078},         // KATAKANA LETTER HE
+	0x30ed: {0x53e3},         // KATAKANA LETTER RO
+	0x30fb: {0x00b7},         // KATAKANA MIDDLE DOT
+
+	0x3131: {0x1100},                 // HANGUL LETTER KIYEOK
+	0x3132: {0x1100, 0x1100},         // HANGUL LETTER SSANGKIYEOK
+	0x3133: {0x1100, 0x1109},         // HANGUL LETTER KIYEOK-SIOS
+	0x3134: {0x1102},                 // HANGUL LETTER NIEUN
+	0x3135: {0x1102, 0x110c},         // HANGUL LETTER NIEUN-CIEUC
+	0x3136: {0x1102, 0x1112},         // HANGUL LETTER NIEUN-HIEUH
+	0x3137: {0x1103},                 // HANGUL LETTER TIKEUT
+	0x3138: {0x1103, 0x1103},         // HANGUL LETTER SSANGTIKEUT
+	0x3139: {0x1105},                 // HANGUL LETTER RIEUL
+	0x313a: {0x1105, 0x1100},         // HANGUL LETTER RIEUL-KIYEOK
+	0x313b: {0x1105, 0x1106},         // HANGUL LETTER RIEUL-MIEUM
+	0x313c: {0x1105, 0x1107},         // HANGUL LETTER RIEUL-PIEUP
+	0x313d: {0x1105, 0x1109},         // HANGUL LETTER RIEUL-SIOS
+	0x313e: {0x1105, 0x1110},         // HANGUL LETTER RIEUL-THIEUTH
+	0x313f: {0x1105, 0x1111},         // HANGUL LETTER RIEUL-PHIEUPH
+	0x3140: {0x1105, 0x1112},         // HANGUL LETTER RIEUL-HIEUH
+	0x3141: {0x1106},                 // HANGUL LETTER MIEUM
+	0x3142: {0x1107},                 // HANGUL LETTER PIEUP
+	0x3143: {0x1107, 0x1107},         // HANGUL LETTER SSANGPIEUP
+	0x3144: {0x1107, 0x1109},         // HANGUL LETTER PIEUP-SIOS
+	0x3145: {0x1109},                 // HANGUL LETTER SIOS
+	0x3146: {0x1109, 0x1109},         // HANGUL LETTER SSANGSIOS
+	0x3147: {0x110b},                 // HANGUL LETTER IEUNG
+	0x3148: {0x110c},                 // HANGUL LETTER CIEUC
+	0x3149: {0x110c, 0x110c},         // HANGUL LETTER SSANGCIEUC
+	0x314a: {0x110e},                 // HANGUL LETTER CHIEUCH
+	0x314b: {0x110f},                 // HANGUL LETTER KHIEUKH
+	0x314c: {0x1110},                 // HANGUL LETTER THIEUTH
+	0x314d: {0x1111},                 // HANGUL LETTER PHIEUPH
+	0x314e: {0x1112},                 // HANGUL LETTER HIEUH
+	0x314f: {0x1161},                 // HANGUL LETTER A
+	0x3150: {0x1161, 0x4e28},         // HANGUL LETTER AE
+	0x3151: {0x1163},                 // HANGUL LETTER YA
+	0x3152: {0x1163, 0x4e28},         // HANGUL LETTER YAE
+	0x3153: {0x1165},                 // HANGUL LETTER EO
+	0x3154: {0x1165, 0x4e28},         // HANGUL LETTER E
+	0x3155: {0x1167},                 // HANGUL LETTER YEO
+	0x3156: {0x1167, 0x4e28},         // HANGUL LETTER YE
+	0x3157: {0x1169},                 // HANGUL LETTER O
+	0x3158: {0x1169, 0x1161},         // HANGUL LETTER WA
+	0x3159: {0x1169, 0x1161, 0x4e28}, // HANGUL LETTER WAE
+	0x315a: {0x1169, 0x4e28},         // HANGUL LETTER OE
+	0x315b: {0x116d},                 // HANGUL LETTER YO
+	0x315c: {0x116e},                 // HANGUL LETTER U
+	0x315d: {0x116e, 0x1165},         // HANGUL LETTER WEO
+	0x315e: {0x116e, 0x1165, 0x4e28}, // HANGUL LETTER WE
+	0x315f: {0x116e, 0x4e28},         // HANGUL LETTER WI
+	0x3160: {0x1172},                 // HANGUL LETTER YU
+	0x3161: {0x30fc},                 // HANGUL LETTER EU
+	0x3162: {0x30fc, 0x4e28},         // HANGUL LETTER YI
+	0x3163: {0x4e28},                 // HANGUL LETTER I
+	0x3164: {0x1160},                 // HANGUL FILLER
+	0x3165: {0x1102, 0x1102},         // HANGUL LETTER SSANGNIEUN
+	0x3166: {0x1102, 0x1103},         // HANGUL LETTER NIEUN-TIKEUT
+	0x3167: {0x1102, 0x1109},         // HANGUL LETTER NIEUN-SIOS
+	0x3168: {0x1102, 0x1140},         // HANGUL LETTER NIEUN-PANSIOS
+	0x3169: {0x1105, 0x1100, 0x1109}, // HANGUL LETTER RIEUL-KIYEOK-SIOS
+	0x316a: {0x1105, 0x1103},         // HANGUL LETTER RIEUL-TIKEUT
+	0x316b: {0x1105, 0x1107, 0x1109}, // HANGUL LETTER RIEUL-PIEUP-SIOS
+	0x316c: {0x1105, 0x1140},         // HANGUL LETTER RIEUL-PANSIOS
+	0x316d: {0x1105, 0x1159},         // HANGUL LETTER RIEUL-YEORINHIEUH
+	0x316e: {0x1106, 0x1107},         // HANGUL LETTER MIEUM-PIEUP
+	0x316f: {0x1106, 0x1109},         // HANGUL LETTER MIEUM-SIOS
+	0x3170: {0x1106, 0x1140},         // HANGUL LETTER MIEUM-PANSIOS
+	0x3171: {0x1106, 0x110b},         // HANGUL LETTER KAPYEOUNMIEUM
+	0x3172: {0x1107, 0x1100},         // HANGUL LETTER PIEUP-KIYEOK
+	0x3173: {0x1107, 0x1103},         // HANGUL LETTER PIEUP-TIKEUT
+	0x3174: {0x1107, 0x1109, 0x1100}, // HANGUL LETTER PIEUP-SIOS-KIYEOK
+	0x3175: {0x1107, 0x1109, 0x1103}, // HANGUL LETTER PIEUP-SIOS-TIKEUT
+	0x3176: {0x1107, 0x110c},         // HANGUL LETTER PIEUP-CIEUC
+	0x3177: {0x1107, 0x1110},         // HANGUL LETTER PIEUP-THIEUTH
+	0x3178: {0x1107, 0x110b},         // HANGUL LETTER KAPYEOUNPIEUP
+	0x3179: {0x1107, 0x1107, 0x110b}, // HANGUL LETTER KAPYEOUNSSANGPIEUP
+	0x317a: {0x1109, 0x1100},         // HANGUL LETTER SIOS-KIYEOK
+	0x317b: {0x1109, 0x1102},         // HANGUL LETTER SIOS-NIEUN
+	0x317c: {0x1109, 0x1103},         // HANGUL LETTER SIOS-TIKEUT
+	0x317d: {0x1109, 0x1107},         // HANGUL LETTER SIOS-PIEUP
+	0x317e: {0x1109, 0x110c},         // HANGUL LETTER SIOS-CIEUC
+	0x317f: {0x1140},                 // HANGUL LETTER PANSIOS
+	0x3180: {0x110b, 0x110b},         // HANGUL LETTER SSANGIEUNG
+	0x3181: {0x114c},                 // HANGUL LETTER YESIEUNG
+	0x3182: {0x110b, 0x1109},         // HANGUL LETTER YESIEUNG-SIOS
+	0x3183: {0x110b, 0x1140},         // HANGUL LETTER YESIEUNG-PANSIOS
+	0x3184: {0x1111, 0x110b},         // HANGUL LETTER KAPYEOUNPHIEUPH
+	0x3185: {0x1112, 0x1112},         // HANGUL LETTER SSANGHIEUH
+	0x3186: {0x1159},                 // HANGUL LETTER YEORINHIEUH
+	0x3187: {0x116d, 0x1163},         // HANGUL LETTER YO-YA
+	0x3188: {0x116d, 0x1163, 0x4e28}, // HANGUL LETTER YO-YAE
+	0x3189: {0x116d, 0x4e28},         // HANGUL LETTER YO-I
+	0x318a: {0x1172, 0x1167},         // HANGUL LETTER YU-YEO
+	0x318b: {0x1172, 0x1167, 0x4e28}, // HANGUL LETTER YU-YE
+	0x318c: {0x1172, 0x4e28},         // HANGUL LETTER YU-I
+	0x318d: {0x119e},                 // HANGUL LETTER ARAEA
+	0x318e: {0x119e, 0x4e28},         // HANGUL LETTER ARAEAE
+	0x31d0: {0x30fc},                 // CJK STROKE H
+	0x31d1: {0x4e28},                 // CJK STROKE S
+	0x31d3: {0x002f},                 // CJK STROKE SP
+	0x31d4: {0x005c},                 // CJK STROKE D
+	0x31d6: {0x4e5b},                 // CJK STROKE HG
+	0x31da: {0x4e85},                 // CJK STROKE SG
+	0x31db: {0x276c},                 // CJK STROKE PD
+	0x31df: {0x4e5a},                 // CJK STROKE SWG
+	0x31e0: {0x4e59},                 // CJK STROKE HXWG
+
+	0x3200: {0x0028, 0x1100, 0x0029},                                 // PARENTHESIZED HANGUL KIYEOK
+	0x3201: {0x0028, 0x1102, 0x0029},                                 // PARENTHESIZED HANGUL NIEUN
+	0x3202: {0x0028, 0x1103, 0x0029},                                 // PARENTHESIZED HANGUL TIKEUT
+	0x3203: {0x0028, 0x1105, 0x0029},                                 // PARENTHESIZED HANGUL RIEUL
+	0x3204: {0x0028, 0x1106, 0x0029},                                 // PARENTHESIZED HANGUL MIEUM
+	0x3205: {0x0028, 0x1107, 0x0029},                                 // PARENTHESIZED HANGUL PIEUP
+	0x3206: {0x0028, 0x1109, 0x0029},                                 // PARENTHESIZED HANGUL SIOS
+	0x3207: {0x0028, 0x110b, 0x0029},                                 // PARENTHESIZED HANGUL IEUNG
+	0x3208: {0x0028, 0x110c, 0x0029},                                 // PARENTHESIZED HANGUL CIEUC
+	0x3209: {0x0028, 0x110e, 0x0029},                                 // PARENTHESIZED HANGUL CHIEUCH
+	0x320a: {0x0028, 0x110f, 0x0029},                                 // PARENTHESIZED HANGUL KHIEUKH
+	0x320b: {0x0028, 0x1110, 0x0029},                                 // PARENTHESIZED HANGUL THIEUTH
+	0x320c: {0x0028, 0x1111, 0x0029},                                 // PARENTHESIZED HANGUL PHIEUPH
+	0x320d: {0x0028, 0x1112, 0x0029},                                 // PARENTHESIZED HANGUL HIEUH
+	0x320e: {0x0028, 0x1100, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL KIYEOK A
+	0x320f: {0x0028, 0x1102, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL NIEUN A
+	0x3210: {0x0028, 0x1103, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL TIKEUT A
+	0x3211: {0x0028, 0x1105, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL RIEUL A
+	0x3212: {0x0028, 0x1106, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL MIEUM A
+	0x3213: {0x0028, 0x1107, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL PIEUP A
+	0x3214: {0x0028, 0x1109, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL SIOS A
+	0x3215: {0x0028, 0x110b, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL IEUNG A
+	0x3216: {0x0028, 0x110c, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL CIEUC A
+	0x3217: {0x0028, 0x110e, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL CHIEUCH A
+	0x3218: {0x0028, 0x110f, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL KHIEUKH A
+	0x3219: {0x0028, 0x1110, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL THIEUTH A
+	0x321a: {0x0028, 0x1111, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL PHIEUPH A
+	0x321b: {0x0028, 0x1112, 0x1161, 0x0029},                         // PARENTHESIZED HANGUL HIEUH A
+	0x321c: {0x0028, 0x110c, 0x116e, 0x0029},                         // PARENTHESIZED HANGUL CIEUC U
+	0x321d: {0x0028, 0x110b, 0x1169, 0x110c, 0x1165, 0x11ab, 0x0029}, // PARENTHESIZED KOREAN CHARACTER OJEON
+	0x321e: {0x0028, 0x110b, 0x1169, 0x1112, 0x116e, 0x0029},         // PARENTHESIZED KOREAN CHARACTER O HU
+	0x3220: {0x0028, 0x30fc, 0x0029},                                 // PARENTHESIZED IDEOGRAPH ONE
+	0x3221: {0x0028, 0x4e8c, 0x0029},                                 // PARENTHESIZED IDEOGRAPH TWO
+	0x3222: {0x0028, 0x4e09, 0x0029},                                 // PARENTHESIZED IDEOGRAPH THREE
+	0x3223: {0x0028, 0x56db, 0x0029},                                 // PARENTHESIZED IDEOGRAPH FOUR
+	0x3224: {0x0028, 0x4e94, 0x0029},                                 // PARENTHESIZED IDEOGRAPH FIVE
+	0x3225: {0x0028, 0x516d, 0x0029},                                 // PARENTHESIZED IDEOGRAPH SIX
+	0x3226: {0x0028, 0x4e03, 0x0029},                                 // PARENTHESIZED IDEOGRAPH SEVEN
+	0x3227: {0x0028, 0x516b, 0x0029},                                 // PARENTHESIZED IDEOGRAPH EIGHT
+	0x3228: {0x0028, 0x4e5d, 0x0029},                                 // PARENTHESIZED IDEOGRAPH NINE
+	0x3229: {0x0028, 0x5341, 0x0029},                                 // PARENTHESIZED IDEOGRAPH TEN
+	0x322a: {0x0028, 0x6708, 0x0029},                                 // PARENTHESIZED IDEOGRAPH MOON
+	0x322b: {0x0028, 0x706b, 0x0029},                                 // PARENTHESIZED IDEOGRAPH FIRE
+	0x322c: {0x0028, 0x6c34, 0x0029},                                 // PARENTHESIZED IDEOGRAPH WATER
+	0x322d: {0x0028, 0x6728, 0x0029},                                 // PARENTHESIZED IDEOGRAPH WOOD
+	0x322e: {0x0028, 0x91d1, 0x0029},                                 // PARENTHESIZED IDEOGRAPH METAL
+	0x322f: {0x0028, 0x571f, 0x0029},                                 // PARENTHESIZED IDEOGRAPH EARTH
+	0x3230: {0x0028, 0x65e5, 0x0029},                                 // PARENTHESIZED IDEOGRAPH SUN
+	0x3231: {0x0028, 0x682a, 0x0029},                                 // PARENTHESIZED IDEOGRAPH STOCK
+	0x3232: {0x0028, 0x6709, 0x0029},                                 // PARENTHESIZED IDEOGRAPH HAVE
+	0x3233: {0x0028, 0x793e, 0x0029},                                 // PARENTHESIZED IDEOGRAPH SOCIETY
+	0x3234: {0x0028, 0x540d, 0x0029},                                 // PARENTHESIZED IDEOGRAPH NAME
+	0x3235: {0x0028, 0x7279, 0x0029},                                 // PARENTHESIZED IDEOGRAPH SPECIAL
+	0x3236: {0x0028, 0x8ca1, 0x0029},                                 // PARENTHESIZED IDEOGRAPH FINANCIAL
+	0x3237: {0x0028, 0x795d, 0x0029},                                 // PARENTHESIZED IDEOGRAPH CONGRATULATION
+	0x3238: {0x0028, 0x52b4, 0x0029},                                 // PARENTHESIZED IDEOGRAPH LABOR
+	0x3239: {0x0028, 0x4ee3, 0x0029},                                 // PARENTHESIZED IDEOGRAPH REPRESENT
+	0x323a: {0x0028, 0x547c, 0x0029},                                 // PARENTHESIZED IDEOGRAPH CALL
+	0x323b: {0x0028, 0x5b66, 0x0029},                                 // PARENTHESIZED IDEOGRAPH STUDY
+	0x323c: {0x0028, 0x76e3, 0x0029},                                 // PARENTHESIZED IDEOGRAPH SUPERVISE
+	0x323d: {0x0028, 0x4f01, 0x0029},                                 // PARENTHESIZED IDEOGRAPH ENTERPRISE
+	0x323e: {0x0028, 0x8cc7, 0x0029},                                 // PARENTHESIZED IDEOGRAPH RESOURCE
+	0x323f: {0x0028, 0x5354, 0x0029},                                 // PARENTHESIZED IDEOGRAPH ALLIANCE
+	0x3240: {0x0028, 0x796d, 0x0029},                                 // PARENTHESIZED IDEOGRAPH FESTIVAL
+	0x3241: {0x0028, 0x4f11, 0x0029},                                 // PARENTHESIZED IDEOGRAPH REST
+	0x3242: {0x0028, 0x81ea, 0x0029},                                 // PARENTHESIZED IDEOGRAPH SELF
+	0x3243: {0x0028, 0x81f3, 0x0029},                                 // PARENTHESIZED IDEOGRAPH REACH
+	0x32c0: {0x006c, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JANUARY
+	0x32c1: {0x0032, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR FEBRUARY
+	0x32c2: {0x0033, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR MARCH
+	0x32c3: {0x0034, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR APRIL
+	0x32c4: {0x0035, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR MAY
+	0x32c5: {0x0036, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JUNE
+	0x32c6: {0x0037, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR JULY
+	0x32c7: {0x0038, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR AUGUST
+	0x32c8: {0x0039, 0x6708},                                         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR SEPTEMBER
+	0x32c9: {0x006c, 0x004f, 0x6708},                                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR OCTOBER
+	0x32ca: {0x006c, 0x006c, 0x6708},                                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR NOVEMBER
+	0x32cb: {0x006c, 0x0032, 0x6708},                                 // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DECEMBER
+
+	0x3358: {0x004f, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ZERO
+	0x3359: {0x006c, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ONE
+	0x335a: {0x0032, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWO
+	0x335b: {0x0033, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR THREE
+	0x335c: {0x0034, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FOUR
+	0x335d: {0x0035, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FIVE
+	0x335e: {0x0036, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SIX
+	0x335f: {0x0037, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SEVEN
+	0x3360: {0x0038, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR EIGHT
+	0x3361: {0x0039, 0x70b9},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR NINE
+	0x3362: {0x006c, 0x004f, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TEN
+	0x3363: {0x006c, 0x006c, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ELEVEN
+	0x3364: {0x006c, 0x0032, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWELVE
+	0x3365: {0x006c, 0x0033, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR THIRTEEN
+	0x3366: {0x006c, 0x0034, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FOURTEEN
+	0x3367: {0x006c, 0x0035, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FIFTEEN
+	0x3368: {0x006c, 0x0036, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SIXTEEN
+	0x3369: {0x006c, 0x0037, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SEVENTEEN
+	0x336a: {0x006c, 0x0038, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR EIGHTEEN
+	0x336b: {0x006c, 0x0039, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR NINETEEN
+	0x336c: {0x0032, 0x004f, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY
+	0x336d: {0x0032, 0x006c, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-ONE
+	0x336e: {0x0032, 0x0032, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-TWO
+	0x336f: {0x0032, 0x0033, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-THREE
+	0x3370: {0x0032, 0x0034, 0x70b9}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-FOUR
+	0x33e0: {0x006c, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ONE
+	0x33e1: {0x0032, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWO
+	0x33e2: {0x0033, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THREE
+	0x33e3: {0x0034, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FOUR
+	0x33e4: {0x0035, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FIVE
+	0x33e5: {0x0036, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SIX
+	0x33e6: {0x0037, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SEVEN
+	0x33e7: {0x0038, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY EIGHT
+	0x33e8: {0x0039, 0x65e5},         // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY NINE
+	0x33e9: {0x006c, 0x004f, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TEN
+	0x33ea: {0x006c, 0x006c, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ELEVEN
+	0x33eb: {0x006c, 0x0032, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWELVE
+	0x33ec: {0x006c, 0x0033, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTEEN
+	0x33ed: {0x006c, 0x0034, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FOURTEEN
+	0x33ee: {0x006c, 0x0035, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FIFTEEN
+	0x33ef: {0x006c, 0x0036, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SIXTEEN
+	0x33f0: {0x006c, 0x0037, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SEVENTEEN
+	0x33f1: {0x006c, 0x0038, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY EIGHTEEN
+	0x33f2: {0x006c, 0x0039, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY NINETEEN
+	0x33f3: {0x0032, 0x004f, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY
+	0x33f4: {0x0032, 0x006c, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-ONE
+	0x33f5: {0x0032, 0x0032, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-TWO
+	0x33f6: {0x0032, 0x0033, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-THREE
+	0x33f7: {0x0032, 0x0034, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-FOUR
+	0x33f8: {0x0032, 0x0035, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-FIVE
+	0x33f9: {0x0032, 0x0036, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-SIX
+	0x33fa: {0x0032, 0x0037, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-SEVEN
+	0x33fb: {0x0032, 0x0038, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-EIGHT
+	0x33fc: {0x0032, 0x0039, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-NINE
+	0x33fd: {0x0033, 0x004f, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY
+	0x33fe: {0x0033, 0x006c, 0x65e5}, // IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY-ONE
+
+	0x39b3: {0x363d}, // CJK UNIFIED IDEOGRAPH-39B3
+
+	0x439b: {0x3588}, // CJK UNIFIED IDEOGRAPH-439B
+
+	0x4420: {0x3b3b}, // CJK UNIFIED IDEOGRAPH-4420
+
+	0x4e00: {0x30fc}, // CJK UNIFIED IDEOGRAPH-4E00
+	0x4e36: {0x005c}, // CJK UNIFIED IDEOGRAPH-4E36
+	0x4e3f: {0x002f}, // CJK UNIFIED IDEOGRAPH-4E3F
+
+	0x5002: {0x4f75}, // CJK UNIFIED IDEOGRAPH-5002
+	0x503c: {0x5024}, // CJK UNIFIED IDEOGRAPH-503C
+
+	0x555f: {0x5553}, // CJK UNIFIED IDEOGRAPH-555F
+
+	0x56d7: {0x53e3}, // CJK UNIFIED IDEOGRAPH-56D7
+
+	0x586b: {0x5861}, // CJK UNIFIED IDEOGRAPH-586B
+	0x58eb: {0x571f}, // CJK UNIFIED IDEOGRAPH-58EB
+	0x58ff: {0x58ab}, // CJK UNIFIED IDEOGRAPH-58FF
+
+	0x5b00: {0x5aaf}, // CJK UNIFIED IDEOGRAPH-5B00
+
+	0x5e32: {0x5e21}, // CJK UNIFIED IDEOGRAPH-5E32
+	0x5e50: {0x3b3a}, // CJK UNIFIED IDEOGRAPH-5E50
+
+	0x6238: {0x6236}, // CJK UNIFIED IDEOGRAPH-6238
+
+	0x6409: {0x3a41}, // CJK UNIFIED IDEOGRAPH-6409
+
+	0x6663: {0x403f}, // CJK UNIFIED IDEOGRAPH-6663
+	0x6669: {0x665a}, // CJK UNIFIED IDEOGRAPH-6669
+	0x66f6: {0x3ada}, // CJK UNIFIED IDEOGRAPH-66F6
+
+	0x6726: {0x4443}, // CJK UNIFIED IDEOGRAPH-6726
+	0x67ff: {0x676e}, // CJK UNIFIED IDEOGRAPH-67FF
+
+	0x69e9: {0x3ba3}, // CJK UNIFIED IDEOGRAPH-69E9
+
+	0x6a27: {0x699d}, // CJK UNIFIED IDEOGRAPH-6A27
+
+	0x6f59: {0x6e88}, // CJK UNIFIED IDEOGRAPH-6F59
+
+	0x784f: {0x7814}, // CJK UNIFIED IDEOGRAPH-784F
+
+	0x7d76: {0x7d55}, // CJK UNIFIED IDEOGRAPH-7D76
+
+	0x80a6: {0x670c}, // CJK UNIFIED IDEOGRAPH-80A6
+	0x80ca: {0x6710}, // CJK UNIFIED IDEOGRAPH-80CA
+	0x80d0: {0x670f}, // CJK UNIFIED IDEOGRAPH-80D0
+	0x80f6: {0x3b35}, // CJK UNIFIED IDEOGRAPH-80F6
+
+	0x8101: {0x6713}, // CJK UNIFIED IDEOGRAPH-8101
+	0x8127: {0x6718}, // CJK UNIFIED IDEOGRAPH-8127
+	0x8141: {0x80fc}, // CJK UNIFIED IDEOGRAPH-8141
+	0x81a7: {0x6723}, // CJK UNIFIED IDEOGRAPH-81A7
+
+	0x853f: {0x848d}, // CJK UNIFIED IDEOGRAPH-853F
+
+	0x8641: {0x8637}, // CJK UNIFIED IDEOGRAPH-8641
+
+	0x8a1e: {0x46b6}, // CJK UNIFIED IDEOGRAPH-8A1E
+	0x8a7d: {0x8a2e}, // CJK UNIFIED IDEOGRAPH-8A7D
+
+	0x8b8f: {0x8b86}, // CJK UNIFIED IDEOGRAPH-8B8F
+
+	0x8c63: {0x8c5c}, // CJK UNIFIED IDEOGRAPH-8C63
+
+	0x8d86: {0x8d7f}, // CJK UNIFIED IDEOGRAPH-8D86
+	0x8dfa: {0x8de5}, // CJK UNIFIED IDEOGRAPH-8DFA
+
+	0x8e9b: {0x8e97}, // CJK UNIFIED IDEOGRAPH-8E9B
+
+	0x8f27: {0x8eff}, // CJK UNIFIED IDEOGRAPH-8F27
+
+	0x90de: {0x90ce}, // CJK UNIFIED IDEOGRAPH-90DE
+
+	0x93ae: {0x93ad}, // CJK UNIFIED IDEOGRAPH-93AE
+
+	0x96b8: {0x96b7}, // CJK UNIFIED IDEOGRAPH-96B8
+
+	0x9e43: {0x9e42}, // CJK UNIFIED IDEOGRAPH-9E43
+	0x9ed2: {0x9ed1}, // CJK UNIFIED IDEOGRAPH-9ED2
+
+	0x9fc3: {0x4039}, // CJK UNIFIED IDEOGRAPH-9FC3
+
+	0xa494: {0xa2cd},         // YI RADICAL CYP
+	0xa49c: {0xa0c0},         // YI RADICAL MOP
+	0xa49e: {0xa04a},         // YI RADICAL PUT
+	0xa4a7: {0xa458},         // YI RADICAL NYOP
+	0xa4a8: {0xa132},         // YI RADICAL TU
+	0xa4ac: {0xa050},         // YI RADICAL PYT
+	0xa4b0: {0xa3c2},         // YI RADICAL SHY
+	0xa4ba: {0xa3bf},         // YI RADICAL SHUR
+	0xa4be: {0xa2b1},         // YI RADICAL CIP
+	0xa4bf: {0xa259},         // YI RADICAL HXOP
+	0xa4c0: {0xa3ab},         // YI RADICAL SHAT
+	0xa4c2: {0xa3b5},         // YI RADICAL SHOP
+	0xa4d0: {0x0042},         // LISU LETTER BA
+	0xa4d1: {0x0050},         // LISU LETTER PA
+	0xa4d2: {0x0064},         // LISU LETTER PHA
+	0xa4d3: {0x0044},         // LISU LETTER DA
+	0xa4d4: {0x0054},         // LISU LETTER TA
+	0xa4d6: {0x0047},         // LISU LETTER GA
+	0xa4d7: {0x004b},         // LISU LETTER KA
+	0xa4d9: {0x004a},         // LISU LETTER JA
+	0xa4da: {0x0043},         // LISU LETTER CA
+	0xa4db: {0x0186},         // LISU LETTER CHA
+	0xa4dc: {0x005a},         // LISU LETTER DZA
+	0xa4dd: {0x0046},         // LISU LETTER TSA
+	0xa4de: {0x2132},         // LISU LETTER TSHA
+	0xa4df: {0x004d},         // LISU LETTER MA
+	0xa4e0: {0x004e},         // LISU LETTER NA
+	0xa4e1: {0x004c},         // LISU LETTER LA
+	0xa4e2: {0x0053},         // LISU LETTER SA
+	0xa4e3: {0x0052},         // LISU LETTER ZHA
+	0xa4e5: {0x0245},         // LISU LETTER NGA
+	0xa4e6: {0x0056},         // LISU LETTER HA
+	0xa4e7: {0x0048},         // LISU LETTER XA
+	0xa4ea: {0x0057},         // LISU LETTER WA
+	0xa4eb: {0x0058},         // LISU LETTER SHA
+	0xa4ec: {0x0059},         // LISU LETTER YA
+	0xa4ed: {0x1660},         // LISU LETTER GHA
+	0xa4ee: {0x0041},         // LISU LETTER A
+	0xa4ef: {0x2c6f},         // LISU LETTER AE
+	0xa4f0: {0x0045},         // LISU LETTER E
+	0xa4f1: {0x018e},         // LISU LETTER EU
+	0xa4f2: {0x006c},         // LISU LETTER I
+	0xa4f3: {0x004f},         // LISU LETTER O
+	0xa4f4: {0x0055},         // LISU LETTER U
+	0xa4f5: {0x0548},         // LISU LETTER UE
+	0xa4f7: {0x15e1},         // LISU LETTER OE
+	0xa4f8: {0x002e},         // LISU LETTER TONE MYA TI
+	0xa4f9: {0x002c},         // LISU LETTER TONE NA PO
+	0xa4fa: {0x002e, 0x002e}, // LISU LETTER TONE MYA CYA
+	0xa4fb: {0x002e, 0x002c}, // LISU LETTER TONE MYA BO
+	0xa4fd: {0x003a},         // LISU LETTER TONE MYA JEU
+	0xa4fe: {0x002d, 0x002e}, // LISU PUNCTUATION COMMA
+	0xa4ff: {0x003d},         // LISU PUNCTUATION FULL STOP
+
+	0xa60e: {0x002e},                 // VAI FULL STOP
+	0xa644: {0x0032},                 // CYRILLIC CAPITAL LETTER REVERSED DZE
+	0xa645: {0x01a8},                 // CYRILLIC SMALL LETTER REVERSED DZE
+	0xa647: {0x0069},                 // CYRILLIC SMALL LETTER IOTA
+	0xa64d: {0x03c9},                 // CYRILLIC SMALL LETTER BROAD OMEGA
+	0xa650: {0x042a, 0x006c},         // CYRILLIC CAPITAL LETTER YERU WITH BACK YER
+	0xa651: {0x02c9, 0x0062, 0x0069}, // CYRILLIC SMALL LETTER YERU WITH BACK YER
+	0xa668: {0x0298},                 // CYRILLIC CAPITAL LETTER MONOCULAR O
+	0xa66f: {0x20e9},                 // COMBINING CYRILLIC VZMET
+	0xa67c: {0x0306},                 // COMBINING CYRILLIC KAVYKA
+	0xa67e: {0x02c7},                 // CYRILLIC KAVYKA
+	0xa695: {0x0068, 0x0314},         // CYRILLIC SMALL LETTER HWE
+	0xa698: {0x004f, 0x004f},         // CYRILLIC CAPITAL LETTER DOUBLE O
+	0xa699: {0x006f, 0x006f},         // CYRILLIC SMALL LETTER DOUBLE O
+	0xa69a: {0x102a8},                // CYRILLIC CAPITAL LETTER CROSSED O
+	0xa6a1: {0x0418},                 // BAMUM LETTER KA
+	0xa6b0: {0x16b9},                 // BAMUM LETTER TAA
+	0xa6b1: {0x2c75},                 // BAMUM LETTER NDAA
+	0xa6cd: {0x02a1},                 // BAMUM LETTER LU
+	0xa6ce: {0x0245},                 // BAMUM LETTER MI
+	0xa6db: {0x03a0},                 // BAMUM LETTER NA
+	0xa6df: {0x0056},                 // BAMUM LETTER KO
+	0xa6eb: {0x003f},                 // BAMUM LETTER NTUU
+	0xa6ef: {0x0032},                 // BAMUM LETTER KOGHOM
+	0xa6f0: {0x0302},                 // BAMUM COMBINING MARK KOQNDON
+	0xa6f1: {0x0304},                 // BAMUM COMBINING MARK TUKWENTIS
+	0xa6f4: {0xa6f3, 0xa6f3},         // BAMUM COLON
+
+	0xa714: {0x02eb},         // MODIFIER LETTER MID LEFT-STEM TONE BAR
+	0xa716: {0x02ea},         // MODIFIER LETTER EXTRA-LOW LEFT-STEM TONE BAR
+	0xa728: {0x0054, 0x0033}, // LATIN CAPITAL LETTER TZ
+	0xa729: {0x0074, 0x021d}, // LATIN SMALL LETTER TZ
+	0xa731: {0x0073},         // LATIN LETTER SMALL CAPITAL S
+	0xa732: {0x0041, 0x0041}, // LATIN CAPITAL LETTER AA
+	0xa733: {0x0061, 0x0061}, // LATIN SMALL LETTER AA
+	0xa734: {0x0041, 0x004f}, // LATIN CAPITAL LETTER AO
+	0xa735: {0x0061, 0x006f}, // LATIN SMALL LETTER AO
+	0xa736: {0x0041, 0x0055}, // LATIN CAPITAL LETTER AU
+	0xa737: {0x0061, 0x0075}, // LATIN SMALL LETTER AU
+	0xa738: {0x0041, 0x0056}, // LATIN CAPITAL LETTER AV
+	0xa739: {0x0061, 0x0076}, // LATIN SMALL LETTER AV
+	0xa73a: {0x0041, 0x0056}, // LATIN CAPITAL LETTER AV WITH HORIZONTAL BAR
+	0xa73b: {0x0061, 0x0076}, // LATIN SMALL LETTER AV WITH HORIZONTAL BAR
+	0xa73c: {0x0041, 0x0059}, // LATIN CAPITAL LETTER AY
+	0xa73d: {0x0061, 0x0079}, // LATIN SMALL LETTER AY
+	0xa740: {0x004b, 0x0335}, // LATIN CAPITAL LETTER K WITH STROKE
+	0xa74a: {0x004f, 0x0335}, // LATIN CAPITAL LETTER O WITH LONG STROKE OVERLAY
+	0xa74b: {0x006f, 0x0335}, // LATIN SMALL LETTER O WITH LONG STROKE OVERLAY
+	0xa74e: {0x004f, 0x004f}, // LATIN CAPITAL LETTER OO
+	0xa74f: {0x006f, 0x006f}, // LATIN SMALL LETTER OO
+	0xa75a: {0x0032},         // LATIN CAPITAL LETTER R ROTUNDA
+	0xa761: {0x0077, 0x0326}, // LATIN SMALL LETTER VY
+	0xa76a: {0x0033},         // LATIN CAPITAL LETTER ET
+	0xa76b: {0x021d},         // LATIN SMALL LETTER ET
+	0xa76e: {0x0039},         // LATIN CAPITAL LETTER CON
+	0xa777: {0x0074, 0x0066}, // LATIN SMALL LETTER TUM
+	0xa778: {0x0026},         // LATIN SMALL LETTER UM
+	0xa77a: {0xa779},         // LATIN SMALL LETTER INSULAR D
+	0xa789: {0x003a},         // MODIFIER LETTER COLON
+	0xa78c: {0x0027},         // LATIN SMALL LETTER SALTILLO
+	0xa78f: {0x00b7},         // LATIN LETTER SINOLOGICAL DOT
+	0xa795: {0xa727},         // LATIN SMALL LETTER H WITH PALATAL HOOK
+	0xa798: {0x0046},         // LATIN CAPITAL LETTER F WITH STROKE
+	0xa799: {0x0066},         // LATIN SMALL LETTER F WITH STROKE
+	0xa79a: {0x10412},        // LATIN CAPITAL LETTER VOLAPUK AE
+	0xa79b: {0x1043a},        // LATIN SMALL LETTER VOLAPUK AE
+	0xa79d: {0x029a},         // LATIN SMALL LETTER VOLAPUK OE
+	0xa79e: {0xa4e4},         // LATIN CAPITAL LETTER VOLAPUK UE
+	0xa79f: {0x0075},         // LATIN SMALL LETTER VOLAPUK UE
+	0xa7ab: {0x0033},         // LATIN CAPITAL LETTER REVERSED OPEN E
+	0xa7b1: {0xa4d5},         // LATIN CAPITAL LETTER TURNED T
+	0xa7b2: {0x004a},         // LATIN CAPITAL LETTER J WITH CROSSED-TAIL
+	0xa7b3: {0x0058},         // LATIN CAPITAL LETTER CHI
+	0xa7b4: {0x0042},         // LATIN CAPITAL LETTER BETA
+	0xa7b5: {0x00df},         // LATIN SMALL LETTER BETA
+	0xa7b6: {0xa64c},         // LATIN CAPITAL LETTER OMEGA
+	0xa7b7: {0x03c9},         // LATIN SMALL LETTER OMEGA
+	0xa7f7: {0x30fc},         // LATIN EPIGRAPHIC LETTER SIDEWAYS I
+
+	0xa830: {0x0964}, // NORTH INDIC FRACTION ONE QUARTER
+
+	0xa960: {0x1103, 0x1106},         // HANGUL CHOSEONG TIKEUT-MIEUM
+	0xa961: {0x1103, 0x1107},         // HANGUL CHOSEONG TIKEUT-PIEUP
+	0xa962: {0x1103, 0x1109},         // HANGUL CHOSEONG TIKEUT-SIOS
+	0xa963: {0x1103, 0x110c},         // HANGUL CHOSEONG TIKEUT-CIEUC
+	0xa964: {0x1105, 0x1100},         // HANGUL CHOSEONG RIEUL-KIYEOK
+	0xa965: {0x1105, 0x1100, 0x1100}, // HANGUL CHOSEONG RIEUL-SSANGKIYEOK
+	0xa966: {0x1105, 0x1103},         // HANGUL CHOSEONG RIEUL-TIKEUT
+	0xa967: {0x1105, 0x1103, 0x1103}, // HANGUL CHOSEONG RIEUL-SSANGTIKEUT
+	0xa968: {0x1105, 0x1106},         // HANGUL CHOSEONG RIEUL-MIEUM
+	0xa969: {0x1105, 0x1107},         // HANGUL CHOSEONG RIEUL-PIEUP
+	0xa96a: {0x1105, 0x1107, 0x1107}, // HANGUL CHOSEONG RIEUL-SSANGPIEUP
+	0xa96b: {0x1105, 0x1107, 0x110b}, // HANGUL CHOSEONG RIEUL-KAPYEOUNPIEUP
+	0xa96c: {0x1105, 0x1109},         // HANGUL CHOSEONG RIEUL-SIOS
+	0xa96d: {0x1105, 0x110c},         // HANGUL CHOSEONG RIEUL-CIEUC
+	0xa96e: {0x1105, 0x110f},         // HANGUL CHOSEONG RIEUL-KHIEUKH
+	0xa96f: {0x1106, 0x1100},         // HANGUL CHOSEONG MIEUM-KIYEOK
+	0xa970: {0x1106, 0x1103},         // HANGUL CHOSEONG MIEUM-TIKEUT
+	0xa971: {0x1106, 0x1109},         // HANGUL CHOSEONG MIEUM-SIOS
+	0xa972: {0x1107, 0x1109, 0x1110}, // HANGUL CHOSEONG PIEUP-SIOS-THIEUTH
+	0xa973: {0x1107, 0x110f},         // HANGUL CHOSEONG PIEUP-KHIEUKH
+	0xa974: {0x1107, 0x1112},         // HANGUL CHOSEONG PIEUP-HIEUH
+	0xa975: {0x1109, 0x1109, 0x1107}, // HANGUL CHOSEONG SSANGSIOS-PIEUP
+	0xa976: {0x110b, 0x1105},         // HANGUL CHOSEONG IEUNG-RIEUL
+	0xa977: {0x110b, 0x1112},         // HANGUL CHOSEONG IEUNG-HIEUH
+	0xa978: {0x110c, 0x110c, 0x1112}, // HANGUL CHOSEONG SSANGCIEUC-HIEUH
+	0xa979: {0x1110, 0x1110},         // HANGUL CHOSEONG SSANGTHIEUTH
+	0xa97a: {0x1111, 0x1112},         // HANGUL CHOSEONG PHIEUPH-HIEUH
+	0xa97b: {0x1112, 0x1109},         // HANGUL CHOSEONG HIEUH-SIOS
+	0xa97c: {0x1159, 0x1159},         // HANGUL CHOSEONG SSANGYEORINHIEUH
+	0xa992: {0x2c3f},                 // JAVANESE LETTER GA
+	0xa9a3: {0xa99d},                 // JAVANESE LETTER DA MAHAPRANA
+	0xa9c6: {0xa9d0},                 // JAVANESE PADA WINDU
+	0xa9cf: {0x0662},                 // JAVANESE PANGRANGKEP
+
+	0xaa53: {0xaa01}, // CHAM DIGIT THREE
+	0xaa56: {0xaa23}, // CHAM DIGIT SIX
+
+	0xab32: {0x0065},                 // LATIN SMALL LETTER BLACKLETTER E
+	0xab35: {0x0066},                 // LATIN SMALL LETTER LENIS F
+	0xab3d: {0x006f},                 // LATIN SMALL LETTER BLACKLETTER O
+	0xab3e: {0x006f, 0x0338},         // LATIN SMALL LETTER BLACKLETTER O WITH STROKE
+	0xab3f: {0x0254, 0x0338},         // LATIN SMALL LETTER OPEN O WITH STROKE
+	0xab41: {0x01dd, 0x006f, 0x0338}, // LATIN SMALL LETTER TURNED OE WITH STROKE
+	0xab42: {0x01dd, 0x006f, 0x0335}, // LATIN SMALL LETTER TURNED OE WITH HORIZONTAL STROKE
+	0xab47: {0x0072},                 // LATIN SMALL LETTER R WITHOUT HANDLE
+	0xab48: {0x0072},                 // LATIN SMALL LETTER DOUBLE R
+	0xab4d: {0x0283},                 // LATIN SMALL LETTER BASELINE ESH
+	0xab4e: {0x0075},                 // LATIN SMALL LETTER U WITH SHORT RIGHT LEG
+	0xab52: {0x0075},                 // LATIN SMALL LETTER U WITH LEFT HOOK
+	0xab53: {0x03c7},                 // LATIN SMALL LETTER CHI
+	0xab55: {0x03c7},                 // LATIN SMALL LETTER CHI WITH LOW LEFT SERIF
+	0xab5a: {0x0079},                 // LATIN SMALL LETTER Y WITH SHORT RIGHT LEG
+	0xab60: {0x0459},                 // LATIN SMALL LETTER SAKHA YAT
+	0xab62: {0x0254, 0x0065},         // LATIN SMALL LETTER OPEN OE
+	0xab63: {0x0075, 0x006f},         // LATIN SMALL LETTER UO
+	0xab70: {0x1d05},                 // CHEROKEE SMALL LETTER A
+	0xab71: {0x0280},                 // CHEROKEE SMALL LETTER E
+	0xab72: {0x1d1b},                 // CHEROKEE SMALL LETTER I
+	0xab74: {0x006f, 0x031b},         // CHEROKEE SMALL LETTER U
+	0xab75: {0x0069},                 // CHEROKEE SMALL LETTER V
+	0xab7a: {0x1d00},                 // CHEROKEE SMALL LETTER GO
+	0xab7b: {0x1d0a},                 // CHEROKEE SMALL LETTER GU
+	0xab7c: {0x1d07},                 // CHEROKEE SMALL LETTER GV
+	0xab7e: {0x0242},                 // CHEROKEE SMALL LETTER HE
+	0xab80: {0x2c76},                 // CHEROKEE SMALL LETTER HO
+	0xab81: {0x0072},                 // CHEROKEE SMALL LETTER HU
+	0xab83: {0x0077},                 // CHEROKEE SMALL LETTER LA
+	0xab87: {0x028d},                 // CHEROKEE SMALL LETTER LU
+	0xab8b: {0x029c},                 // CHEROKEE SMALL LETTER MI
+	0xab8e: {0x006f, 0x0335},         // CHEROKEE SMALL LETTER NA
+	0xab90: {0x0262},                 // CHEROKEE SMALL LETTER NAH
+	0xab93: {0x007a},                 // CHEROKEE SMALL LETTER NO
+	0xab9b: {0xa793},                 // CHEROKEE SMALL LETTER QUV
+	0xab9c: {0x0075, 0x0335},         // CHEROKEE SMALL LETTER SA
+	0xab9f: {0x0185},                 // CHEROKEE SMALL LETTER SI
+	0xaba2: {0x0280},                 // CHEROKEE SMALL LETTER SV
+	0xaba9: {0x0076},                 // CHEROKEE SMALL LETTER DO
+	0xabaa: {0x0073},                 // CHEROKEE SMALL LETTER DU
+	0xabae: {0x029f},                 // CHEROKEE SMALL LETTER TLE
+	0xabaf: {0x0063},                 // CHEROKEE SMALL LETTER TLI
+	0xabb2: {0x1d18},                 // CHEROKEE SMALL LETTER TLV
+	0xabb6: {0x0138},                 // CHEROKEE SMALL LETTER TSO
+	0xabbb: {0x006f, 0x0335},         // CHEROKEE SMALL LETTER WI
+
+	0xd7b0: {0x1169, 0x1167},         // HANGUL JUNGSEONG O-YEO
+	0xd7b1: {0x1169, 0x1169, 0x4e28}, // HANGUL JUNGSEONG O-O-I
+	0xd7b2: {0x116d, 0x1161},         // HANGUL JUNGSEONG YO-A
+	0xd7b3: {0x116d, 0x1161, 0x4e28}, // HANGUL JUNGSEONG YO-AE
+	0xd7b4: {0x116d, 0x1165},         // HANGUL JUNGSEONG YO-EO
+	0xd7b5: {0x116e, 0x1167},         // HANGUL JUNGSEONG U-YEO
+	0xd7b6: {0x116e, 0x4e28, 0x4e28}, // HANGUL JUNGSEONG U-I-I
+	0xd7b7: {0x1172, 0x1161, 0x4e28}, // HANGUL JUNGSEONG YU-AE
+	0xd7b8: {0x1172, 0x1169},         // HANGUL JUNGSEONG YU-O
+	0xd7b9: {0x30fc, 0x1161},         // HANGUL JUNGSEONG EU-A
+	0xd7ba: {0x30fc, 0x1165},         // HANGUL JUNGSEONG EU-EO
+	0xd7bb: {0x30fc, 0x1165, 0x4e28}, // HANGUL JUNGSEONG EU-E
+	0xd7bc: {0x30fc, 0x1169},         // HANGUL JUNGSEONG EU-O
+	0xd7bd: {0x4e28, 0x1163, 0x1169}, // HANGUL JUNGSEONG I-YA-O
+	0xd7be: {0x4e28, 0x1163, 0x4e28}, // HANGUL JUNGSEONG I-YAE
+	0xd7bf: {0x4e28, 0x1167},         // HANGUL JUNGSEONG I-YEO
+	0xd7c0: {0x4e28, 0x1167, 0x4e28}, // HANGUL JUNGSEONG I-YE
+	0xd7c1: {0x4e28, 0x1169, 0x4e28}, // HANGUL JUNGSEONG I-O-I
+	0xd7c2: {0x4e28, 0x116d},         // HANGUL JUNGSEONG I-YO
+	0xd7c3: {0x4e28, 0x1172},         // HANGUL JUNGSEONG I-YU
+	0xd7c4: {0x4e28, 0x4e28},         // HANGUL JUNGSEONG I-I
+	0xd7c5: {0x119e, 0x1161},         // HANGUL JUNGSEONG ARAEA-A
+	0xd7c6: {0x119e, 0x1165, 0x4e28}, // HANGUL JUNGSEONG ARAEA-E
+	0xd7cb: {0x1102, 0x1105},         // HANGUL JONGSEONG NIEUN-RIEUL
+	0xd7cc: {0x1102, 0x110e},         // HANGUL JONGSEONG NIEUN-CHIEUCH
+	0xd7cd: {0x1103, 0x1103},         // HANGUL JONGSEONG SSANGTIKEUT
+	0xd7ce: {0x1103, 0x1103, 0x1107}, // HANGUL JONGSEONG SSANGTIKEUT-PIEUP
+	0xd7cf: {0x1103, 0x1107},         // HANGUL JONGSEONG TIKEUT-PIEUP
+	0xd7d0: {0x1103, 0x1109},         // HANGUL JONGSEONG TIKEUT-SIOS
+	0xd7d1: {0x1103, 0x1109, 0x1100}, // HANGUL JONGSEONG TIKEUT-SIOS-KIYEOK
+	0xd7d2: {0x1103, 0x110c},         // HANGUL JONGSEONG TIKEUT-CIEUC
+	0xd7d3: {0x1103, 0x110e},         // HANGUL JONGSEONG TIKEUT-CHIEUCH
+	0xd7d4: {0x1103, 0x1110},         // HANGUL JONGSEONG TIKEUT-THIEUTH
+	0xd7d5: {0x1105, 0x1100, 0x1100}, // HANGUL JONGSEONG RIEUL-SSANGKIYEOK
+	0xd7d6: {0x1105, 0x1100, 0x1112}, // HANGUL JONGSEONG RIEUL-KIYEOK-HIEUH
+	0xd7d7: {0x1105, 0x1105, 0x110f}, // HANGUL JONGSEONG SSANGRIEUL-KHIEUKH
+	0xd7d8: {0x1105, 0x1106, 0x1112}, // HANGUL JONGSEONG RIEUL-MIEUM-HIEUH
+	0xd7d9: {0x1105, 0x1107, 0x1103}, // HANGUL JONGSEONG RIEUL-PIEUP-TIKEUT
+	0xd7da: {0x1105, 0x1107, 0x1111}, // HANGUL JONGSEONG RIEUL-PIEUP-PHIEUPH
+	0xd7db: {0x1105, 0x114c},         // HANGUL JONGSEONG RIEUL-YESIEUNG
+	0xd7dc: {0x1105, 0x1159, 0x1112}, // HANGUL JONGSEONG RIEUL-YEORINHIEUH-HIEUH
+	0xd7dd: {0x1105, 0x110b},         // HANGUL JONGSEONG KAPYEOUNRIEUL
+	0xd7de: {0x1106, 0x1102},         // HANGUL JONGSEONG MIEUM-NIEUN
+	0xd7df: {0x1106, 0x1102, 0x1102}, // HANGUL JONGSEONG MIEUM-SSANGNIEUN
+	0xd7e0: {0x1106, 0x1106},         // HANGUL JONGSEONG SSANGMIEUM
+	0xd7e1: {0x1106, 0x1107, 0x1109}, // HANGUL JONGSEONG MIEUM-PIEUP-SIOS
+	0xd7e2: {0x1106, 0x110c},         // HANGUL JONGSEONG MIEUM-CIEUC
+	0xd7e3: {0x1107, 0x1103},         // HANGUL JONGSEONG PIEUP-TIKEUT
+	0xd7e4: {0x1107, 0x1105, 0x1111}, // HANGUL JONGSEONG PIEUP-RIEUL-PHIEUPH
+	0xd7e5: {0x1107, 0x1106},         // HANGUL JONGSEONG PIEUP-MIEUM
+	0xd7e6: {0x1107, 0x1107},         // HANGUL JONGSEONG SSANGPIEUP
+	0xd7e7: {0x1107, 0x1109, 0x1103}, // HANGUL JONGSEONG PIEUP-SIOS-TIKEUT
+	0xd7e8: {0x1107, 0x110c},         // HANGUL JONGSEONG PIEUP-CIEUC
+	0xd7e9: {0x1107, 0x110e},         // HANGUL JONGSEONG PIEUP-CHIEUCH
+	0xd7ea: {0x1109, 0x1106},         // HANGUL JONGSEONG SIOS-MIEUM
+	0xd7eb: {0x1109, 0x1107, 0x110b}, // HANGUL JONGSEONG SIOS-KAPYEOUNPIEUP
+	0xd7ec: {0x1109, 0x1109, 0x1100}, // HANGUL JONGSEONG SSANGSIOS-KIYEOK
+	0xd7ed: {0x1109, 0x1109, 0x1103}, // HANGUL JONGSEONG SSANGSIOS-TIKEUT
+	0xd7ee: {0x1109, 0x1140},         // HANGUL JONGSEONG SIOS-PANSIOS
+	0xd7ef: {0x1109, 0x110c},         // HANGUL JONGSEONG SIOS-CIEUC
+	0xd7f0: {0x1109, 0x110e},         // HANGUL JONGSEONG SIOS-CHIEUCH
+	0xd7f1: {0x1109, 0x1110},         // HANGUL JONGSEONG SIOS-THIEUTH
+	0xd7f2: {0x1105, 0x1112},         // HANGUL JONGSEONG SIOS-HIEUH
+	0xd7f3: {0x1140, 0x1107},         // HANGUL JONGSEONG PANSIOS-PIEUP
+	0xd7f4: {0x1140, 0x1107, 0x110b}, // HANGUL JONGSEONG PANSIOS-KAPYEOUNPIEUP
+	0xd7f5: {0x114c, 0x1106},         // HANGUL JONGSEONG YESIEUNG-MIEUM
+	0xd7f6: {0x114c, 0x1112},         // HANGUL JONGSEONG YESIEUNG-HIEUH
+	0xd7f7: {0x110c, 0x1107},         // HANGUL JONGSEONG CIEUC-PIEUP
+	0xd7f8: {0x110c, 0x1107, 0x1107}, // HANGUL JONGSEONG CIEUC-SSANGPIEUP
+	0xd7f9: {0x110c, 0x110c},         // HANGUL JONGSEONG SSANGCIEUC
+	0xd7fa: {0x1111, 0x1109},         // HANGUL JONGSEONG PHIEUPH-SIOS
+	0xd7fb: {0x1111, 0x1110},         // HANGUL JONGSEONG PHIEUPH-THIEUTH
+
+	0xfb00: {0x0066, 0x0066},                 // LATIN SMALL LIGATURE FF
+	0xfb01: {0x0066, 0x0069},                 // LATIN SMALL LIGATURE FI
+	0xfb02: {0x0066, 0x006c},                 // LATIN SMALL LIGATURE FL
+	0xfb03: {0x0066, 0x0066, 0x0069},         // LATIN SMALL LIGATURE FFI
+	0xfb04: {0x0066, 0x0066, 0x006c},         // LATIN SMALL LIGATURE FFL
+	0xfb06: {0x0073, 0x0074},                 // LATIN SMALL LIGATURE ST
+	0xfb13: {0x0574, 0x0576},                 // ARMENIAN SMALL LIGATURE MEN NOW
+	0xfb14: {0x0574, 0x0565},                 // ARMENIAN SMALL LIGATURE MEN ECH
+	0xfb15: {0x0574, 0x056b},                 // ARMENIAN SMALL LIGATURE MEN INI
+	0xfb16: {0x057e, 0x0576},                 // ARMENIAN SMALL LIGATURE VEW NOW
+	0xfb17: {0x0574, 0x056d},                 // ARMENIAN SMALL LIGATURE MEN XEH
+	0xfb20: {0x05e2},                         // HEBREW LETTER ALTERNATIVE AYIN
+	0xfb21: {0x05d0},                         // HEBREW LETTER WIDE ALEF
+	0xfb22: {0x05d3},                         // HEBREW LETTER WIDE DALET
+	0xfb23: {0x05d4},                         // HEBREW LETTER WIDE HE
+	0xfb24: {0x05db},                         // HEBREW LETTER WIDE KAF
+	0xfb25: {0x05dc},                         // HEBREW LETTER WIDE LAMED
+	0xfb26: {0x05dd},                         // HEBREW LETTER WIDE FINAL MEM
+	0xfb27: {0x05e8},                         // HEBREW LETTER WIDE RESH
+	0xfb28: {0x05ea},                         // HEBREW LETTER WIDE TAV
+	0xfb29: {0x002d, 0x0307},                 // HEBREW LETTER ALTERNATIVE PLUS SIGN
+	0xfb4f: {0x05d0, 0x05dc},                 // HEBREW LIGATURE ALEF LAMED
+	0xfb50: {0x0671},                         // ARABIC LETTER ALEF WASLA ISOLATED FORM
+	0xfb51: {0x0671},                         // ARABIC LETTER ALEF WASLA FINAL FORM
+	0xfb52: {0x067b},                         // ARABIC LETTER BEEH ISOLATED FORM
+	0xfb53: {0x067b},                         // ARABIC LETTER BEEH FINAL FORM
+	0xfb54: {0x067b},                         // ARABIC LETTER BEEH INITIAL FORM
+	0xfb55: {0x067b},                         // ARABIC LETTER BEEH MEDIAL FORM
+	0xfb56: {0x0649, 0x06db},                 // ARABIC LETTER PEH ISOLATED FORM
+	0xfb57: {0x0649, 0x06db},                 // ARABIC LETTER PEH FINAL FORM
+	0xfb58: {0x0649, 0x06db},                 // ARABIC LETTER PEH INITIAL FORM
+	0xfb59: {0x0649, 0x06db},                 // ARABIC LETTER PEH MEDIAL FORM
+	0xfb5a: {0x0680},                         // ARABIC LETTER BEHEH ISOLATED FORM
+	0xfb5b: {0x0680},                         // ARABIC LETTER BEHEH FINAL FORM
+	0xfb5c: {0x0680},                         // ARABIC LETTER BEHEH INITIAL FORM
+	0xfb5d: {0x0680},                         // ARABIC LETTER BEHEH MEDIAL FORM
+	0xfb5e: {0x067a},                         // ARABIC LETTER TTEHEH ISOLATED FORM
+	0xfb5f: {0x067a},                         // ARABIC LETTER TTEHEH FINAL FORM
+	0xfb60: {0x067a},                         // ARABIC LETTER TTEHEH INITIAL FORM
+	0xfb61: {0x067a},                         // ARABIC LETTER TTEHEH MEDIAL FORM
+	0xfb62: {0x067f},                         // ARABIC LETTER TEHEH ISOLATED FORM
+	0xfb63: {0x067f},                         // ARABIC LETTER TEHEH FINAL FORM
+	0xfb64: {0x067f},                         // ARABIC LETTER TEHEH INITIAL FORM
+	0xfb65: {0x067f},                         // ARABIC LETTER TEHEH MEDIAL FORM
+	0xfb66: {0x0649, 0x0615},                 // ARABIC LETTER TTEH ISOLATED FORM
+	0xfb67: {0x0649, 0x0615},                 // ARABIC LETTER TTEH FINAL FORM
+	0xfb68: {0x0649, 0x0615},                 // ARABIC LETTER TTEH INITIAL FORM
+	0xfb69: {0x0649, 0x0615},                 // ARABIC LETTER TTEH MEDIAL FORM
+	0xfb6a: {0x06a1, 0x06db},                 // ARABIC LETTER VEH ISOLATED FORM
+	0xfb6b: {0x06a1, 0x06db},                 // ARABIC LETTER VEH FINAL FORM
+	0xfb6c: {0x06a1, 0x06db},                 // ARABIC LETTER VEH INITIAL FORM
+	0xfb6d: {0x06a1, 0x06db},                 // ARABIC LETTER VEH MEDIAL FORM
+	0xfb6e: {0x06a6},                         // ARABIC LETTER PEHEH ISOLATED FORM
+	0xfb6f: {0x06a6},                         // ARABIC LETTER PEHEH FINAL FORM
+	0xfb70: {0x06a6},                         // ARABIC LETTER PEHEH INITIAL FORM
+	0xfb71: {0x06a6},                         // ARABIC LETTER PEHEH MEDIAL FORM
+	0xfb72: {0x0684},                         // ARABIC LETTER DYEH ISOLATED FORM
+	0xfb73: {0x0684},                         // ARABIC LETTER DYEH FINAL FORM
+	0xfb74: {0x0684},                         // ARABIC LETTER DYEH INITIAL FORM
+	0xfb75: {0x0684},                         // ARABIC LETTER DYEH MEDIAL FORM
+	0xfb76: {0x0683},                         // ARABIC LETTER NYEH ISOLATED FORM
+	0xfb77: {0x0683},                         // ARABIC LETTER NYEH FINAL FORM
+	0xfb78: {0x0683},                         // ARABIC LETTER NYEH INITIAL FORM
+	0xfb79: {0x0683},                         // ARABIC LETTER NYEH MEDIAL FORM
+	0xfb7a: {0x0686},                         // ARABIC LETTER TCHEH ISOLATED FORM
+	0xfb7b: {0x0686},                         // ARABIC LETTER TCHEH FINAL FORM
+	0xfb7c: {0x0686},                         // ARABIC LETTER TCHEH INITIAL FORM
+	0xfb7d: {0x0686},                         // ARABIC LETTER TCHEH MEDIAL FORM
+	0xfb7e: {0x0687},                         // ARABIC LETTER TCHEHEH ISOLATED FORM
+	0xfb7f: {0x0687},                         // ARABIC LETTER TCHEHEH FINAL FORM
+	0xfb80: {0x0687},                         // ARABIC LETTER TCHEHEH INITIAL FORM
+	0xfb81: {0x0687},                         // ARABIC LETTER TCHEHEH MEDIAL FORM
+	0xfb82: {0x068d},                         // ARABIC LETTER DDAHAL ISOLATED FORM
+	0xfb83: {0x068d},                         // ARABIC LETTER DDAHAL FINAL FORM
+	0xfb84: {0x068c},                         // ARABIC LETTER DAHAL ISOLATED FORM
+	0xfb85: {0x068c},                         // ARABIC LETTER DAHAL FINAL FORM
+	0xfb86: {0x062f, 0x06db},                 // ARABIC LETTER DUL ISOLATED FORM
+	0xfb87: {0x062f, 0x06db},                 // ARABIC LETTER DUL FINAL FORM
+	0xfb88: {0x062f, 0x0615},                 // ARABIC LETTER DDAL ISOLATED FORM
+	0xfb89: {0x062f, 0x0615},                 // ARABIC LETTER DDAL FINAL FORM
+	0xfb8a: {0x0631, 0x06db},                 // ARABIC LETTER JEH ISOLATED FORM
+	0xfb8b: {0x0631, 0x06db},                 // ARABIC LETTER JEH FINAL FORM
+	0xfb8c: {0x0631, 0x0615},                 // ARABIC LETTER RREH ISOLATED FORM
+	0xfb8d: {0x0631, 0x0615},                 // ARABIC LETTER RREH FINAL FORM
+	0xfb8e: {0x0643},                         // ARABIC LETTER KEHEH ISOLATED FORM
+	0xfb8f: {0x0643},                         // ARABIC LETTER KEHEH FINAL FORM
+	0xfb90: {0x0643},                         // ARABIC LETTER KEHEH INITIAL FORM
+	0xfb91: {0x0643},                         // ARABIC LETTER KEHEH MEDIAL FORM
+	0xfb92: {0x06af},                         // ARABIC LETTER GAF ISOLATED FORM
+	0xfb93: {0x06af},                         // ARABIC LETTER GAF FINAL FORM
+	0xfb94: {0x06af},                         // ARABIC LETTER GAF INITIAL FORM
+	0xfb95: {0x06af},                         // ARABIC LETTER GAF MEDIAL FORM
+	0xfb96: {0x06b3},                         // ARABIC LETTER GUEH ISOLATED FORM
+	0xfb97: {0x06b3},                         // ARABIC LETTER GUEH FINAL FORM
+	0xfb98: {0x06b3},                         // ARABIC LETTER GUEH INITIAL FORM
+	0xfb99: {0x06b3},                         // ARABIC LETTER GUEH MEDIAL FORM
+	0xfb9a: {0x06b1},                         // ARABIC LETTER NGOEH ISOLATED FORM
+	0xfb9b: {0x06b1},                         // ARABIC LETTER NGOEH FINAL FORM
+	0xfb9c: {0x06b1},                         // ARABIC LETTER NGOEH INITIAL FORM
+	0xfb9d: {0x06b1},                         // ARABIC LETTER NGOEH MEDIAL FORM
+	0xfb9e: {0x0649},                         // ARABIC LETTER NOON GHUNNA ISOLATED FORM
+	0xfb9f: {0x0649},                         // ARABIC LETTER NOON GHUNNA FINAL FORM
+	0xfba0: {0x0649, 0x0615},                 // ARABIC LETTER RNOON ISOLATED FORM
+	0xfba1: {0x0649, 0x0615},                 // ARABIC LETTER RNOON FINAL FORM
+	0xfba2: {0x0649, 0x0615},                 // ARABIC LETTER RNOON INITIAL FORM
+	0xfba3: {0x0649, 0x0615},                 // ARABIC LETTER RNOON MEDIAL FORM
+	0xfba4: {0x06d5, 0x0654},                 // ARABIC LETTER HEH WITH YEH ABOVE ISOLATED FORM
+	0xfba5: {0x06d5, 0x0654},                 // ARABIC LETTER HEH WITH YEH ABOVE FINAL FORM
+	0xfba6: {0x006f},                         // ARABIC LETTER HEH GOAL ISOLATED FORM
+	0xfba7: {0x006f},                         // ARABIC LETTER HEH GOAL FINAL FORM
+	0xfba8: {0x006f},                         // ARABIC LETTER HEH GOAL INITIAL FORM
+	0xfba9: {0x006f},                         // ARABIC LETTER HEH GOAL MEDIAL FORM
+	0xfbaa: {0x006f},                         // ARABIC LETTER HEH DOACHASHMEE ISOLATED FORM
+	0xfbab: {0x006f},                         // ARABIC LETTER HEH DOACHASHMEE FINAL FORM
+	0xfbac: {0x006f},                         // ARABIC LETTER HEH DOACHASHMEE INITIAL FORM
+	0xfbad: {0x006f},                         // ARABIC LETTER HEH DOACHASHMEE MEDIAL FORM
+	0xfbae: {0x0649},                         // ARABIC LETTER YEH BARREE ISOLATED FORM
+	0xfbaf: {0x0649},                         // ARABIC LETTER YEH BARREE FINAL FORM
+	0xfbb0: {0x06d2, 0x0654},                 // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE ISOLATED FORM
+	0xfbb1: {0x06d2, 0x0654},                 // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE FINAL FORM
+	0xfbd3: {0x0643, 0x06db},                 // ARABIC LETTER NG ISOLATED FORM
+	0xfbd4: {0x0643, 0x06db},                 // ARABIC LETTER NG FINAL FORM
+	0xfbd5: {0x0643, 0x06db},                 // ARABIC LETTER NG INITIAL FORM
+	0xfbd6: {0x0643, 0x06db},                 // ARABIC LETTER NG MEDIAL FORM
+	0xfbd7: {0x0648, 0x0313},                 // ARABIC LETTER U ISOLATED FORM
+	0xfbd8: {0x0648, 0x0313},                 // ARABIC LETTER U FINAL FORM
+	0xfbd9: {0x0648, 0x0306},                 // ARABIC LETTER OE ISOLATED FORM
+	0xfbda: {0x0648, 0x0306},                 // ARABIC LETTER OE FINAL FORM
+	0xfbdb: {0x0648, 0x0670},                 // ARABIC LETTER YU ISOLATED FORM
+	0xfbdc: {0x0648, 0x0670},                 // ARABIC LETTER YU FINAL FORM
+	0xfbdd: {0x0648, 0x0313, 0x0674},         // ARABIC LETTER U WITH HAMZA ABOVE ISOLATED FORM
+	0xfbde: {0x0648, 0x06db},                 // ARABIC LETTER VE ISOLATED FORM
+	0xfbdf: {0x0648, 0x06db},                 // ARABIC LETTER VE FINAL FORM
+	0xfbe0: {0x06c5},                         // ARABIC LETTER KIRGHIZ OE ISOLATED FORM
+	0xfbe1: {0x06c5},                         // ARABIC LETTER KIRGHIZ OE FINAL FORM
+	0xfbe2: {0x0648, 0x0302},                 // ARABIC LETTER KIRGHIZ YU ISOLATED FORM
+	0xfbe3: {0x0648, 0x0302},                 // ARABIC LETTER KIRGHIZ YU FINAL FORM
+	0xfbe4: {0x067b},                         // ARABIC LETTER E ISOLATED FORM
+	0xfbe5: {0x067b},                         // ARABIC LETTER E FINAL FORM
+	0xfbe6: {0x067b},                         // ARABIC LETTER E INITIAL FORM
+	0xfbe7: {0x067b},                         // ARABIC LETTER E MEDIAL FORM
+	0xfbe8: {0x0649},                         // ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA INITIAL FORM
+	0xfbe9: {0x0649},                         // ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA MEDIAL FORM
+	0xfbea: {0x0649, 0x0674, 0x006c},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF ISOLATED FORM
+	0xfbeb: {0x0649, 0x0674, 0x006c},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF FINAL FORM
+	0xfbec: {0x0649, 0x0674, 0x006f},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH AE ISOLATED FORM
+	0xfbed: {0x0649, 0x0674, 0x006f},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH AE FINAL FORM
+	0xfbee: {0x0649, 0x0674, 0x0648},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH WAW ISOLATED FORM
+	0xfbef: {0x0649, 0x0674, 0x0648},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH WAW FINAL FORM
+	0xfbf0: {0x0649, 0x0674, 0x0648, 0x0313}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH U ISOLATED FORM
+	0xfbf1: {0x0649, 0x0674, 0x0648, 0x0313}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH U FINAL FORM
+	0xfbf2: {0x0649, 0x0674, 0x0648, 0x0306}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH OE ISOLATED FORM
+	0xfbf3: {0x0649, 0x0674, 0x0648, 0x0306}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH OE FINAL FORM
+	0xfbf4: {0x0649, 0x0674, 0x0648, 0x0670}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YU ISOLATED FORM
+	0xfbf5: {0x0649, 0x0674, 0x0648, 0x0670}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YU FINAL FORM
+	0xfbf6: {0x0649, 0x0674, 0x067b},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E ISOLATED FORM
+	0xfbf7: {0x0649, 0x0674, 0x067b},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E FINAL FORM
+	0xfbf8: {0x0649, 0x0674, 0x067b},         // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E INITIAL FORM
+	0xfbf9: {0x0649, 0x0674, 0x0649},         // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM
+	0xfbfa: {0x0649, 0x0674, 0x0649},         // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA FINAL FORM
+	0xfbfb: {0x0649, 0x0674, 0x0649},         // ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA INITIAL FORM
+	0xfbfc: {0x0649},                         // ARABIC LETTER FARSI YEH ISOLATED FORM
+	0xfbfd: {0x0649},                         // ARABIC LETTER FARSI YEH FINAL FORM
+	0xfbfe: {0x0649},                         // ARABIC LETTER FARSI YEH INITIAL FORM
+	0xfbff: {0x0649},                         // ARABIC LETTER FARSI YEH MEDIAL FORM
+
+	0xfc00: {0x0649, 0x0674, 0x062c}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH JEEM ISOLATED FORM
+	0xfc01: {0x0649, 0x0674, 0x062d}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HAH ISOLATED FORM
+	0xfc02: {0x0649, 0x0674, 0x0645}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM ISOLATED FORM
+	0xfc03: {0x0649, 0x0674, 0x0649}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM
+	0xfc04: {0x0649, 0x0674, 0x0649}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YEH ISOLATED FORM
+	0xfc05: {0x0628, 0x062c},         // ARABIC LIGATURE BEH WITH JEEM ISOLATED FORM
+	0xfc06: {0x0628, 0x062d},         // ARABIC LIGATURE BEH WITH HAH ISOLATED FORM
+	0xfc07: {0x0628, 0x062e},         // ARABIC LIGATURE BEH WITH KHAH ISOLATED FORM
+	0xfc08: {0x0628, 0x0645},         // ARABIC LIGATURE BEH WITH MEEM ISOLATED FORM
+	0xfc09: {0x0628, 0x0649},         // ARABIC LIGATURE BEH WITH ALEF MAKSURA ISOLATED FORM
+	0xfc0a: {0x0628, 0x0649},         // ARABIC LIGATURE BEH WITH YEH ISOLATED FORM
+	0xfc0b: {0x062a, 0x062c},         // ARABIC LIGATURE TEH WITH JEEM ISOLATED FORM
+	0xfc0c: {0x062a, 0x062d},         // ARABIC LIGATURE TEH WITH HAH ISOLATED FORM
+	0xfc0d: {0x062a, 0x062e},         // ARABIC LIGATURE TEH WITH KHAH ISOLATED FORM
+	0xfc0e: {0x062a, 0x0645},         // ARABIC LIGATURE TEH WITH MEEM ISOLATED FORM
+	0xfc0f: {0x062a, 0x0649},         // ARABIC LIGATURE TEH WITH ALEF MAKSURA ISOLATED FORM
+	0xfc10: {0x062a, 0x0649},         // ARABIC LIGATURE TEH WITH YEH ISOLATED FORM
+	0xfc11: {0x0649, 0x06db, 0x062c}, // ARABIC LIGATURE THEH WITH JEEM ISOLATED FORM
+	0xfc12: {0x0649, 0x06db, 0x0645}, // ARABIC LIGATURE THEH WITH MEEM ISOLATED FORM
+	0xfc13: {0x0649, 0x06db, 0x0649}, // ARABIC LIGATURE THEH WITH ALEF MAKSURA ISOLATED FORM
+	0xfc14: {0x0649, 0x06db, 0x0649}, // ARABIC LIGATURE THEH WITH YEH ISOLATED FORM
+	0xfc15: {0x062c, 0x062d},         // ARABIC LIGATURE JEEM WITH HAH ISOLATED FORM
+	0xfc16: {0x062c, 0x0645},         // ARABIC LIGATURE JEEM WITH MEEM ISOLATED FORM
+	0xfc17: {0x062d, 0x062c},         // ARABIC LIGATURE HAH WITH JEEM ISOLATED FORM
+	0xfc18: {0x062d, 0x0645},         // ARABIC LIGATURE HAH WITH MEEM ISOLATED FORM
+	0xfc19: {0x062e, 0x062c},         // ARABIC LIGATURE KHAH WITH JEEM ISOLATED FORM
+	0xfc1a: {0x062e, 0x062d},         // ARABIC LIGATURE KHAH WITH HAH ISOLATED FORM
+	0xfc1b: {0x062e, 0x0645},         // ARABIC LIGATURE KHAH WITH MEEM ISOLATED FORM
+	0xfc1c: {0x0633, 0x062c},         // ARABIC LIGATURE SEEN WITH JEEM ISOLATED FORM
+	0xfc1d: {0x0633, 0x062d},         // ARABIC LIGATURE SEEN WITH HAH ISOLATED FORM
+	0xfc1e: {0x0633, 0x062e},         // ARABIC LIGATURE SEEN WITH KHAH ISOLATED FORM
+	0xfc1f: {0x0633, 0x0645},         // ARABIC LIGATURE SEEN WITH MEEM ISOLATED FORM
+	0xfc20: {0x0635, 0x062d},         // ARABIC LIGATURE SAD WITH HAH ISOLATED FORM
+	0xfc21: {0x0635, 0x0645},         // ARABIC LIGATURE SAD WITH MEEM ISOLATED FORM
+	0xfc22: {0x0636, 0x062c},         // ARABIC LIGATURE DAD WITH JEEM ISOLATED FORM
+	0xfc23: {0x0636, 0x062d},         // ARABIC LIGATURE DAD WITH HAH ISOLATED FORM
+	0xfc24: {0x0636, 0x062e},         // ARABIC LIGATURE DAD WITH KHAH ISOLATED FORM
+	0xfc25: {0x0636, 0x0645},         // ARABIC LIGATURE DAD WITH MEEM ISOLATED FORM
+	0xfc26: {0x0637, 0x062d},         // ARABIC LIGATURE TAH WITH HAH ISOLATED FORM
+	0xfc27: {0x0637, 0x0645},         // ARABIC LIGATURE TAH WITH MEEM ISOLATED FORM
+	0xfc28: {0x0638, 0x0645},         // ARABIC LIGATURE ZAH WITH MEEM ISOLATED FORM
+	0xfc29: {0x0639, 0x062c},         // ARABIC LIGATURE AIN WITH JEEM ISOLATED FORM
+	0xfc2a: {0x0639, 0x0645},         // ARABIC LIGATURE AIN WITH MEEM ISOLATED FORM
+	0xfc2b: {0x063a, 0x062c},         // ARABIC LIGATURE GHAIN WITH JEEM ISOLATED FORM
+	0xfc2c: {0x063a, 0x0645},         // ARABIC LIGATURE GHAIN WITH MEEM ISOLATED FORM
+	0xfc2d: {0x0641, 0x062c},         // ARABIC LIGATURE FEH WITH JEEM ISOLATED FORM
+	0xfc2e: {0x0641, 0x062d},         // ARABIC LIGATURE FEH WITH HAH ISOLATED FORM
+	0xfc2f: {0x0641, 0x062e},         // ARABIC LIGATURE FEH WITH KHAH ISOLATED FORM
+	0xfc30: {0x0641, 0x0645},         // ARABIC LIGATURE FEH WITH MEEM ISOLATED FORM
+	0xfc31: {0x0641, 0x0649},         // ARABIC LIGATURE FEH WITH ALEF MAKSURA ISOLATED FORM
+	0xfc32: {0x0641, 0x0649},         // ARABIC LIGATURE FEH WITH YEH ISOLATED FORM
+	0xfc33: {0x0642, 0x062d},         // ARABIC LIGATURE QAF WITH HAH ISOLATED FORM
+	0xfc34: {0x0642, 0x0645},         // ARABIC LIGATURE QAF WITH MEEM ISOLATED FORM
+	0xfc35: {0x0642, 0x0649},         // ARABIC LIGATURE QAF WITH ALEF MAKSURA ISOLATED FORM
+	0xfc36: {0x0642, 0x0649},         // ARABIC LIGATURE QAF WITH YEH ISOLATED FORM
+	0xfc37: {0x0643, 0x006c},         // ARABIC LIGATURE KAF WITH ALEF ISOLATED FORM
+	0xfc38: {0x0643, 0x062c},         // ARABIC LIGATURE KAF WITH JEEM ISOLATED FORM
+	0xfc39: {0x0643, 0x062d},         // ARABIC LIGATURE KAF WITH HAH ISOLATED FORM
+	0xfc3a: {0x0643, 0x062e},         // ARABIC LIGATURE KAF WITH KHAH ISOLATED FORM
+	0xfc3b: {0x0643, 0x0644},         // ARABIC LIGATURE KAF WITH LAM ISOLATED FORM
+	0xfc3c: {0x0643, 0x0645},         // ARABIC LIGATURE KAF WITH MEEM ISOLATED FORM
+	0xfc3d: {0x0643, 0x0649},         // ARABIC LIGATURE KAF WITH ALEF MAKSURA ISOLATED FORM
+	0xfc3e: {0x0643, 0x0649},         // ARABIC LIGATURE KAF WITH YEH ISOLATED FORM
+	0xfc3f: {0x0644, 0x062c},         // ARABIC LIGATURE LAM WITH JEEM ISOLATED FORM
+	0xfc40: {0x0644, 0x062d},         // ARABIC LIGATURE LAM WITH HAH ISOLATED FORM
+	0xfc41: {0x0644, 0x062e},         // ARABIC LIGATURE LAM WITH KHAH ISOLATED FORM
+	0xfc42: {0x0644, 0x0645},         // ARABIC LIGATURE LAM WITH MEEM ISOLATED FORM
+	0xfc43: {0x0644, 0x0649},         // ARABIC LIGATURE LAM WITH ALEF MAKSURA ISOLATED FORM
+	0xfc44: {0x0644, 0x0649},         // ARABIC LIGATURE LAM WITH YEH ISOLATED FORM
+	0xfc45: {0x0645, 0x062c},         // ARABIC LIGATURE MEEM WITH JEEM ISOLATED FORM
+	0xfc46: {0x0645, 0x062d},         // ARABIC LIGATURE MEEM WITH HAH ISOLATED FORM
+	0xfc47: {0x0645, 0x062e},         // ARABIC LIGATURE MEEM WITH KHAH ISOLATED FORM
+	0xfc48: {0x0645, 0x0645},         // ARABIC LIGATURE MEEM WITH MEEM ISOLATED FORM
+	0xfc49: {0x0645, 0x0649},         // ARABIC LIGATURE MEEM WITH ALEF MAKSURA ISOLATED FORM
+	0xfc4a: {0x0645, 0x0649},         // ARABIC LIGATURE MEEM WITH YEH ISOLATED FORM
+	0xfc4b: {0x0628, 0x062e},         // ARABIC LIGATURE NOON WITH JEEM ISOLATED FORM
+	0xfc4c: {0x0646, 0x062d},         // ARABIC LIGATURE NOON WITH HAH ISOLATED FORM
+	0xfc4d: {0x0646, 0x062e},         // ARABIC LIGATURE NOON WITH KHAH ISOLATED FORM
+	0xfc4e: {0x0646, 0x0645},         // ARABIC LIGATURE NOON WITH MEEM ISOLATED FORM
+	0xfc4f: {0x0646, 0x0649},         // ARABIC LIGATURE NOON WITH ALEF MAKSURA ISOLATED FORM
+	0xfc50: {0x0646, 0x0649},         // ARABIC LIGATURE NOON WITH YEH ISOLATED FORM
+	0xfc51: {0x006f, 0x062c},         // ARABIC LIGATURE HEH WITH JEEM ISOLATED FORM
+	0xfc52: {0x006f, 0x0645},         // ARABIC LIGATURE HEH WITH MEEM ISOLATED FORM
+	0xfc53: {0x006f, 0x0649},         // ARABIC LIGATURE HEH WITH ALEF MAKSURA ISOLATED FORM
+	0xfc54: {0x006f, 0x0649},         // ARABIC LIGATURE HEH WITH YEH ISOLATED FORM
+	0xfc55: {0x0649, 0x062c},         // ARABIC LIGATURE YEH WITH JEEM ISOLATED FORM
+	0xfc56: {0x0649, 0x062d},         // ARABIC LIGATURE YEH WITH HAH ISOLATED FORM
+	0xfc57: {0x0649, 0x062e},         // ARABIC LIGATURE YEH WITH KHAH ISOLATED FORM
+	0xfc58: {0x0649, 0x0645},         // ARABIC LIGATURE YEH WITH MEEM ISOLATED FORM
+	0xfc59: {0x0649, 0x0649},         // ARABIC LIGATURE YEH WITH ALEF MAKSURA ISOLATED FORM
+	0xfc5a: {0x0649, 0x0649},         // ARABIC LIGATURE YEH WITH YEH ISOLATED FORM
+	0xfc5b: {0x0630, 0x0670},         // ARABIC LIGATURE THAL WITH SUPERSCRIPT ALEF ISOLATED FORM
+	0xfc5c: {0x0631, 0x0670},         // ARABIC LIGATURE REH WITH SUPERSCRIPT ALEF ISOLATED FORM
+	0xfc5d: {0x0649, 0x0670},         // ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF ISOLATED FORM
+	0xfc5e: {0xfe72, 0x0651},         // ARABIC LIGATURE SHADDA WITH DAMMATAN ISOLATED FORM
+	0xfc5f: {0xfe74, 0x0651},         // ARABIC LIGATURE SHADDA WITH KASRATAN ISOLATED FORM
+	0xfc60: {0xfe76, 0x0651},         // ARABIC LIGATURE SHADDA WITH FATHA ISOLATED FORM
+	0xfc61: {0xfe78, 0x0651},         // ARABIC LIGATURE SHADDA WITH DAMMA ISOLATED FORM
+	0xfc62: {0xfe7a, 0x0651},         // ARABIC LIGATURE SHADDA WITH KASRA ISOLATED FORM
+	0xfc63: {0xfe7c, 0x0670},         // ARABIC LIGATURE SHADDA WITH SUPERSCRIPT ALEF ISOLATED FORM
+	0xfc64: {0x0649, 0x0674, 0x0631}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH REH FINAL FORM
+	0xfc65: {0x0649, 0x0674, 0x0632}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ZAIN FINAL FORM
+	0xfc66: {0x0649, 0x0674, 0x0645}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM FINAL FORM
+	0xfc67: {0x0649, 0x0674, 0x0646}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH NOON FINAL FORM
+	0xfc68: {0x0649, 0x0674, 0x0649}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF MAKSURA FINAL FORM
+	0xfc69: {0x0649, 0x0674, 0x0649}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YEH FINAL FORM
+	0xfc6a: {0x0628, 0x0631},         // ARABIC LIGATURE BEH WITH REH FINAL FORM
+	0xfc6b: {0x0628, 0x0632},         // ARABIC LIGATURE BEH WITH ZAIN FINAL FORM
+	0xfc6c: {0x0628, 0x0645},         // ARABIC LIGATURE BEH WITH MEEM FINAL FORM
+	0xfc6d: {0x0628, 0x0646},         // ARABIC LIGATURE BEH WITH NOON FINAL FORM
+	0xfc6e: {0x0628, 0x0649},         // ARABIC LIGATURE BEH WITH ALEF MAKSURA FINAL FORM
+	0xfc6f: {0x0628, 0x0649},         // ARABIC LIGATURE BEH WITH YEH FINAL FORM
+	0xfc70: {0x062a, 0x0631},         // ARABIC LIGATURE TEH WITH REH FINAL FORM
+	0xfc71: {0x062a, 0x0632},         // ARABIC LIGATURE TEH WITH ZAIN FINAL FORM
+	0xfc72: {0x062a, 0x0645},         // ARABIC LIGATURE TEH WITH MEEM FINAL FORM
+	0xfc73: {0x062a, 0x0646},         // ARABIC LIGATURE TEH WITH NOON FINAL FORM
+	0xfc74: {0x062a, 0x0649},         // ARABIC LIGATURE TEH WITH ALEF MAKSURA FINAL FORM
+	0xfc75: {0x062a, 0x0649},         // ARABIC LIGATURE TEH WITH YEH FINAL FORM
+	0xfc76: {0x0649, 0x06db, 0x0631}, // ARABIC LIGATURE THEH WITH REH FINAL FORM
+	0xfc77: {0x0649, 0x06db, 0x0632}, // ARABIC LIGATURE THEH WITH ZAIN FINAL FORM
+	0xfc78: {0x0649, 0x06db, 0x0645}, // ARABIC LIGATURE THEH WITH MEEM FINAL FORM
+	0xfc79: {0x0649, 0x06db, 0x0646}, // ARABIC LIGATURE THEH WITH NOON FINAL FORM
+	0xfc7a: {0x0649, 0x06db, 0x0649}, // ARABIC LIGATURE THEH WITH ALEF MAKSURA FINAL FORM
+	0xfc7b: {0x0649, 0x06db, 0x0649}, // ARABIC LIGATURE THEH WITH YEH FINAL FORM
+	0xfc7c: {0x0641, 0x0649},         // ARABIC LIGATURE FEH WITH ALEF MAKSURA FINAL FORM
+	0xfc7d: {0x0641, 0x0649},         // ARABIC LIGATURE FEH WITH YEH FINAL FORM
+	0xfc7e: {0x0642, 0x0649},         // ARABIC LIGATURE QAF WITH ALEF MAKSURA FINAL FORM
+	0xfc7f: {0x0642, 0x0649},         // ARABIC LIGATURE QAF WITH YEH FINAL FORM
+	0xfc80: {0x0643, 0x006c},         // ARABIC LIGATURE KAF WITH ALEF FINAL FORM
+	0xfc81: {0x0643, 0x0644},         // ARABIC LIGATURE KAF WITH LAM FINAL FORM
+	0xfc82: {0x0643, 0x0645},         // ARABIC LIGATURE KAF WITH MEEM FINAL FORM
+	0xfc83: {0x0643, 0x0649},         // ARABIC LIGATURE KAF WITH ALEF MAKSURA FINAL FORM
+	0xfc84: {0x0643, 0x0649},         // ARABIC LIGATURE KAF WITH YEH FINAL FORM
+	0xfc85: {0x0644, 0x0645},         // ARABIC LIGATURE LAM WITH MEEM FINAL FORM
+	0xfc86: {0x0644, 0x0649},         // ARABIC LIGATURE LAM WITH ALEF MAKSURA FINAL FORM
+	0xfc87: {0x0644, 0x0649},         // ARABIC LIGATURE LAM WITH YEH FINAL FORM
+	0xfc88: {0x0645, 0x006c},         // ARABIC LIGATURE MEEM WITH ALEF FINAL FORM
+	0xfc89: {0x0645, 0x0645},         // ARABIC LIGATURE MEEM WITH MEEM FINAL FORM
+	0xfc8a: {0x0646, 0x0631},         // ARABIC LIGATURE NOON WITH REH FINAL FORM
+	0xfc8b: {0x0646, 0x0632},         // ARABIC LIGATURE NOON WITH ZAIN FINAL FORM
+	0xfc8c: {0x0646, 0x0645},         // ARABIC LIGATURE NOON WITH MEEM FINAL FORM
+	0xfc8d: {0x0646, 0x0646},         // ARABIC LIGATURE NOON WITH NOON FINAL FORM
+	0xfc8e: {0x0646, 0x0649},         // ARABIC LIGATURE NOON WITH ALEF MAKSURA FINAL FORM
+	0xfc8f: {0x0646, 0x0649},         // ARABIC LIGATURE NOON WITH YEH FINAL FORM
+	0xfc90: {0x0649, 0x0670},         // ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF FINAL FORM
+	0xfc91: {0x0649, 0x0631},         // ARABIC LIGATURE YEH WITH REH FINAL FORM
+	0xfc92: {0x0649, 0x0632},         // ARABIC LIGATURE YEH WITH ZAIN FINAL FORM
+	0xfc93: {0x0649, 0x0645},         // ARABIC LIGATURE YEH WITH MEEM FINAL FORM
+	0xfc94: {0x0649, 0x0646},         // ARABIC LIGATURE YEH WITH NOON FINAL FORM
+	0xfc95: {0x0649, 0x0649},         // ARABIC LIGATURE YEH WITH ALEF MAKSURA FINAL FORM
+	0xfc96: {0x0649, 0x0649},         // ARABIC LIGATURE YEH WITH YEH FINAL FORM
+	0xfc97: {0x0649, 0x0674, 0x062c}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH JEEM INITIAL FORM
+	0xfc98: {0x0649, 0x0674, 0x062d}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HAH INITIAL FORM
+	0xfc99: {0x0649, 0x0674, 0x062e}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH KHAH INITIAL FORM
+	0xfc9a: {0x0649, 0x0674, 0x0645}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM INITIAL FORM
+	0xfc9b: {0x0649, 0x0674, 0x006f}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HEH INITIAL FORM
+	0xfc9c: {0x0628, 0x062c},         // ARABIC LIGATURE BEH WITH JEEM INITIAL FORM
+	0xfc9d: {0x0628, 0x062d},         // ARABIC LIGATURE BEH WITH HAH INITIAL FORM
+	0xfc9e: {0x0628, 0x062e},         // ARABIC LIGATURE BEH WITH KHAH INITIAL FORM
+	0xfc9f: {0x0628, 0x0645},         // ARABIC LIGATURE BEH WITH MEEM INITIAL FORM
+	0xfca0: {0x0628, 0x006f},         // ARABIC LIGATURE BEH WITH HEH INITIAL FORM
+	0xfca1: {0x062a, 0x062c},         // ARABIC LIGATURE TEH WITH JEEM INITIAL FORM
+	0xfca2: {0x062a, 0x062d},         // ARABIC LIGATURE TEH WITH HAH INITIAL FORM
+	0xfca3: {0x062a, 0x062e},         // ARABIC LIGATURE TEH WITH KHAH INITIAL FORM
+	0xfca4: {0x062a, 0x0645},         // ARABIC LIGATURE TEH WITH MEEM INITIAL FORM
+	0xfca5: {0x062a, 0x006f},         // ARABIC LIGATURE TEH WITH HEH INITIAL FORM
+	0xfca6: {0x0649, 0x06db, 0x0645}, // ARABIC LIGATURE THEH WITH MEEM INITIAL FORM
+	0xfca7: {0x062c, 0x062d},         // ARABIC LIGATURE JEEM WITH HAH INITIAL FORM
+	0xfca8: {0x062c, 0x0645},         // ARABIC LIGATURE JEEM WITH MEEM INITIAL FORM
+	0xfca9: {0x062d, 0x062c},         // ARABIC LIGATURE HAH WITH JEEM INITIAL FORM
+	0xfcaa: {0x062d, 0x0645},         // ARABIC LIGATURE HAH WITH MEEM INITIAL FORM
+	0xfcab: {0x062e, 0x062c},         // ARABIC LIGATURE KHAH WITH JEEM INITIAL FORM
+	0xfcac: {0x062e, 0x0645},         // ARABIC LIGATURE KHAH WITH MEEM INITIAL FORM
+	0xfcad: {0x0633, 0x062c},         // ARABIC LIGATURE SEEN WITH JEEM INITIAL FORM
+	0xfcae: {0x0633, 0x062d},         // ARABIC LIGATURE SEEN WITH HAH INITIAL FORM
+	0xfcaf: {0x0633, 0x062e},         // ARABIC LIGATURE SEEN WITH KHAH INITIAL FORM
+	0xfcb0: {0x0633, 0x0645},         // ARABIC LIGATURE SEEN WITH MEEM INITIAL FORM
+	0xfcb1: {0x0635, 0x062d},         // ARABIC LIGATURE SAD WITH HAH INITIAL FORM
+	0xfcb2: {0x0635, 0x062e},         // ARABIC LIGATURE SAD WITH KHAH INITIAL FORM
+	0xfcb3: {0x0635, 0x0645},         // ARABIC LIGATURE SAD WITH MEEM INITIAL FORM
+	0xfcb4: {0x0636, 0x062c},         // ARABIC LIGATURE DAD WITH JEEM INITIAL FORM
+	0xfcb5: {0x0636, 0x062d},         // ARABIC LIGATURE DAD WITH HAH INITIAL FORM
+	0xfcb6: {0x0636, 0x062e},         // ARABIC LIGATURE DAD WITH KHAH INITIAL FORM
+	0xfcb7: {0x0636, 0x0645},         // ARABIC LIGATURE DAD WITH MEEM INITIAL FORM
+	0xfcb8: {0x0637, 0x062d},         // ARABIC LIGATURE TAH WITH HAH INITIAL FORM
+	0xfcb9: {0x0638, 0x0645},         // ARABIC LIGATURE ZAH WITH MEEM INITIAL FORM
+	0xfcba: {0x0639, 0x062c},         // ARABIC LIGATURE AIN WITH JEEM INITIAL FORM
+	0xfcbb: {0x0639, 0x0645},         // ARABIC LIGATURE AIN WITH MEEM INITIAL FORM
+	0xfcbc: {0x063a, 0x062c},         // ARABIC LIGATURE GHAIN WITH JEEM INITIAL FORM
+	0xfcbd: {0x063a, 0x0645},         // ARABIC LIGATURE GHAIN WITH MEEM INITIAL FORM
+	0xfcbe: {0x0641, 0x062c},         // ARABIC LIGATURE FEH WITH JEEM INITIAL FORM
+	0xfcbf: {0x0641, 0x062d},         // ARABIC LIGATURE FEH WITH HAH INITIAL FORM
+	0xfcc0: {0x0641, 0x062e},         // ARABIC LIGATURE FEH WITH KHAH INITIAL FORM
+	0xfcc1: {0x0641, 0x0645},         // ARABIC LIGATURE FEH WITH MEEM INITIAL FORM
+	0xfcc2: {0x0642, 0x062d},         // ARABIC LIGATURE QAF WITH HAH INITIAL FORM
+	0xfcc3: {0x0642, 0x0645},         // ARABIC LIGATURE QAF WITH MEEM INITIAL FORM
+	0xfcc4: {0x0643, 0x062c},         // ARABIC LIGATURE KAF WITH JEEM INITIAL FORM
+	0xfcc5: {0x0643, 0x062d},         // ARABIC LIGATURE KAF WITH HAH INITIAL FORM
+	0xfcc6: {0x0643, 0x062e},         // ARABIC LIGATURE KAF WITH KHAH INITIAL FORM
+	0xfcc7: {0x0643, 0x0644},         // ARABIC LIGATURE KAF WITH LAM INITIAL FORM
+	0xfcc8: {0x0643, 0x0645},         // ARABIC LIGATURE KAF WITH MEEM INITIAL FORM
+	0xfcc9: {0x0644, 0x062c},         // ARABIC LIGATURE LAM WITH JEEM INITIAL FORM
+	0xfcca: {0x0644, 0x062d},         // ARABIC LIGATURE LAM WITH HAH INITIAL FORM
+	0xfccb: {0x0644, 0x062e},         // ARABIC LIGATURE LAM WITH KHAH INITIAL FORM
+	0xfccc: {0x0644, 0x0645},         // ARABIC LIGATURE LAM WITH MEEM INITIAL FORM
+	0xfccd: {0x0644, 0x006f},         // ARABIC LIGATURE LAM WITH HEH INITIAL FORM
+	0xfcce: {0x0645, 0x062c},         // ARABIC LIGATURE MEEM WITH JEEM INITIAL FORM
+	0xfccf: {0x0645, 0x062d},         // ARABIC LIGATURE MEEM WITH HAH INITIAL FORM
+	0xfcd0: {0x0645, 0x062e},         // ARABIC LIGATURE MEEM WITH KHAH INITIAL FORM
+	0xfcd1: {0x0645, 0x0645},         // ARABIC LIGATURE MEEM WITH MEEM INITIAL FORM
+	0xfcd2: {0x0628, 0x062e},         // ARABIC LIGATURE NOON WITH JEEM INITIAL FORM
+	0xfcd3: {0x0646, 0x062d},         // ARABIC LIGATURE NOON WITH HAH INITIAL FORM
+	0xfcd4: {0x0646, 0x062e},         // ARABIC LIGATURE NOON WITH KHAH INITIAL FORM
+	0xfcd5: {0x0646, 0x0645},         // ARABIC LIGATURE NOON WITH MEEM INITIAL FORM
+	0xfcd6: {0x0646, 0x006f},         // ARABIC LIGATURE NOON WITH HEH INITIAL FORM
+	0xfcd7: {0x006f, 0x062c},         // ARABIC LIGATURE HEH WITH JEEM INITIAL FORM
+	0xfcd8: {0x006f, 0x0645},         // ARABIC LIGATURE HEH WITH MEEM INITIAL FORM
+	0xfcd9: {0x006f, 0x0670},         // ARABIC LIGATURE HEH WITH SUPERSCRIPT ALEF INITIAL FORM
+	0xfcda: {0x0649, 0x062c},         // ARABIC LIGATURE YEH WITH JEEM INITIAL FORM
+	0xfcdb: {0x0649, 0x062d},         // ARABIC LIGATURE YEH WITH HAH INITIAL FORM
+	0xfcdc: {0x0649, 0x062e},         // ARABIC LIGATURE YEH WITH KHAH INITIAL FORM
+	0xfcdd: {0x0649, 0x0645},         // ARABIC LIGATURE YEH WITH MEEM INITIAL FORM
+	0xfcde: {0x0649, 0x006f},         // ARABIC LIGATURE YEH WITH HEH INITIAL FORM
+	0xfcdf: {0x0649, 0x0674, 0x0645}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM MEDIAL FORM
+	0xfce0: {0x0649, 0x0674, 0x006f}, // ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HEH MEDIAL FORM
+	0xfce1: {0x0628, 0x0645},         // ARABIC LIGATURE BEH WITH MEEM MEDIAL FORM
+	0xfce2: {0x0628, 0x006f},         // ARABIC LIGATURE BEH WITH HEH MEDIAL FORM
+	0xfce3: {0x062a, 0x0645},         // ARABIC LIGATURE TEH WITH MEEM MEDIAL FORM
+	0xfce4: {0x062a, 0x006f},         // ARABIC LIGATURE TEH WITH HEH MEDIAL FORM
+	0xfce5: {0x0649, 0x06db, 0x0645}, // ARABIC LIGATURE THEH WITH MEEM MEDIAL FORM
+	0xfce6: {0x0649, 0x06db, 0x006f}, // ARABIC LIGATURE THEH WITH HEH MEDIAL FORM
+	0xfce7: {0x0633, 0x0645},         // ARABIC LIGATURE SEEN WITH MEEM MEDIAL FORM
+	0xfce8: {0x0633, 0x006f},         // ARABIC LIGATURE SEEN WITH HEH MEDIAL FORM
+	0xfce9: {0x0633, 0x06db, 0x0645}, // ARABIC LIGATURE SHEEN WITH MEEM MEDIAL FORM
+	0xfcea: {0x0633, 0x06db, 0x006f}, // ARABIC LIGATURE SHEEN WITH HEH MEDIAL FORM
+	0xfceb: {0x0643, 0x0644},         // ARABIC LIGATURE KAF WITH LAM MEDIAL FORM
+	0xfcec: {0x0643, 0x0645},         // ARABIC LIGATURE KAF WITH MEEM MEDIAL FORM
+	0xfced: {0x0644, 0x0645},         // ARABIC LIGATURE LAM WITH MEEM MEDIAL FORM
+	0xfcee: {0x0646, 0x0645},         // ARABIC LIGATURE NOON WITH MEEM MEDIAL FORM
+	0xfcef: {0x0646, 0x006f},         // ARABIC LIGATURE NOON WITH HEH MEDIAL FORM
+	0xfcf0: {0x0649, 0x0645},         // ARABIC LIGATURE YEH WITH MEEM MEDIAL FORM
+	0xfcf1: {0x0649, 0x006f},         // ARABIC LIGATURE YEH WITH HEH MEDIAL FORM
+	0xfcf2: {0xfe77, 0x0651},         // ARABIC LIGATURE SHADDA WITH FATHA MEDIAL FORM
+	0xfcf3: {0xfe79, 0x0651},         // ARABIC LIGATURE SHADDA WITH DAMMA MEDIAL FORM
+	0xfcf4: {0xfe7b, 0x0651},         // ARABIC LIGATURE SHADDA WITH KASRA MEDIAL FORM
+	0xfcf5: {0x0637, 0x0649},         // ARABIC LIGATURE TAH WITH ALEF MAKSURA ISOLATED FORM
+	0xfcf6: {0x0637, 0x0649},         // ARABIC LIGATURE TAH WITH YEH ISOLATED FORM
+	0xfcf7: {0x0639, 0x0649},         // ARABIC LIGATURE AIN WITH ALEF MAKSURA ISOLATED FORM
+	0xfcf8: {0x0639, 0x0649},         // ARABIC LIGATURE AIN WITH YEH ISOLATED FORM
+	0xfcf9: {0x063a, 0x0649},         // ARABIC LIGATURE GHAIN WITH ALEF MAKSURA ISOLATED FORM
+	0xfcfa: {0x063a, 0x0649},         // ARABIC LIGATURE GHAIN WITH YEH ISOLATED FORM
+	0xfcfb: {0x0633, 0x0649},         // ARABIC LIGATURE SEEN WITH ALEF MAKSURA ISOLATED FORM
+	0xfcfc: {0x0633, 0x0649},         // ARABIC LIGATURE SEEN WITH YEH ISOLATED FORM
+	0xfcfd: {0x0633, 0x06db, 0x0649}, // ARABIC LIGATURE SHEEN WITH ALEF MAKSURA ISOLATED FORM
+	0xfcfe: {0x0633, 0x06db, 0x0649}, // ARABIC LIGATURE SHEEN WITH YEH ISOLATED FORM
+	0xfcff: {0x062d, 0x0649},         // ARABIC LIGATURE HAH WITH ALEF MAKSURA ISOLATED FORM
+
+	0xfd00: {0x062d, 0x0649},                                                                                                                                 // ARABIC LIGATURE HAH WITH YEH ISOLATED FORM
+	0xfd01: {0x062c, 0x0649},                                                                                                                                 // ARABIC LIGATURE JEEM WITH ALEF MAKSURA ISOLATED FORM
+	0xfd02: {0x062c, 0x0649},                                                                                                                                 // ARABIC LIGATURE JEEM WITH YEH ISOLATED FORM
+	0xfd03: {0x062e, 0x0649},                                                                                                                                 // ARABIC LIGATURE KHAH WITH ALEF MAKSURA ISOLATED FORM
+	0xfd04: {0x062e, 0x0649},                                                                                                                                 // ARABIC LIGATURE KHAH WITH YEH ISOLATED FORM
+	0xfd05: {0x0635, 0x0649},                                                                                                                                 // ARABIC LIGATURE SAD WITH ALEF MAKSURA ISOLATED FORM
+	0xfd06: {0x0635, 0x0649},                                                                                                                                 // ARABIC LIGATURE SAD WITH YEH ISOLATED FORM
+	0xfd07: {0x0636, 0x0649},                                                                                                                                 // ARABIC LIGATURE DAD WITH ALEF MAKSURA ISOLATED FORM
+	0xfd08: {0x0636, 0x0649},                                                                                                                                 // ARABIC LIGATURE DAD WITH YEH ISOLATED FORM
+	0xfd09: {0x0633, 0x06db, 0x062c},                                                                                                                         // ARABIC LIGATURE SHEEN WITH JEEM ISOLATED FORM
+	0xfd0a: {0x0633, 0x06db, 0x062d},                                                                                                                         // ARABIC LIGATURE SHEEN WITH HAH ISOLATED FORM
+	0xfd0b: {0x0633, 0x06db, 0x062e},                                                                                                                         // ARABIC LIGATURE SHEEN WITH KHAH ISOLATED FORM
+	0xfd0c: {0x0633, 0x06db, 0x0645},                                                                                                                         // ARABIC LIGATURE SHEEN WITH MEEM ISOLATED FORM
+	0xfd0d: {0x0633, 0x06db, 0x0631},                                                                                                                         // ARABIC LIGATURE SHEEN WITH REH ISOLATED FORM
+	0xfd0e: {0x0633, 0x0631},                                                                                                                                 // ARABIC LIGATURE SEEN WITH REH ISOLATED FORM
+	0xfd0f: {0x0635, 0x0631},                                                                                                                                 // ARABIC LIGATURE SAD WITH REH ISOLATED FORM
+	0xfd10: {0x0636, 0x0631},                                                                                                                                 // ARABIC LIGATURE DAD WITH REH ISOLATED FORM
+	0xfd11: {0x0637, 0x0649},                                                                                                                                 // ARABIC LIGATURE TAH WITH ALEF MAKSURA FINAL FORM
+	0xfd12: {0x0637, 0x0649},                                                                                                                                 // ARABIC LIGATURE TAH WITH YEH FINAL FORM
+	0xfd13: {0x0639, 0x0649},                                                                                                                                 // ARABIC LIGATURE AIN WITH ALEF MAKSURA FINAL FORM
+	0xfd14: {0x0639, 0x0649},                                                                                                                                 // ARABIC LIGATURE AIN WITH YEH FINAL FORM
+	0xfd15: {0x063a, 0x0649},                                                                                                                                 // ARABIC LIGATURE GHAIN WITH ALEF MAKSURA FINAL FORM
+	0xfd16: {0x063a, 0x0649},                                                                                                                                 // ARABIC LIGATURE GHAIN WITH YEH FINAL FORM
+	0xfd17: {0x0633, 0x0649},                                                                                                                                 // ARABIC LIGATURE SEEN WITH ALEF MAKSURA FINAL FORM
+	0xfd18: {0x0633, 0x0649},                                                                                                                                 // ARABIC LIGATURE SEEN WITH YEH FINAL FORM
+	0xfd19: {0x0633, 0x06db, 0x0649},                                                                                                                         // ARABIC LIGATURE SHEEN WITH ALEF MAKSURA FINAL FORM
+	0xfd1a: {0x0633, 0x06db, 0x0649},                                                                                                                         // ARABIC LIGATURE SHEEN WITH YEH FINAL FORM
+	0xfd1b: {0x062d, 0x0649},                                                                                                                                 // ARABIC LIGATURE HAH WITH ALEF MAKSURA FINAL FORM
+	0xfd1c: {0x062d, 0x0649},                                                                                                                                 // ARABIC LIGATURE HAH WITH YEH FINAL FORM
+	0xfd1d: {0x062c, 0x0649},                                                                                                                                 // ARABIC LIGATURE JEEM WITH ALEF MAKSURA FINAL FORM
+	0xfd1e: {0x062c, 0x0649},                                                                                                                                 // ARABIC LIGATURE JEEM WITH YEH FINAL FORM
+	0xfd1f: {0x062e, 0x0649},                                                                                                                                 // ARABIC LIGATURE KHAH WITH ALEF MAKSURA FINAL FORM
+	0xfd20: {0x062e, 0x0649},                                                                                                                                 // ARABIC LIGATURE KHAH WITH YEH FINAL FORM
+	0xfd21: {0x0635, 0x0649},                                                                                                                                 // ARABIC LIGATURE SAD WITH ALEF MAKSURA FINAL FORM
+	0xfd22: {0x0635, 0x0649},                                                                                                                                 // ARABIC LIGATURE SAD WITH YEH FINAL FORM
+	0xfd23: {0x0636, 0x0649},                                                                                                                                 // ARABIC LIGATURE DAD WITH ALEF MAKSURA FINAL FORM
+	0xfd24: {0x0636, 0x0649},                                                                                                                                 // ARABIC LIGATURE DAD WITH YEH FINAL FORM
+	0xfd25: {0x0633, 0x06db, 0x062c},                                                                                                                         // ARABIC LIGATURE SHEEN WITH JEEM FINAL FORM
+	0xfd26: {0x0633, 0x06db, 0x062d},                                                                                                                         // ARABIC LIGATURE SHEEN WITH HAH FINAL FORM
+	0xfd27: {0x0633, 0x06db, 0x062e},                                                                                                                         // ARABIC LIGATURE SHEEN WITH KHAH FINAL FORM
+	0xfd28: {0x0633, 0x06db, 0x0645},                                                                                                                         // ARABIC LIGATURE SHEEN WITH MEEM FINAL FORM
+	0xfd29: {0x0633, 0x06db, 0x0631},                                                                                                                         // ARABIC LIGATURE SHEEN WITH REH FINAL FORM
+	0xfd2a: {0x0633, 0x0631},                                                                                                                                 // ARABIC LIGATURE SEEN WITH REH FINAL FORM
+	0xfd2b: {0x0635, 0x0631},                                                                                                                                 // ARABIC LIGATURE SAD WITH REH FINAL FORM
+	0xfd2c: {0x0636, 0x0631},                                                                                                                                 // ARABIC LIGATURE DAD WITH REH FINAL FORM
+	0xfd2d: {0x0633, 0x06db, 0x062c},                                                                                                                         // ARABIC LIGATURE SHEEN WITH JEEM INITIAL FORM
+	0xfd2e: {0x0633, 0x06db, 0x062d},                                                                                                                         // ARABIC LIGATURE SHEEN WITH HAH INITIAL FORM
+	0xfd2f: {0x0633, 0x06db, 0x062e},                                                                                                                         // ARABIC LIGATURE SHEEN WITH KHAH INITIAL FORM
+	0xfd30: {0x0633, 0x06db, 0x0645},                                                                                                                         // ARABIC LIGATURE SHEEN WITH MEEM INITIAL FORM
+	0xfd31: {0x0633, 0x006f},                                                                                                                                 // ARABIC LIGATURE SEEN WITH HEH INITIAL FORM
+	0xfd32: {0x0633, 0x06db, 0x006f},                                                                                                                         // ARABIC LIGATURE SHEEN WITH HEH INITIAL FORM
+	0xfd33: {0x0637, 0x0645},                                                                                                                                 // ARABIC LIGATURE TAH WITH MEEM INITIAL FORM
+	0xfd34: {0x0633, 0x062c},                                                                                                                                 // ARABIC LIGATURE SEEN WITH JEEM MEDIAL FORM
+	0xfd35: {0x0633, 0x062d},                                                                                                                                 // ARABIC LIGATURE SEEN WITH HAH MEDIAL FORM
+	0xfd36: {0x0633, 0x062e},                                                                                                                                 // ARABIC LIGATURE SEEN WITH KHAH MEDIAL FORM
+	0xfd37: {0x0633, 0x06db, 0x062c},                                                                                                                         // ARABIC LIGATURE SHEEN WITH JEEM MEDIAL FORM
+	0xfd38: {0x0633, 0x06db, 0x062d},                                                                                                                         // ARABIC LIGATURE SHEEN WITH HAH MEDIAL FORM
+	0xfd39: {0x0633, 0x06db, 0x062e},                                                                                                                         // ARABIC LIGATURE SHEEN WITH KHAH MEDIAL FORM
+	0xfd3a: {0x0637, 0x0645},                                                                                                                                 // ARABIC LIGATURE TAH WITH MEEM MEDIAL FORM
+	0xfd3b: {0x0638, 0x0645},                                                                                                                                 // ARABIC LIGATURE ZAH WITH MEEM MEDIAL FORM
+	0xfd3c: {0x006c, 0x030b},                                                                                                                                 // ARABIC LIGATURE ALEF WITH FATHATAN FINAL FORM
+	0xfd3d: {0x006c, 0x030b},                                                                                                                                 // ARABIC LIGATURE ALEF WITH FATHATAN ISOLATED FORM
+	0xfd3e: {0x0028},                                                                                                                                         // ORNATE LEFT PARENTHESIS
+	0xfd3f: {0x0029},                                                                                                                                         // ORNATE RIGHT PARENTHESIS
+	0xfd50: {0x062a, 0x062c, 0x0645},                                                                                                                         // ARABIC LIGATURE TEH WITH JEEM WITH MEEM INITIAL FORM
+	0xfd51: {0x062a, 0x062d, 0x062c},                                                                                                                         // ARABIC LIGATURE TEH WITH HAH WITH JEEM FINAL FORM
+	0xfd52: {0x062a, 0x062d, 0x062c},                                                                                                                         // ARABIC LIGATURE TEH WITH HAH WITH JEEM INITIAL FORM
+	0xfd53: {0x062a, 0x062d, 0x0645},                                                                                                                         // ARABIC LIGATURE TEH WITH HAH WITH MEEM INITIAL FORM
+	0xfd54: {0x062a, 0x062e, 0x0645},                                                                                                                         // ARABIC LIGATURE TEH WITH KHAH WITH MEEM INITIAL FORM
+	0xfd55: {0x062a, 0x0645, 0x062c},                                                                                                                         // ARABIC LIGATURE TEH WITH MEEM WITH JEEM INITIAL FORM
+	0xfd56: {0x062a, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE TEH WITH MEEM WITH HAH INITIAL FORM
+	0xfd57: {0x062a, 0x0645, 0x062e},                                                                                                                         // ARABIC LIGATURE TEH WITH MEEM WITH KHAH INITIAL FORM
+	0xfd58: {0x062c, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE JEEM WITH MEEM WITH HAH FINAL FORM
+	0xfd59: {0x062c, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE JEEM WITH MEEM WITH HAH INITIAL FORM
+	0xfd5a: {0x062d, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE HAH WITH MEEM WITH YEH FINAL FORM
+	0xfd5b: {0x062d, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE HAH WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xfd5c: {0x0633, 0x062d, 0x062c},                                                                                                                         // ARABIC LIGATURE SEEN WITH HAH WITH JEEM INITIAL FORM
+	0xfd5d: {0x0633, 0x062c, 0x062d},                                                                                                                         // ARABIC LIGATURE SEEN WITH JEEM WITH HAH INITIAL FORM
+	0xfd5e: {0x0633, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE SEEN WITH JEEM WITH ALEF MAKSURA FINAL FORM
+	0xfd5f: {0x0633, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE SEEN WITH MEEM WITH HAH FINAL FORM
+	0xfd60: {0x0633, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE SEEN WITH MEEM WITH HAH INITIAL FORM
+	0xfd61: {0x0633, 0x0645, 0x062c},                                                                                                                         // ARABIC LIGATURE SEEN WITH MEEM WITH JEEM INITIAL FORM
+	0xfd62: {0x0633, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE SEEN WITH MEEM WITH MEEM FINAL FORM
+	0xfd63: {0x0633, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE SEEN WITH MEEM WITH MEEM INITIAL FORM
+	0xfd64: {0x0635, 0x062d, 0x062d},                                                                                                                         // ARABIC LIGATURE SAD WITH HAH WITH HAH FINAL FORM
+	0xfd65: {0x0635, 0x062d, 0x062d},                                                                                                                         // ARABIC LIGATURE SAD WITH HAH WITH HAH INITIAL FORM
+	0xfd66: {0x0635, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE SAD WITH MEEM WITH MEEM FINAL FORM
+	0xfd67: {0x0633, 0x06db, 0x062d, 0x0645},                                                                                                                 // ARABIC LIGATURE SHEEN WITH HAH WITH MEEM FINAL FORM
+	0xfd68: {0x0633, 0x06db, 0x062d, 0x0645},                                                                                                                 // ARABIC LIGATURE SHEEN WITH HAH WITH MEEM INITIAL FORM
+	0xfd69: {0x0633, 0x06db, 0x062c, 0x0649},                                                                                                                 // ARABIC LIGATURE SHEEN WITH JEEM WITH YEH FINAL FORM
+	0xfd6a: {0x0633, 0x06db, 0x0645, 0x062e},                                                                                                                 // ARABIC LIGATURE SHEEN WITH MEEM WITH KHAH FINAL FORM
+	0xfd6b: {0x0633, 0x06db, 0x0645, 0x062e},                                                                                                                 // ARABIC LIGATURE SHEEN WITH MEEM WITH KHAH INITIAL FORM
+	0xfd6c: {0x0633, 0x06db, 0x0645, 0x0645},                                                                                                                 // ARABIC LIGATURE SHEEN WITH MEEM WITH MEEM FINAL FORM
+	0xfd6d: {0x0633, 0x06db, 0x0645, 0x0645},                                                                                                                 // ARABIC LIGATURE SHEEN WITH MEEM WITH MEEM INITIAL FORM
+	0xfd6e: {0x0636, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE DAD WITH HAH WITH ALEF MAKSURA FINAL FORM
+	0xfd6f: {0x0636, 0x062e, 0x0645},                                                                                                                         // ARABIC LIGATURE DAD WITH KHAH WITH MEEM FINAL FORM
+	0xfd70: {0x0636, 0x062e, 0x0645},                                                                                                                         // ARABIC LIGATURE DAD WITH KHAH WITH MEEM INITIAL FORM
+	0xfd71: {0x0637, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE TAH WITH MEEM WITH HAH FINAL FORM
+	0xfd72: {0x0637, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE TAH WITH MEEM WITH HAH INITIAL FORM
+	0xfd73: {0x0637, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE TAH WITH MEEM WITH MEEM INITIAL FORM
+	0xfd74: {0x0637, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE TAH WITH MEEM WITH YEH FINAL FORM
+	0xfd75: {0x0639, 0x062c, 0x0645},                                                                                                                         // ARABIC LIGATURE AIN WITH JEEM WITH MEEM FINAL FORM
+	0xfd76: {0x0639, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE AIN WITH MEEM WITH MEEM FINAL FORM
+	0xfd77: {0x0639, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE AIN WITH MEEM WITH MEEM INITIAL FORM
+	0xfd78: {0x0639, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE AIN WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xfd79: {0x063a, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE GHAIN WITH MEEM WITH MEEM FINAL FORM
+	0xfd7a: {0x063a, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE GHAIN WITH MEEM WITH YEH FINAL FORM
+	0xfd7b: {0x063a, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE GHAIN WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xfd7c: {0x0641, 0x062e, 0x0645},                                                                                                                         // ARABIC LIGATURE FEH WITH KHAH WITH MEEM FINAL FORM
+	0xfd7d: {0x0641, 0x062e, 0x0645},                                                                                                                         // ARABIC LIGATURE FEH WITH KHAH WITH MEEM INITIAL FORM
+	0xfd7e: {0x0642, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE QAF WITH MEEM WITH HAH FINAL FORM
+	0xfd7f: {0x0642, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE QAF WITH MEEM WITH MEEM FINAL FORM
+	0xfd80: {0x0644, 0x062d, 0x0645},                                                                                                                         // ARABIC LIGATURE LAM WITH HAH WITH MEEM FINAL FORM
+	0xfd81: {0x0644, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE LAM WITH HAH WITH YEH FINAL FORM
+	0xfd82: {0x0644, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE LAM WITH HAH WITH ALEF MAKSURA FINAL FORM
+	0xfd83: {0x0644, 0x062c, 0x062c},                                                                                                                         // ARABIC LIGATURE LAM WITH JEEM WITH JEEM INITIAL FORM
+	0xfd84: {0x0644, 0x062c, 0x062c},                                                                                                                         // ARABIC LIGATURE LAM WITH JEEM WITH JEEM FINAL FORM
+	0xfd85: {0x0644, 0x062e, 0x0645},                                                                                                                         // ARABIC LIGATURE LAM WITH KHAH WITH MEEM FINAL FORM
+	0xfd86: {0x0644, 0x062e, 0x0645},                                                                                                                         // ARABIC LIGATURE LAM WITH KHAH WITH MEEM INITIAL FORM
+	0xfd87: {0x0644, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE LAM WITH MEEM WITH HAH FINAL FORM
+	0xfd88: {0x0644, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE LAM WITH MEEM WITH HAH INITIAL FORM
+	0xfd89: {0x0645, 0x062d, 0x062c},                                                                                                                         // ARABIC LIGATURE MEEM WITH HAH WITH JEEM INITIAL FORM
+	0xfd8a: {0x0645, 0x062d, 0x0645},                                                                                                                         // ARABIC LIGATURE MEEM WITH HAH WITH MEEM INITIAL FORM
+	0xfd8b: {0x0645, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE MEEM WITH HAH WITH YEH FINAL FORM
+	0xfd8c: {0x0645, 0x062c, 0x062d},                                                                                                                         // ARABIC LIGATURE MEEM WITH JEEM WITH HAH INITIAL FORM
+	0xfd8d: {0x0645, 0x062c, 0x0645},                                                                                                                         // ARABIC LIGATURE MEEM WITH JEEM WITH MEEM INITIAL FORM
+	0xfd8e: {0x0645, 0x062e, 0x062c},                                                                                                                         // ARABIC LIGATURE MEEM WITH KHAH WITH JEEM INITIAL FORM
+	0xfd8f: {0x0645, 0x062e, 0x0645},                                                                                                                         // ARABIC LIGATURE MEEM WITH KHAH WITH MEEM INITIAL FORM
+	0xfd92: {0x0645, 0x062c, 0x062e},                                                                                                                         // ARABIC LIGATURE MEEM WITH JEEM WITH KHAH INITIAL FORM
+	0xfd93: {0x006f, 0x0645, 0x062c},                                                                                                                         // ARABIC LIGATURE HEH WITH MEEM WITH JEEM INITIAL FORM
+	0xfd94: {0x006f, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE HEH WITH MEEM WITH MEEM INITIAL FORM
+	0xfd95: {0x0646, 0x062d, 0x0645},                                                                                                                         // ARABIC LIGATURE NOON WITH HAH WITH MEEM INITIAL FORM
+	0xfd96: {0x0646, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE NOON WITH HAH WITH ALEF MAKSURA FINAL FORM
+	0xfd97: {0x0646, 0x062c, 0x0645},                                                                                                                         // ARABIC LIGATURE NOON WITH JEEM WITH MEEM FINAL FORM
+	0xfd98: {0x0646, 0x062c, 0x0645},                                                                                                                         // ARABIC LIGATURE NOON WITH JEEM WITH MEEM INITIAL FORM
+	0xfd99: {0x0646, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE NOON WITH JEEM WITH ALEF MAKSURA FINAL FORM
+	0xfd9a: {0x0646, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE NOON WITH MEEM WITH YEH FINAL FORM
+	0xfd9b: {0x0646, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE NOON WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xfd9c: {0x0649, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE YEH WITH MEEM WITH MEEM FINAL FORM
+	0xfd9d: {0x0649, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE YEH WITH MEEM WITH MEEM INITIAL FORM
+	0xfd9e: {0x0628, 0x062e, 0x0649},                                                                                                                         // ARABIC LIGATURE BEH WITH KHAH WITH YEH FINAL FORM
+	0xfd9f: {0x062a, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE TEH WITH JEEM WITH YEH FINAL FORM
+	0xfda0: {0x062a, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE TEH WITH JEEM WITH ALEF MAKSURA FINAL FORM
+	0xfda1: {0x062a, 0x062e, 0x0649},                                                                                                                         // ARABIC LIGATURE TEH WITH KHAH WITH YEH FINAL FORM
+	0xfda2: {0x062a, 0x062e, 0x0649},                                                                                                                         // ARABIC LIGATURE TEH WITH KHAH WITH ALEF MAKSURA FINAL FORM
+	0xfda3: {0x062a, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE TEH WITH MEEM WITH YEH FINAL FORM
+	0xfda4: {0x062a, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE TEH WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xfda5: {0x062c, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE JEEM WITH MEEM WITH YEH FINAL FORM
+	0xfda6: {0x062c, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE JEEM WITH HAH WITH ALEF MAKSURA FINAL FORM
+	0xfda7: {0x062c, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE JEEM WITH MEEM WITH ALEF MAKSURA FINAL FORM
+	0xfda8: {0x0633, 0x062e, 0x0649},                                                                                                                         // ARABIC LIGATURE SEEN WITH KHAH WITH ALEF MAKSURA FINAL FORM
+	0xfda9: {0x0635, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE SAD WITH HAH WITH YEH FINAL FORM
+	0xfdaa: {0x0633, 0x06db, 0x062d, 0x0649},                                                                                                                 // ARABIC LIGATURE SHEEN WITH HAH WITH YEH FINAL FORM
+	0xfdab: {0x0636, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE DAD WITH HAH WITH YEH FINAL FORM
+	0xfdac: {0x0644, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE LAM WITH JEEM WITH YEH FINAL FORM
+	0xfdad: {0x0644, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE LAM WITH MEEM WITH YEH FINAL FORM
+	0xfdae: {0x0649, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE YEH WITH HAH WITH YEH FINAL FORM
+	0xfdaf: {0x0649, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE YEH WITH JEEM WITH YEH FINAL FORM
+	0xfdb0: {0x0649, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE YEH WITH MEEM WITH YEH FINAL FORM
+	0xfdb1: {0x0645, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE MEEM WITH MEEM WITH YEH FINAL FORM
+	0xfdb2: {0x0642, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE QAF WITH MEEM WITH YEH FINAL FORM
+	0xfdb3: {0x0646, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE NOON WITH HAH WITH YEH FINAL FORM
+	0xfdb4: {0x0642, 0x0645, 0x062d},                                                                                                                         // ARABIC LIGATURE QAF WITH MEEM WITH HAH INITIAL FORM
+	0xfdb5: {0x0644, 0x062d, 0x0645},                                                                                                                         // ARABIC LIGATURE LAM WITH HAH WITH MEEM INITIAL FORM
+	0xfdb6: {0x0639, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE AIN WITH MEEM WITH YEH FINAL FORM
+	0xfdb7: {0x0643, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE KAF WITH MEEM WITH YEH FINAL FORM
+	0xfdb8: {0x0646, 0x062c, 0x062d},                                                                                                                         // ARABIC LIGATURE NOON WITH JEEM WITH HAH INITIAL FORM
+	0xfdb9: {0x0645, 0x062e, 0x0649},                                                                                                                         // ARABIC LIGATURE MEEM WITH KHAH WITH YEH FINAL FORM
+	0xfdba: {0x0644, 0x062c, 0x0645},                                                                                                                         // ARABIC LIGATURE LAM WITH JEEM WITH MEEM INITIAL FORM
+	0xfdbb: {0x0643, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE KAF WITH MEEM WITH MEEM FINAL FORM
+	0xfdbc: {0x0644, 0x062c, 0x0645},                                                                                                                         // ARABIC LIGATURE LAM WITH JEEM WITH MEEM FINAL FORM
+	0xfdbd: {0x0646, 0x062c, 0x062d},                                                                                                                         // ARABIC LIGATURE NOON WITH JEEM WITH HAH FINAL FORM
+	0xfdbe: {0x062c, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE JEEM WITH HAH WITH YEH FINAL FORM
+	0xfdbf: {0x062d, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE HAH WITH JEEM WITH YEH FINAL FORM
+	0xfdc0: {0x0645, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE MEEM WITH JEEM WITH YEH FINAL FORM
+	0xfdc1: {0x0641, 0x0645, 0x0649},                                                                                                                         // ARABIC LIGATURE FEH WITH MEEM WITH YEH FINAL FORM
+	0xfdc2: {0x0628, 0x062d, 0x0649},                                                                                                                         // ARABIC LIGATURE BEH WITH HAH WITH YEH FINAL FORM
+	0xfdc3: {0x0643, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE KAF WITH MEEM WITH MEEM INITIAL FORM
+	0xfdc4: {0x0639, 0x062c, 0x0645},                                                                                                                         // ARABIC LIGATURE AIN WITH JEEM WITH MEEM INITIAL FORM
+	0xfdc5: {0x0635, 0x0645, 0x0645},                                                                                                                         // ARABIC LIGATURE SAD WITH MEEM WITH MEEM INITIAL FORM
+	0xfdc6: {0x0633, 0x062e, 0x0649},                                                                                                                         // ARABIC LIGATURE SEEN WITH KHAH WITH YEH FINAL FORM
+	0xfdc7: {0x0646, 0x062c, 0x0649},                                                                                                                         // ARABIC LIGATURE NOON WITH JEEM WITH YEH FINAL FORM
+	0xfdf0: {0x0635, 0x0644, 0x0649},                                                                                                                         // ARABIC LIGATURE SALLA USED AS KORANIC STOP SIGN ISOLATED FORM
+	0xfdf1: {0x0642, 0x0644, 0x0649},                                                                                                                         // ARABIC LIGATURE QALA USED AS KORANIC STOP SIGN ISOLATED FORM
+	0xfdf2: {0x006c, 0x0644, 0x0644, 0x0651, 0x0670, 0x006f},                                                                                                 // ARABIC LIGATURE ALLAH ISOLATED FORM
+	0xfdf3: {0x006c, 0x0643, 0x0628, 0x0631},                                                                                                                 // ARABIC LIGATURE AKBAR ISOLATED FORM
+	0xfdf4: {0x0645, 0x062d, 0x0645, 0x062f},                                                                                                                 // ARABIC LIGATURE MOHAMMAD ISOLATED FORM
+	0xfdf5: {0x0635, 0x0644, 0x0639, 0x0645},                                                                                                                 // ARABIC LIGATURE SALAM ISOLATED FORM
+	0xfdf6: {0x0631, 0x0633, 0x0648, 0x0644},                                                                                                                 // ARABIC LIGATURE RASOUL ISOLATED FORM
+	0xfdf7: {0x0639, 0x0644, 0x0649, 0x006f},                                                                                                                 // ARABIC LIGATURE ALAYHE ISOLATED FORM
+	0xfdf8: {0x0648, 0x0633, 0x0644, 0x0645},                                                                                                                 // ARABIC LIGATURE WASALLAM ISOLATED FORM
+	0xfdf9: {0x0635, 0x0644, 0x0649},                                                                                                                         // ARABIC LIGATURE SALLA ISOLATED FORM
+	0xfdfa: {0x0635, 0x0644, 0x0649, 0x0020, 0x006c, 0x0644, 0x0644, 0x006f, 0x0020, 0x0639, 0x0644, 0x0649, 0x006f, 0x0020, 0x0648, 0x0633, 0x0644, 0x0645}, // ARABIC LIGATURE SALLALLAHOU ALAYHE WASALLAM
+	0xfdfb: {0x062c, 0x0644, 0x0020, 0x062c, 0x0644, 0x006c, 0x0644, 0x006f},                                                                                 // ARABIC LIGATURE JALLAJALALOUHOU
+	0xfdfc: {0x0631, 0x0649, 0x006c, 0x0644},                                                                                                                 // RIAL SIGN
+
+	0xfe19: {0x2d57},                 // PRESENTATION FORM FOR VERTICAL HORIZONTAL ELLIPSIS
+	0xfe30: {0x003a},                 // PRESENTATION FORM FOR VERTICAL TWO DOT LEADER
+	0xfe31: {0x2502},                 // PRESENTATION FORM FOR VERTICAL EM DASH
+	0xfe34: {0x2307},                 // PRESENTATION FORM FOR VERTICAL WAVY LOW LINE
+	0xfe35: {0x23dc},                 // PRESENTATION FORM FOR VERTICAL LEFT PARENTHESIS
+	0xfe36: {0x23dd},                 // PRESENTATION FORM FOR VERTICAL RIGHT PARENTHESIS
+	0xfe37: {0x23de},                 // PRESENTATION FORM FOR VERTICAL LEFT CURLY BRACKET
+	0xfe38: {0x23df},                 // PRESENTATION FORM FOR VERTICAL RIGHT CURLY BRACKET
+	0xfe39: {0x23e0},                 // PRESENTATION FORM FOR VERTICAL LEFT TORTOISE SHELL BRACKET
+	0xfe3a: {0x23e1},                 // PRESENTATION FORM FOR VERTICAL RIGHT TORTOISE SHELL BRACKET
+	0xfe49: {0x02c9},                 // DASHED OVERLINE
+	0xfe4a: {0x02c9},                 // CENTRELINE OVERLINE
+	0xfe4b: {0x02c9},                 // WAVY OVERLINE
+	0xfe4c: {0x02c9},                 // DOUBLE WAVY OVERLINE
+	0xfe4d: {0x005f},                 // DASHED LOW LINE
+	0xfe4e: {0x005f},                 // CENTRELINE LOW LINE
+	0xfe4f: {0x005f},                 // WAVY LOW LINE
+	0xfe58: {0x002d},                 // SMALL EM DASH
+	0xfe68: {0x005c},                 // SMALL REVERSE SOLIDUS
+	0xfe80: {0x0621},                 // ARABIC LETTER HAMZA ISOLATED FORM
+	0xfe81: {0x0627, 0x0653},         // ARABIC LETTER ALEF WITH MADDA ABOVE ISOLATED FORM
+	0xfe82: {0x0627, 0x0653},         // ARABIC LETTER ALEF WITH MADDA ABOVE FINAL FORM
+	0xfe83: {0x006c, 0x0674},         // ARABIC LETTER ALEF WITH HAMZA ABOVE ISOLATED FORM
+	0xfe84: {0x006c, 0x0674},         // ARABIC LETTER ALEF WITH HAMZA ABOVE FINAL FORM
+	0xfe85: {0x0648, 0x0674},         // ARABIC LETTER WAW WITH HAMZA ABOVE ISOLATED FORM
+	0xfe86: {0x0648, 0x0674},         // ARABIC LETTER WAW WITH HAMZA ABOVE FINAL FORM
+	0xfe87: {0x006c, 0x0655},         // ARABIC LETTER ALEF WITH HAMZA BELOW ISOLATED FORM
+	0xfe88: {0x006c, 0x0655},         // ARABIC LETTER ALEF WITH HAMZA BELOW FINAL FORM
+	0xfe89: {0x0649, 0x0674},         // ARABIC LETTER YEH WITH HAMZA ABOVE ISOLATED FORM
+	0xfe8a: {0x0649, 0x0674},         // ARABIC LETTER YEH WITH HAMZA ABOVE FINAL FORM
+	0xfe8b: {0x0649, 0x0674},         // ARABIC LETTER YEH WITH HAMZA ABOVE INITIAL FORM
+	0xfe8c: {0x0649, 0x0674},         // ARABIC LETTER YEH WITH HAMZA ABOVE MEDIAL FORM
+	0xfe8d: {0x006c},                 // ARABIC LETTER ALEF ISOLATED FORM
+	0xfe8e: {0x006c},                 // ARABIC LETTER ALEF FINAL FORM
+	0xfe8f: {0x0628},                 // ARABIC LETTER BEH ISOLATED FORM
+	0xfe90: {0x0628},                 // ARABIC LETTER BEH FINAL FORM
+	0xfe91: {0x0628},                 // ARABIC LETTER BEH INITIAL FORM
+	0xfe92: {0x0628},                 // ARABIC LETTER BEH MEDIAL FORM
+	0xfe93: {0x0629},                 // ARABIC LETTER TEH MARBUTA ISOLATED FORM
+	0xfe94: {0x0629},                 // ARABIC LETTER TEH MARBUTA FINAL FORM
+	0xfe95: {0x062a},                 // ARABIC LETTER TEH ISOLATED FORM
+	0xfe96: {0x062a},                 // ARABIC LETTER TEH FINAL FORM
+	0xfe97: {0x062a},                 // ARABIC LETTER TEH INITIAL FORM
+	0xfe98: {0x062a},                 // ARABIC LETTER TEH MEDIAL FORM
+	0xfe99: {0x0649, 0x06db},         // ARABIC LETTER THEH ISOLATED FORM
+	0xfe9a: {0x0649, 0x06db},         // ARABIC LETTER THEH FINAL FORM
+	0xfe9b: {0x0649, 0x06db},         // ARABIC LETTER THEH INITIAL FORM
+	0xfe9c: {0x0649, 0x06db},         // ARABIC LETTER THEH MEDIAL FORM
+	0xfe9d: {0x062c},                 // ARABIC LETTER JEEM ISOLATED FORM
+	0xfe9e: {0x062c},                 // ARABIC LETTER JEEM FINAL FORM
+	0xfe9f: {0x062c},                 // ARABIC LETTER JEEM INITIAL FORM
+	0xfea0: {0x062c},                 // ARABIC LETTER JEEM MEDIAL FORM
+	0xfea1: {0x062d},                 // ARABIC LETTER HAH ISOLATED FORM
+	0xfea2: {0x062d},                 // ARABIC LETTER HAH FINAL FORM
+	0xfea3: {0x062d},                 // ARABIC LETTER HAH INITIAL FORM
+	0xfea4: {0x062d},                 // ARABIC LETTER HAH MEDIAL FORM
+	0xfea5: {0x062e},                 // ARABIC LETTER KHAH ISOLATED FORM
+	0xfea6: {0x062e},                 // ARABIC LETTER KHAH FINAL FORM
+	0xfea7: {0x062e},                 // ARABIC LETTER KHAH INITIAL FORM
+	0xfea8: {0x062e},                 // ARABIC LETTER KHAH MEDIAL FORM
+	0xfea9: {0x062f},                 // ARABIC LETTER DAL ISOLATED FORM
+	0xfeaa: {0x062f},                 // ARABIC LETTER DAL FINAL FORM
+	0xfeab: {0x0630},                 // ARABIC LETTER THAL ISOLATED FORM
+	0xfeac: {0x0630},                 // ARABIC LETTER THAL FINAL FORM
+	0xfead: {0x0631},                 // ARABIC LETTER REH ISOLATED FORM
+	0xfeae: {0x0631},                 // ARABIC LETTER REH FINAL FORM
+	0xfeaf: {0x0632},                 // ARABIC LETTER ZAIN ISOLATED FORM
+	0xfeb0: {0x0632},                 // ARABIC LETTER ZAIN FINAL FORM
+	0xfeb1: {0x0633},                 // ARABIC LETTER SEEN ISOLATED FORM
+	0xfeb2: {0x0633},                 // ARABIC LETTER SEEN FINAL FORM
+	0xfeb3: {0x0633},                 // ARABIC LETTER SEEN INITIAL FORM
+	0xfeb4: {0x0633},                 // ARABIC LETTER SEEN MEDIAL FORM
+	0xfeb5: {0x0633, 0x06db},         // ARABIC LETTER SHEEN ISOLATED FORM
+	0xfeb6: {0x0633, 0x06db},         // ARABIC LETTER SHEEN FINAL FORM
+	0xfeb7: {0x0633, 0x06db},         // ARABIC LETTER SHEEN INITIAL FORM
+	0xfeb8: {0x0633, 0x06db},         // ARABIC LETTER SHEEN MEDIAL FORM
+	0xfeb9: {0x0635},                 // ARABIC LETTER SAD ISOLATED FORM
+	0xfeba: {0x0635},                 // ARABIC LETTER SAD FINAL FORM
+	0xfebb: {0x0635},                 // ARABIC LETTER SAD INITIAL FORM
+	0xfebc: {0x0635},                 // ARABIC LETTER SAD MEDIAL FORM
+	0xfebd: {0x0636},                 // ARABIC LETTER DAD ISOLATED FORM
+	0xfebe: {0x0636},                 // ARABIC LETTER DAD FINAL FORM
+	0xfebf: {0x0636},                 // ARABIC LETTER DAD INITIAL FORM
+	0xfec0: {0x0636},                 // ARABIC LETTER DAD MEDIAL FORM
+	0xfec1: {0x0637},                 // ARABIC LETTER TAH ISOLATED FORM
+	0xfec2: {0x0637},                 // ARABIC LETTER TAH FINAL FORM
+	0xfec3: {0x0637},                 // ARABIC LETTER TAH INITIAL FORM
+	0xfec4: {0x0637},                 // ARABIC LETTER TAH MEDIAL FORM
+	0xfec5: {0x0638},                 // ARABIC LETTER ZAH ISOLATED FORM
+	0xfec6: {0x0638},                 // ARABIC LETTER ZAH FINAL FORM
+	0xfec7: {0x0638},                 // ARABIC LETTER ZAH INITIAL FORM
+	0xfec8: {0x0638},                 // ARABIC LETTER ZAH MEDIAL FORM
+	0xfec9: {0x0639},                 // ARABIC LETTER AIN ISOLATED FORM
+	0xfeca: {0x0639},                 // ARABIC LETTER AIN FINAL FORM
+	0xfecb: {0x0639},                 // ARABIC LETTER AIN INITIAL FORM
+	0xfecc: {0x0639},                 // ARABIC LETTER AIN MEDIAL FORM
+	0xfecd: {0x063a},                 // ARABIC LETTER GHAIN ISOLATED FORM
+	0xfece: {0x063a},                 // ARABIC LETTER GHAIN FINAL FORM
+	0xfecf: {0x063a},                 // ARABIC LETTER GHAIN INITIAL FORM
+	0xfed0: {0x063a},                 // ARABIC LETTER GHAIN MEDIAL FORM
+	0xfed1: {0x0641},                 // ARABIC LETTER FEH ISOLATED FORM
+	0xfed2: {0x0641},                 // ARABIC LETTER FEH FINAL FORM
+	0xfed3: {0x0641},                 // ARABIC LETTER FEH INITIAL FORM
+	0xfed4: {0x0641},                 // ARABIC LETTER FEH MEDIAL FORM
+	0xfed5: {0x0642},                 // ARABIC LETTER QAF ISOLATED FORM
+	0xfed6: {0x0642},                 // ARABIC LETTER QAF FINAL FORM
+	0xfed7: {0x0642},                 // ARABIC LETTER QAF INITIAL FORM
+	0xfed8: {0x0642},                 // ARABIC LETTER QAF MEDIAL FORM
+	0xfed9: {0x0643},                 // ARABIC LETTER KAF ISOLATED FORM
+	0xfeda: {0x0643},                 // ARABIC LETTER KAF FINAL FORM
+	0xfedb: {0x0643},                 // ARABIC LETTER KAF INITIAL FORM
+	0xfedc: {0x0643},                 // ARABIC LETTER KAF MEDIAL FORM
+	0xfedd: {0x0644},                 // ARABIC LETTER LAM ISOLATED FORM
+	0xfede: {0x0644},                 // ARABIC LETTER LAM FINAL FORM
+	0xfedf: {0x0644},                 // ARABIC LETTER LAM INITIAL FORM
+	0xfee0: {0x0644},                 // ARABIC LETTER LAM MEDIAL FORM
+	0xfee1: {0x0645},                 // ARABIC LETTER MEEM ISOLATED FORM
+	0xfee2: {0x0645},                 // ARABIC LETTER MEEM FINAL FORM
+	0xfee3: {0x0645},                 // ARABIC LETTER MEEM INITIAL FORM
+	0xfee4: {0x0645},                 // ARABIC LETTER MEEM MEDIAL FORM
+	0xfee5: {0x0646},                 // ARABIC LETTER NOON ISOLATED FORM
+	0xfee6: {0x0646},                 // ARABIC LETTER NOON FINAL FORM
+	0xfee7: {0x0646},                 // ARABIC LETTER NOON INITIAL FORM
+	0xfee8: {0x0646},                 // ARABIC LETTER NOON MEDIAL FORM
+	0xfee9: {0x006f},                 // ARABIC LETTER HEH ISOLATED FORM
+	0xfeea: {0x006f},                 // ARABIC LETTER HEH FINAL FORM
+	0xfeeb: {0x006f},                 // ARABIC LETTER HEH INITIAL FORM
+	0xfeec: {0x006f},                 // ARABIC LETTER HEH MEDIAL FORM
+	0xfeed: {0x0648},                 // ARABIC LETTER WAW ISOLATED FORM
+	0xfeee: {0x0648},                 // ARABIC LETTER WAW FINAL FORM
+	0xfeef: {0x0649},                 // ARABIC LETTER ALEF MAKSURA ISOLATED FORM
+	0xfef0: {0x0649},                 // ARABIC LETTER ALEF MAKSURA FINAL FORM
+	0xfef1: {0x0649},                 // ARABIC LETTER YEH ISOLATED FORM
+	0xfef2: {0x0649},                 // ARABIC LETTER YEH FINAL FORM
+	0xfef3: {0x0649},                 // ARABIC LETTER YEH INITIAL FORM
+	0xfef4: {0x0649},                 // ARABIC LETTER YEH MEDIAL FORM
+	0xfef5: {0x0644, 0x0627, 0x0653}, // ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE ISOLATED FORM
+	0xfef6: {0x0644, 0x0627, 0x0653}, // ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE FINAL FORM
+	0xfef7: {0x0644, 0x006c, 0x0674}, // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE ISOLATED FORM
+	0xfef8: {0x0644, 0x006c, 0x0674}, // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE FINAL FORM
+	0xfef9: {0x0644, 0x006c, 0x0655}, // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA BELOW ISOLATED FORM
+	0xfefa: {0x0644, 0x006c, 0x0655}, // ARABIC LIGATURE LAM WITH ALEF WITH HAMZA BELOW FINAL FORM
+	0xfefb: {0x0644, 0x006c},         // ARABIC LIGATURE LAM WITH ALEF ISOLATED FORM
+	0xfefc: {0x0644, 0x006c},         // ARABIC LIGATURE LAM WITH ALEF FINAL FORM
+
+	0xff01: {0x0021},         // FULLWIDTH EXCLAMATION MARK
+	0xff02: {0x0027, 0x0027}, // FULLWIDTH QUOTATION MARK
+	0xff07: {0x0027},         // FULLWIDTH APOSTROPHE
+	0xff0d: {0x30fc},         // FULLWIDTH HYPHEN-MINUS
+	0xff1a: {0x003a},         // FULLWIDTH COLON
+	0xff21: {0x0041},         // FULLWIDTH LATIN CAPITAL LETTER A
+	0xff22: {0x0042},         // FULLWIDTH LATIN CAPITAL LETTER B
+	0xff23: {0x0043},         // FULLWIDTH LATIN CAPITAL LETTER C
+	0xff25: {0x0045},         // FULLWIDTH LATIN CAPITAL LETTER E
+	0xff28: {0x0048},         // FULLWIDTH LATIN CAPITAL LETTER H
+	0xff29: {0x006c},         // FULLWIDTH LATIN CAPITAL LETTER I
+	0xff2a: {0x004a},         // FULLWIDTH LATIN CAPITAL LETTER J
+	0xff2b: {0x004b},         // FULLWIDTH LATIN CAPITAL LETTER K
+	0xff2d: {0x004d},         // FULLWIDTH LATIN CAPITAL LETTER M
+	0xff2e: {0x004e},         // FULLWIDTH LATIN CAPITAL LETTER N
+	0xff2f: {0x004f},         // FULLWIDTH LATIN CAPITAL LETTER O
+	0xff30: {0x0050},         // FULLWIDTH LATIN CAPITAL LETTER P
+	0xff33: {0x0053},         // FULLWIDTH LATIN CAPITAL LETTER S
+	0xff34: {0x0054},         // FULLWIDTH LATIN CAPITAL LETTER T
+	0xff38: {0x0058},         // FULLWIDTH LATIN CAPITAL LETTER X
+	0xff39: {0x0059},         // FULLWIDTH LATIN CAPITAL LETTER Y
+	0xff3a: {0x005a},         // FULLWIDTH LATIN CAPITAL LETTER Z
+	0xff3b: {0x0028},         // FULLWIDTH LEFT SQUARE BRACKET
+	0xff3c: {0x005c},         // FULLWIDTH REVERSE SOLIDUS
+	0xff3d: {0x0029},         // FULLWIDTH RIGHT SQUARE BRACKET
+	0xff3e: {0xfe3f},         // FULLWIDTH CIRCUMFLEX ACCENT
+	0xff40: {0x0027},         // FULLWIDTH GRAVE ACCENT
+	0xff41: {0x0061},         // FULLWIDTH LATIN SMALL LETTER A
+	0xff43: {0x0063},         // FULLWIDTH LATIN SMALL LETTER C
+	0xff45: {0x0065},         // FULLWIDTH LATIN SMALL LETTER E
+	0xff47: {0x0067},         // FULLWIDTH LATIN SMALL LETTER G
+	0xff48: {0x0068},         // FULLWIDTH LATIN SMALL LETTER H
+	0xff49: {0x0069},         // FULLWIDTH LATIN SMALL LETTER I
+	0xff4a: {0x006a},         // FULLWIDTH LATIN SMALL LETTER J
+	0xff4c: {0x006c},         // FULLWIDTH LATIN SMALL LETTER L
+	0xff4f: {0x006f},         // FULLWIDTH LATIN SMALL LETTER O
+	0xff50: {0x0070},         // FULLWIDTH LATIN SMALL LETTER P
+	0xff53: {0x0073},         // FULLWIDTH LATIN SMALL LETTER S
+	0xff56: {0x0076},         // FULLWIDTH LATIN SMALL LETTER V
+	0xff58: {0x0078},         // FULLWIDTH LATIN SMALL LETTER X
+	0xff59: {0x0079},         // FULLWIDTH LATIN SMALL LETTER Y
+	0xff5c: {0x2502},         // FULLWIDTH VERTICAL LINE
+	0xff5e: {0x301c},         // FULLWIDTH TILDE
+	0xff65: {0x00b7},         // HALFWIDTH KATAKANA MIDDLE DOT
+	0xffe3: {0x02c9},         // FULLWIDTH MACRON
+	0xffe8: {0x006c},         // HALFWIDTH FORMS LIGHT VERTICAL
+	0xffed: {0x25aa},         // HALFWIDTH BLACK SQUARE
+
+	0x10101: {0x00b7},                                         // AEGEAN WORD SEPARATOR DOT
+	0x1018e: {0x004e, 0x030a},                                 // NOMISMA SIGN
+	0x10196: {0x0058, 0x0335},                                 // ROMAN DENARIUS SIGN
+	0x10197: {0x0056, 0x0335},                                 // ROMAN QUINARIUS SIGN
+	0x10198: {0x006c, 0x0335, 0x006c, 0x0335, 0x0053, 0x0335}, // ROMAN SESTERTIUS SIGN
+	0x10199: {0x006c, 0x0335, 0x006c, 0x0335},                 // ROMAN DUPONDIUS SIGN
+	0x101a0: {0x2ce8},                                         // GREEK SYMBOL TAU RHO
+
+	0x10282: {0x0042}, // LYCIAN LETTER B
+	0x10285: {0x0394}, // LYCIAN LETTER D
+	0x10286: {0x0045}, // LYCIAN LETTER I
+	0x10287: {0x0046}, // LYCIAN LETTER W
+	0x1028a: {0x006c}, // LYCIAN LETTER J
+	0x1028d: {0x0245}, // LYCIAN LETTER L
+	0x10290: {0x0058}, // LYCIAN LETTER MM
+	0x10292: {0x004f}, // LYCIAN LETTER U
+	0x10294: {0x16dc}, // LYCIAN LETTER KK
+	0x10295: {0x0050}, // LYCIAN LETTER R
+	0x10296: {0x0053}, // LYCIAN LETTER S
+	0x10297: {0x0054}, // LYCIAN LETTER T
+	0x1029b: {0x002b}, // LYCIAN LETTER H
+	0x102a0: {0x0041}, // CARIAN LETTER A
+	0x102a1: {0x0042}, // CARIAN LETTER P2
+	0x102a2: {0x0043}, // CARIAN LETTER D
+	0x102a3: {0x0394}, // CARIAN LETTER L
+	0x102a5: {0x0046}, // CARIAN LETTER R
+	0x102ab: {0x004f}, // CARIAN LETTER O
+	0x102ad: {0x03d8}, // CARIAN LETTER T
+	0x102b0: {0x004d}, // CARIAN LETTER S
+	0x102b1: {0x0054}, // CARIAN LETTER C-18
+	0x102b2: {0x0059}, // CARIAN LETTER U
+	0x102b3: {0x03a6}, // CARIAN LETTER NN
+	0x102b4: {0x0058}, // CARIAN LETTER X
+	0x102b5: {0x03a8}, // CARIAN LETTER N
+	0x102b6: {0x03a9}, // CARIAN LETTER TT2
+	0x102b8: {0x2d40}, // CARIAN LETTER SS
+	0x102cf: {0x0048}, // CARIAN LETTER E2
+	0x102e1: {0x062f}, // COPTIC EPACT DIGIT ONE
+	0x102e4: {0x0648}, // COPTIC EPACT DIGIT FOUR
+	0x102e8: {0x0637}, // COPTIC EPACT DIGIT EIGHT
+	0x102f2: {0x0635}, // COPTIC EPACT NUMBER NINETY
+	0x102f5: {0x005a}, // COPTIC EPACT NUMBER THREE HUNDRED
+
+	0x10301: {0x0042},  // OLD ITALIC LETTER BE
+	0x10302: {0x0043},  // OLD ITALIC LETTER KE
+	0x10309: {0x006c},  // OLD ITALIC LETTER I
+	0x10311: {0x004d},  // OLD ITALIC LETTER SHE
+	0x10312: {0x03d8},  // OLD ITALIC LETTER KU
+	0x10315: {0x0054},  // OLD ITALIC LETTER TE
+	0x10317: {0x0058},  // OLD ITALIC LETTER EKS
+	0x1031a: {0x0038},  // OLD ITALIC LETTER EF
+	0x1031f: {0x002a},  // OLD ITALIC LETTER ESS
+	0x10320: {0x006c},  // OLD ITALIC NUMERAL ONE
+	0x10322: {0x0058},  // OLD ITALIC NUMERAL TEN
+	0x103d1: {0x10382}, // OLD PERSIAN NUMBER ONE
+	0x103d3: {0x10393}, // OLD PERSIAN NUMBER TEN
+
+	0x10401: {0x0190},  // DESERET CAPITAL LETTER LONG E
+	0x10404: {0x004f},  // DESERET CAPITAL LETTER LONG O
+	0x10411: {0xa4f6},  // DESERET CAPITAL LETTER PEE
+	0x10415: {0x0043},  // DESERET CAPITAL LETTER CHEE
+	0x1041b: {0x004c},  // DESERET CAPITAL LETTER ETH
+	0x1041f: {0x2c70},  // DESERET CAPITAL LETTER ESH
+	0x10420: {0x0053},  // DESERET CAPITAL LETTER ZHEE
+	0x10423: {0x0186},  // DESERET CAPITAL LETTER EM
+	0x10425: {0x0418},  // DESERET CAPITAL LETTER ENG
+	0x10429: {0xa793},  // DESERET SMALL LETTER LONG E
+	0x1042a: {0x029a},  // DESERET SMALL LETTER LONG A
+	0x1042c: {0x006f},  // DESERET SMALL LETTER LONG O
+	0x1043d: {0x0063},  // DESERET SMALL LETTER CHEE
+	0x1043f: {0x0277},  // DESERET SMALL LETTER KAY
+	0x10442: {0x025e},  // DESERET SMALL LETTER VEE
+	0x10443: {0x029f},  // DESERET SMALL LETTER ETH
+	0x10448: {0x0073},  // DESERET SMALL LETTER ZHEE
+	0x1044b: {0x0254},  // DESERET SMALL LETTER EM
+	0x1044d: {0x1d0e},  // DESERET SMALL LETTER ENG
+	0x104a0: {0x10486}, // OSMANYA DIGIT ZERO
+	0x104b0: {0x0245},  // OSAGE CAPITAL LETTER A
+	0x104b4: {0x0052},  // OSAGE CAPITAL LETTER BRA
+	0x104bc: {0x04c3},  // OSAGE CAPITAL LETTER KA
+	0x104c2: {0x004f},  // OSAGE CAPITAL LETTER O
+	0x104c3: {0x0298},  // OSAGE CAPITAL LETTER OIN
+	0x104c4: {0x00de},  // OSAGE CAPITAL LETTER PA
+	0x104cd: {0x040b},  // OSAGE CAPITAL LETTER DHA
+	0x104ce: {0x0055},  // OSAGE CAPITAL LETTER U
+	0x104d0: {0x16e6},  // OSAGE CAPITAL LETTER KHA
+	0x104d1: {0x03a8},  // OSAGE CAPITAL LETTER GHA
+	0x104d2: {0x0037},  // OSAGE CAPITAL LETTER ZA
+	0x104d8: {0x028c},  // OSAGE SMALL LETTER A
+	0x104db: {0x03bb},  // OSAGE SMALL LETTER AH
+	0x104ea: {0x006f},  // OSAGE SMALL LETTER O
+	0x104eb: {0xa669},  // OSAGE SMALL LETTER OIN
+	0x104f6: {0x0075},  // OSAGE SMALL LETTER U
+	0x104f9: {0x03c8},  // OSAGE SMALL LETTER GHA
+
+	0x10513: {0x004e}, // ELBASAN LETTER NE
+	0x10516: {0x004f}, // ELBASAN LETTER O
+	0x10518: {0x004b}, // ELBASAN LETTER QE
+	0x1051c: {0x0043}, // ELBASAN LETTER SHE
+	0x1051d: {0x0056}, // ELBASAN LETTER TE
+	0x10525: {0x0046}, // ELBASAN LETTER GHE
+	0x10526: {0x004c}, // ELBASAN LETTER GHAMMA
+	0x10527: {0x0058}, // ELBASAN LETTER KHE
+
+	0x10a3a: {0x0323},           // KHAROSHTHI SIGN DOT BELOW
+	0x10a50: {0x002e},           // KHAROSHTHI PUNCTUATION DOT
+	0x10a57: {0x10a56, 0x10a56}, // KHAROSHTHI PUNCTUATION DOUBLE DANDA
+
+	0x10cfa: {0x10ca5}, // OLD HUNGARIAN NUMBER ONE
+	0x10cfc: {0x10c82}, // OLD HUNGARIAN NUMBER TEN
+
+	0x110bb: {0x0970}, // KAITHI ABBREVIATION SIGN
+
+	0x111c7: {0x0970}, // SHARADA ABBREVIATION SIGN
+	0x111ca: {0x0323}, // SHARADA SIGN NUKTA
+	0x111cb: {0x093a}, // SHARADA VOWEL MODIFIER MARK
+	0x111db: {0xa8fc}, // SHARADA SIGN SIDDHAM
+	0x111dc: {0xa8fb}, // SHARADA HEADSTROKE
+	0x111de: {0x2248}, // SHARADA SECTION MARK-1
+
+	0x11300: {0x030a}, // GRANTHA SIGN COMBINING ANUSVARA ABOVE
+
+	0x11413: {0x11434, 0x11442, 0x11412}, // NEWA LETTER NGHA
+	0x11419: {0x11434, 0x11442, 0x11418}, // NEWA LETTER NYHA
+	0x11424: {0x11434, 0x11442, 0x11423}, // NEWA LETTER NHA
+	0x1142a: {0x11434, 0x11442, 0x11429}, // NEWA LETTER MHA
+	0x1142d: {0x11434, 0x11442, 0x1142c}, // NEWA LETTER RHA
+	0x1142f: {0x11434, 0x11442, 0x1142e}, // NEWA LETTER LHA
+	0x1144c: {0x1144b, 0x1144b},          // NEWA DOUBLE DANDA
+	0x11492: {0x0998},                    // TIRHUTA LETTER GHA
+	0x11494: {0x099a},                    // TIRHUTA LETTER CA
+	0x11496: {0x099c},                    // TIRHUTA LETTER JA
+	0x11498: {0x099e},                    // TIRHUTA LETTER NYA
+	0x11499: {0x099f},                    // TIRHUTA LETTER TTA
+	0x1149b: {0x09a1},                    // TIRHUTA LETTER DDA
+	0x1149d: {0x09b2},                    // TIRHUTA LETTER NNA
+	0x1149e: {0x09a4},                    // TIRHUTA LETTER TA
+	0x1149f: {0x09a5},                    // TIRHUTA LETTER THA
+	0x114a0: {0x09a6},                    // TIRHUTA LETTER DA
+	0x114a1: {0x09a7},                    // TIRHUTA LETTER DHA
+	0x114a2: {0x09a8},                    // TIRHUTA LETTER NA
+	0x114a3: {0x09aa},                    // TIRHUTA LETTER PA
+	0x114a7: {0x09ae},                    // TIRHUTA LETTER MA
+	0x114a8: {0x09af},                    // TIRHUTA LETTER YA
+	0x114a9: {0x09ac},                    // TIRHUTA LETTER RA
+	0x114aa: {0x09a3},                    // TIRHUTA LETTER LA
+	0x114ab: {0x09b0},                    // TIRHUTA LETTER VA
+	0x114ad: {0x09b7},                    // TIRHUTA LETTER SSA
+	0x114ae: {0x09b8},                    // TIRHUTA LETTER SA
+	0x114b0: {0x09be},                    // TIRHUTA VOWEL SIGN AA
+	0x114b1: {0x09bf},                    // TIRHUTA VOWEL SIGN I
+	0x114b9: {0x09c7},                    // TIRHUTA VOWEL SIGN E
+	0x114bd: {0x09d7},                    // TIRHUTA VOWEL SIGN SHORT O
+	0x114bf: {0x0306, 0x0307},            // TIRHUTA SIGN CANDRABINDU
+	0x114c1: {0x0983},                    // TIRHUTA SIGN VISARGA
+	0x114c2: {0x09cd},                    // TIRHUTA SIGN VIRAMA
+	0x114c3: {0x0323},                    // TIRHUTA SIGN NUKTA
+	0x114c4: {0x09bd},                    // TIRHUTA SIGN AVAGRAHA
+	0x114c5: {0x0077, 0x0307},            // TIRHUTA GVANG
+	0x114d0: {0x004f},                    // TIRHUTA DIGIT ZERO
+	0x114d1: {0x09e7},                    // TIRHUTA DIGIT ONE
+	0x114d2: {0x09e8},                    // TIRHUTA DIGIT TWO
+	0x114d6: {0x09ec},                    // TIRHUTA DIGIT SIX
+
+	0x115d8: {0x11582}, // SIDDHAM LETTER THREE-CIRCLE ALTERNATE I
+	0x115d9: {0x11582}, // SIDDHAM LETTER TWO-CIRCLE ALTERNATE I
+	0x115da: {0x11583}, // SIDDHAM LETTER TWO-CIRCLE ALTERNATE II
+	0x115db: {0x11584}, // SIDDHAM LETTER ALTERNATE U
+	0x115dc: {0x115b2}, // SIDDHAM VOWEL SIGN ALTERNATE U
+	0x115dd: {0x115b3}, // SIDDHAM VOWEL SIGN ALTERNATE UU
+
+	0x11642: {0x11641, 0x11641}, // MODI DOUBLE DANDA
+
+	0x11700: {0x0072, 0x006e}, // AHOM LETTER KA
+	0x11706: {0x0076},         // AHOM LETTER PA
+	0x1170a: {0x0077},         // AHOM LETTER JA
+	0x1170e: {0x0077},         // AHOM LETTER LA
+	0x1170f: {0x0077},         // AHOM LETTER SA
+
+	0x118a0: {0x0056},         // WARANG CITI CAPITAL LETTER NGAA
+	0x118a2: {0x0046},         // WARANG CITI CAPITAL LETTER WI
+	0x118a3: {0x004c},         // WARANG CITI CAPITAL LETTER YU
+	0x118a4: {0x0059},         // WARANG CITI CAPITAL LETTER YA
+	0x118a6: {0x0045},         // WARANG CITI CAPITAL LETTER II
+	0x118a8: {0x2207},         // WARANG CITI CAPITAL LETTER E
+	0x118a9: {0x005a},         // WARANG CITI CAPITAL LETTER O
+	0x118ac: {0x0039},         // WARANG CITI CAPITAL LETTER KO
+	0x118ae: {0x0045},         // WARANG CITI CAPITAL LETTER YUJ
+	0x118af: {0x0034},         // WARANG CITI CAPITAL LETTER UC
+	0x118b2: {0x004c},         // WARANG CITI CAPITAL LETTER TTE
+	0x118b5: {0x004f},         // WARANG CITI CAPITAL LETTER AT
+	0x118b7: {0x16dc},         // WARANG CITI CAPITAL LETTER BU
+	0x118b8: {0x0055},         // WARANG CITI CAPITAL LETTER PU
+	0x118bb: {0x0035},         // WARANG CITI CAPITAL LETTER HORR
+	0x118bc: {0x0054},         // WARANG CITI CAPITAL LETTER HAR
+	0x118c0: {0x0076},         // WARANG CITI SMALL LETTER NGAA
+	0x118c1: {0x0073},         // WARANG CITI SMALL LETTER A
+	0x118c2: {0x0046},         // WARANG CITI SMALL LETTER WI
+	0x118c3: {0x0069},         // WARANG CITI SMALL LETTER YU
+	0x118c4: {0x007a},         // WARANG CITI SMALL LETTER YA
+	0x118c6: {0x0037},         // WARANG CITI SMALL LETTER II
+	0x118c8: {0x006f},         // WARANG CITI SMALL LETTER E
+	0x118ca: {0x0033},         // WARANG CITI SMALL LETTER ANG
+	0x118cc: {0x0039},         // WARANG CITI SMALL LETTER KO
+	0x118ce: {0xa793},         // WARANG CITI SMALL LETTER YUJ
+	0x118d5: {0x0036},         // WARANG CITI SMALL LETTER AT
+	0x118d6: {0x0039},         // WARANG CITI SMALL LETTER AM
+	0x118d7: {0x006f},         // WARANG CITI SMALL LETTER BU
+	0x118d8: {0x0075},         // WARANG CITI SMALL LETTER PU
+	0x118dc: {0x0079},         // WARANG CITI SMALL LETTER HAR
+	0x118e0: {0x004f},         // WARANG CITI DIGIT ZERO
+	0x118e3: {0x0072, 0x006e}, // WARANG CITI DIGIT THREE
+	0x118e4: {0x0669},         // WARANG CITI DIGIT FOUR
+	0x118e5: {0x005a},         // WARANG CITI DIGIT FIVE
+	0x118e6: {0x0057},         // WARANG CITI DIGIT SIX
+	0x118e9: {0x0043},         // WARANG CITI DIGIT NINE
+	0x118ec: {0x0058},         // WARANG CITI NUMBER THIRTY
+	0x118ef: {0x0057},         // WARANG CITI NUMBER SIXTY
+	0x118f2: {0x0043},         // WARANG CITI NUMBER NINETY
+
+	0x11ae6: {0x11ae5, 0x11aef},          // PAU CIN HAU RISING TONE
+	0x11ae7: {0x11ae5, 0x11af0},          // PAU CIN HAU SANDHI GLOTTAL STOP
+	0x11ae8: {0x11ae5, 0x11ae5},          // PAU CIN HAU RISING TONE LONG FINAL
+	0x11ae9: {0x11ae5, 0x11ae5, 0x11aef}, // PAU CIN HAU RISING TONE FINAL
+	0x11aea: {0x11ae5, 0x11ae5, 0x11af0}, // PAU CIN HAU SANDHI GLOTTAL STOP FINAL
+	0x11aec: {0x11aeb, 0x11aef},          // PAU CIN HAU SANDHI TONE
+	0x11aed: {0x11aeb, 0x11aeb},          // PAU CIN HAU SANDHI TONE LONG FINAL
+	0x11aee: {0x11aeb, 0x11aeb, 0x11aef}, // PAU CIN HAU SANDHI TONE FINAL
+	0x11af4: {0x11af3, 0x11aef},          // PAU CIN HAU LOW-FALLING TONE
+	0x11af5: {0x11af3, 0x11af0},          // PAU CIN HAU GLOTTAL STOP
+	0x11af6: {0x11af3, 0x11af3},          // PAU CIN HAU LOW-FALLING TONE LONG FINAL
+	0x11af7: {0x11af3, 0x11af3, 0x11aef}, // PAU CIN HAU LOW-FALLING TONE FINAL
+	0x11af8: {0x11af3, 0x11af3, 0x11af0}, // PAU CIN HAU GLOTTAL STOP FINAL
+
+	0x11c42: {0x11c41, 0x11c41}, // BHAIKSUKI DOUBLE DANDA
+	0x11cb2: {0x11caa},          // MARCHEN VOWEL SIGN U
+
+	0x12038: {0x1039a}, // CUNEIFORM SIGN ASH
+
+	0x132f9: {0x1099e}, // EGYPTIAN HIEROGLYPH S034
+
+	0x16f07: {0x0393}, // MIAO LETTER FA
+	0x16f08: {0x0056}, // MIAO LETTER VA
+	0x16f0a: {0x0054}, // MIAO LETTER TA
+	0x16f16: {0x004c}, // MIAO LETTER LA
+	0x16f1a: {0x0394}, // MIAO LETTER TLHA
+	0x16f1c: {0xa658}, // MIAO LETTER TLHYA
+	0x16f26: {0xa4f6}, // MIAO LETTER HA
+	0x16f28: {0x006c}, // MIAO LETTER GHA
+	0x16f2d: {0x0190}, // MIAO LETTER NYHA
+	0x16f35: {0x0052}, // MIAO LETTER ZHA
+	0x16f3a: {0x0053}, // MIAO LETTER SA
+	0x16f3b: {0x0033}, // MIAO LETTER ZA
+	0x16f3d: {0x0245}, // MIAO LETTER ZZA
+	0x16f3f: {0x003e}, // MIAO LETTER ARCHAIC ZZA
+	0x16f40: {0x0041}, // MIAO LETTER ZZYA
+	0x16f42: {0x0055}, // MIAO LETTER WA
+	0x16f43: {0x0059}, // MIAO LETTER AH
+	0x16f51: {0x0027}, // MIAO SIGN ASPIRATION
+	0x16f52: {0x0027}, // MIAO SIGN REFORMED VOICING
+
+	0x1d114: {0x007b}, // MUSICAL SYMBOL BRACE
+	0x1d16d: {0x002e}, // MUSICAL SYMBOL COMBINING AUGMENTATION DOT
+
+	0x1d202: {0x04fe},         // GREEK VOCAL NOTATION SYMBOL-3
+	0x1d206: {0x0033},         // GREEK VOCAL NOTATION SYMBOL-7
+	0x1d20b: {0x0418},         // GREEK VOCAL NOTATION SYMBOL-12
+	0x1d20d: {0x0056},         // GREEK VOCAL NOTATION SYMBOL-14
+	0x1d20f: {0x005c},         // GREEK VOCAL NOTATION SYMBOL-16
+	0x1d212: {0x0037},         // GREEK VOCAL NOTATION SYMBOL-19
+	0x1d213: {0x0046},         // GREEK VOCAL NOTATION SYMBOL-20
+	0x1d214: {0x102bc},        // GREEK VOCAL NOTATION SYMBOL-21
+	0x1d215: {0xa4f6},         // GREEK VOCAL NOTATION SYMBOL-22
+	0x1d216: {0x0052},         // GREEK VOCAL NOTATION SYMBOL-23
+	0x1d217: {0x2c6f},         // GREEK VOCAL NOTATION SYMBOL-24
+	0x1d21a: {0x004f, 0x0335}, // GREEK VOCAL NOTATION SYMBOL-52
+	0x1d21b: {0x2144},         // GREEK VOCAL NOTATION SYMBOL-53
+	0x1d21c: {0xa4d5},         // GREEK VOCAL NOTATION SYMBOL-54
+	0x1d221: {0x0190},         // GREEK INSTRUMENTAL NOTATION SYMBOL-7
+	0x1d222: {0x0460},         // GREEK INSTRUMENTAL NOTATION SYMBOL-8
+	0x1d22a: {0x004c},         // GREEK INSTRUMENTAL NOTATION SYMBOL-23
+	0x1d22b: {0xa4f6},         // GREEK INSTRUMENTAL NOTATION SYMBOL-24
+	0x1d230: {0xa7fb},         // GREEK INSTRUMENTAL NOTATION SYMBOL-30
+	0x1d236: {0x003c},         // GREEK INSTRUMENTAL NOTATION SYMBOL-40
+	0x1d237: {0x003e},         // GREEK INSTRUMENTAL NOTATION SYMBOL-42
+	0x1d238: {0x228f},         // GREEK INSTRUMENTAL NOTATION SYMBOL-43
+	0x1d239: {0x2290},         // GREEK INSTRUMENTAL NOTATION SYMBOL-45
+	0x1d23a: {0x002f},         // GREEK INSTRUMENTAL NOTATION SYMBOL-47
+	0x1d23b: {0x005c},         // GREEK INSTRUMENTAL NOTATION SYMBOL-48
+	0x1d23f: {0x16cb},         // GREEK INSTRUMENTAL NOTATION SYMBOL-52
+	0x1d245: {0x0548},         // GREEK MUSICAL LEIMMA
+
+	0x1d400: {0x0041},         // MATHEMATICAL BOLD CAPITAL A
+	0x1d401: {0x0042},         // MATHEMATICAL BOLD CAPITAL B
+	0x1d402: {0x0043},         // MATHEMATICAL BOLD CAPITAL C
+	0x1d403: {0x0044},         // MATHEMATICAL BOLD CAPITAL D
+	0x1d404: {0x0045},         // MATHEMATICAL BOLD CAPITAL E
+	0x1d405: {0x0046},         // MATHEMATICAL BOLD CAPITAL F
+	0x1d406: {0x0047},         // MATHEMATICAL BOLD CAPITAL G
+	0x1d407: {0x0048},         // MATHEMATICAL BOLD CAPITAL H
+	0x1d408: {0x006c},         // MATHEMATICAL BOLD CAPITAL I
+	0x1d409: {0x004a},         // MATHEMATICAL BOLD CAPITAL J
+	0x1d40a: {0x004b},         // MATHEMATICAL BOLD CAPITAL K
+	0x1d40b: {0x004c},         // MATHEMATICAL BOLD CAPITAL L
+	0x1d40c: {0x004d},         // MATHEMATICAL BOLD CAPITAL M
+	0x1d40d: {0x004e},         // MATHEMATICAL BOLD CAPITAL N
+	0x1d40e: {0x004f},         // MATHEMATICAL BOLD CAPITAL O
+	0x1d40f: {0x0050},         // MATHEMATICAL BOLD CAPITAL P
+	0x1d410: {0x0051},         // MATHEMATICAL BOLD CAPITAL Q
+	0x1d411: {0x0052},         // MATHEMATICAL BOLD CAPITAL R
+	0x1d412: {0x0053},         // MATHEMATICAL BOLD CAPITAL S
+	0x1d413: {0x0054},         // MATHEMATICAL BOLD CAPITAL T
+	0x1d414: {0x0055},         // MATHEMATICAL BOLD CAPITAL U
+	0x1d415: {0x0056},         // MATHEMATICAL BOLD CAPITAL V
+	0x1d416: {0x0057},         // MATHEMATICAL BOLD CAPITAL W
+	0x1d417: {0x0058},         // MATHEMATICAL BOLD CAPITAL X
+	0x1d418: {0x0059},         // MATHEMATICAL BOLD CAPITAL Y
+	0x1d419: {0x005a},         // MATHEMATICAL BOLD CAPITAL Z
+	0x1d41a: {0x0061},         // MATHEMATICAL BOLD SMALL A
+	0x1d41b: {0x0062},         // MATHEMATICAL BOLD SMALL B
+	0x1d41c: {0x0063},         // MATHEMATICAL BOLD SMALL C
+	0x1d41d: {0x0064},         // MATHEMATICAL BOLD SMALL D
+	0x1d41e: {0x0065},         // MATHEMATICAL BOLD SMALL E
+	0x1d41f: {0x0066},         // MATHEMATICAL BOLD SMALL F
+	0x1d420: {0x0067},         // MATHEMATICAL BOLD SMALL G
+	0x1d421: {0x0068},         // MATHEMATICAL BOLD SMALL H
+	0x1d422: {0x0069},         // MATHEMATICAL BOLD SMALL I
+	0x1d423: {0x006a},         // MATHEMATICAL BOLD SMALL J
+	0x1d424: {0x006b},         // MATHEMATICAL BOLD SMALL K
+	0x1d425: {0x006c},         // MATHEMATICAL BOLD SMALL L
+	0x1d426: {0x0072, 0x006e}, // MATHEMATICAL BOLD SMALL M
+	0x1d427: {0x006e},         // MATHEMATICAL BOLD SMALL N
+	0x1d428: {0x006f},         // MATHEMATICAL BOLD SMALL O
+	0x1d429: {0x0070},         // MATHEMATICAL BOLD SMALL P
+	0x1d42a: {0x0071},         // MATHEMATICAL BOLD SMALL Q
+	0x1d42b: {0x0072},         // MATHEMATICAL BOLD SMALL R
+	0x1d42c: {0x0073},         // MATHEMATICAL BOLD SMALL S
+	0x1d42d: {0x0074},         // MATHEMATICAL BOLD SMALL T
+	0x1d42e: {0x0075},         // MATHEMATICAL BOLD SMALL U
+	0x1d42f: {0x0076},         // MATHEMATICAL BOLD SMALL V
+	0x1d430: {0x0077},         // MATHEMATICAL BOLD SMALL W
+	0x1d431: {0x0078},         // MATHEMATICAL BOLD SMALL X
+	0x1d432: {0x0079},         // MATHEMATICAL BOLD SMALL Y
+	0x1d433: {0x007a},         // MATHEMATICAL BOLD SMALL Z
+	0x1d434: {0x0041},         // MATHEMATICAL ITALIC CAPITAL A
+	0x1d435: {0x0042},         // MATHEMATICAL ITALIC CAPITAL B
+	0x1d436: {0x0043},         // MATHEMATICAL ITALIC CAPITAL C
+	0x1d437: {0x0044},         // MATHEMATICAL ITALIC CAPITAL D
+	0x1d438: {0x0045},         // MATHEMATICAL ITALIC CAPITAL E
+	0x1d439: {0x0046},         // MATHEMATICAL ITALIC CAPITAL F
+	0x1d43a: {0x0047},         // MATHEMATICAL ITALIC CAPITAL G
+	0x1d43b: {0x0048},         // MATHEMATICAL ITALIC CAPITAL H
+	0x1d43c: {0x006c},         // MATHEMATICAL ITALIC CAPITAL I
+	0x1d43d: {0x004a},         // MATHEMATICAL ITALIC CAPITAL J
+	0x1d43e: {0x004b},         // MATHEMATICAL ITALIC CAPITAL K
+	0x1d43f: {0x004c},         // MATHEMATICAL ITALIC CAPITAL L
+	0x1d440: {0x004d},         // MATHEMATICAL ITALIC CAPITAL M
+	0x1d441: {0x004e},         // MATHEMATICAL ITALIC CAPITAL N
+	0x1d442: {0x004f},         // MATHEMATICAL ITALIC CAPITAL O
+	0x1d443: {0x0050},         // MATHEMATICAL ITALIC CAPITAL P
+	0x1d444: {0x0051},         // MATHEMATICAL ITALIC CAPITAL Q
+	0x1d445: {0x0052},         // MATHEMATICAL ITALIC CAPITAL R
+	0x1d446: {0x0053},         // MATHEMATICAL ITALIC CAPITAL S
+	0x1d447: {0x0054},         // MATHEMATICAL ITALIC CAPITAL T
+	0x1d448: {0x0055},         // MATHEMATICAL ITALIC CAPITAL U
+	0x1d449: {0x0056},         // MATHEMATICAL ITALIC CAPITAL V
+	0x1d44a: {0x0057},         // MATHEMATICAL ITALIC CAPITAL W
+	0x1d44b: {0x0058},         // MATHEMATICAL ITALIC CAPITAL X
+	0x1d44c: {0x0059},         // MATHEMATICAL ITALIC CAPITAL Y
+	0x1d44d: {0x005a},         // MATHEMATICAL ITALIC CAPITAL Z
+	0x1d44e: {0x0061},         // MATHEMATICAL ITALIC SMALL A
+	0x1d44f: {0x0062},         // MATHEMATICAL ITALIC SMALL B
+	0x1d450: {0x0063},         // MATHEMATICAL ITALIC SMALL C
+	0x1d451: {0x0064},         // MATHEMATICAL ITALIC SMALL D
+	0x1d452: {0x0065},         // MATHEMATICAL ITALIC SMALL E
+	0x1d453: {0x0066},         // MATHEMATICAL ITALIC SMALL F
+	0x1d454: {0x0067},         // MATHEMATICAL ITALIC SMALL G
+	0x1d456: {0x0069},         // MATHEMATICAL ITALIC SMALL I
+	0x1d457: {0x006a},         // MATHEMATICAL ITALIC SMALL J
+	0x1d458: {0x006b},         // MATHEMATICAL ITALIC SMALL K
+	0x1d459: {0x006c},         // MATHEMATICAL ITALIC SMALL L
+	0x1d45a: {0x0072, 0x006e}, // MATHEMATICAL ITALIC SMALL M
+	0x1d45b: {0x006e},         // MATHEMATICAL ITALIC SMALL N
+	0x1d45c: {0x006f},         // MATHEMATICAL ITALIC SMALL O
+	0x1d45d: {0x0070},         // MATHEMATICAL ITALIC SMALL P
+	0x1d45e: {0x0071},         // MATHEMATICAL ITALIC SMALL Q
+	0x1d45f: {0x0072},         // MATHEMATICAL ITALIC SMALL R
+	0x1d460: {0x0073},         // MATHEMATICAL ITALIC SMALL S
+	0x1d461: {0x0074},         // MATHEMATICAL ITALIC SMALL T
+	0x1d462: {0x0075},         // MATHEMATICAL ITALIC SMALL U
+	0x1d463: {0x0076},         // MATHEMATICAL ITALIC SMALL V
+	0x1d464: {0x0077},         // MATHEMATICAL ITALIC SMALL W
+	0x1d465: {0x0078},         // MATHEMATICAL ITALIC SMALL X
+	0x1d466: {0x0079},         // MATHEMATICAL ITALIC SMALL Y
+	0x1d467: {0x007a},         // MATHEMATICAL ITALIC SMALL Z
+	0x1d468: {0x0041},         // MATHEMATICAL BOLD ITALIC CAPITAL A
+	0x1d469: {0x0042},         // MATHEMATICAL BOLD ITALIC CAPITAL B
+	0x1d46a: {0x0043},         // MATHEMATICAL BOLD ITALIC CAPITAL C
+	0x1d46b: {0x0044},         // MATHEMATICAL BOLD ITALIC CAPITAL D
+	0x1d46c: {0x0045},         // MATHEMATICAL BOLD ITALIC CAPITAL E
+	0x1d46d: {0x0046},         // MATHEMATICAL BOLD ITALIC CAPITAL F
+	0x1d46e: {0x0047},         // MATHEMATICAL BOLD ITALIC CAPITAL G
+	0x1d46f: {0x0048},         // MATHEMATICAL BOLD ITALIC CAPITAL H
+	0x1d470: {0x006c},         // MATHEMATICAL BOLD ITALIC CAPITAL I
+	0x1d471: {0x004a},         // MATHEMATICAL BOLD ITALIC CAPITAL J
+	0x1d472: {0x004b},         // MATHEMATICAL BOLD ITALIC CAPITAL K
+	0x1d473: {0x004c},         // MATHEMATICAL BOLD ITALIC CAPITAL L
+	0x1d474: {0x004d},         // MATHEMATICAL BOLD ITALIC CAPITAL M
+	0x1d475: {0x004e},         // MATHEMATICAL BOLD ITALIC CAPITAL N
+	0x1d476: {0x004f},         // MATHEMATICAL BOLD ITALIC CAPITAL O
+	0x1d477: {0x0050},         // MATHEMATICAL BOLD ITALIC CAPITAL P
+	0x1d478: {0x0051},         // MATHEMATICAL BOLD ITALIC CAPITAL Q
+	0x1d479: {0x0052},         // MATHEMATICAL BOLD ITALIC CAPITAL R
+	0x1d47a: {0x0053},         // MATHEMATICAL BOLD ITALIC CAPITAL S
+	0x1d47b: {0x0054},         // MATHEMATICAL BOLD ITALIC CAPITAL T
+	0x1d47c: {0x0055},         // MATHEMATICAL BOLD ITALIC CAPITAL U
+	0x1d47d: {0x0056},         // MATHEMATICAL BOLD ITALIC CAPITAL V
+	0x1d47e: {0x0057},         // MATHEMATICAL BOLD ITALIC CAPITAL W
+	0x1d47f: {0x0058},         // MATHEMATICAL BOLD ITALIC CAPITAL X
+	0x1d480: {0x0059},         // MATHEMATICAL BOLD ITALIC CAPITAL Y
+	0x1d481: {0x005a},         // MATHEMATICAL BOLD ITALIC CAPITAL Z
+	0x1d482: {0x0061},         // MATHEMATICAL BOLD ITALIC SMALL A
+	0x1d483: {0x0062},         // MATHEMATICAL BOLD ITALIC SMALL B
+	0x1d484: {0x0063},         // MATHEMATICAL BOLD ITALIC SMALL C
+	0x1d485: {0x0064},         // MATHEMATICAL BOLD ITALIC SMALL D
+	0x1d486: {0x0065},         // MATHEMATICAL BOLD ITALIC SMALL E
+	0x1d487: {0x0066},         // MATHEMATICAL BOLD ITALIC SMALL F
+	0x1d488: {0x0067},         // MATHEMATICAL BOLD ITALIC SMALL G
+	0x1d489: {0x0068},         // MATHEMATICAL BOLD ITALIC SMALL H
+	0x1d48a: {0x0069},         // MATHEMATICAL BOLD ITALIC SMALL I
+	0x1d48b: {0x006a},         // MATHEMATICAL BOLD ITALIC SMALL J
+	0x1d48c: {0x006b},         // MATHEMATICAL BOLD ITALIC SMALL K
+	0x1d48d: {0x006c},         // MATHEMATICAL BOLD ITALIC SMALL L
+	0x1d48e: {0x0072, 0x006e}, // MATHEMATICAL BOLD ITALIC SMALL M
+	0x1d48f: {0x006e},         // MATHEMATICAL BOLD ITALIC SMALL N
+	0x1d490: {0x006f},         // MATHEMATICAL BOLD ITALIC SMALL O
+	0x1d491: {0x0070},         // MATHEMATICAL BOLD ITALIC SMALL P
+	0x1d492: {0x0071},         // MATHEMATICAL BOLD ITALIC SMALL Q
+	0x1d493: {0x0072},         // MATHEMATICAL BOLD ITALIC SMALL R
+	0x1d494: {0x0073},         // MATHEMATICAL BOLD ITALIC SMALL S
+	0x1d495: {0x0074},         // MATHEMATICAL BOLD ITALIC SMALL T
+	0x1d496: {0x0075},         // MATHEMATICAL BOLD ITALIC SMALL U
+	0x1d497: {0x0076},         // MATHEMATICAL BOLD ITALIC SMALL V
+	0x1d498: {0x0077},         // MATHEMATICAL BOLD ITALIC SMALL W
+	0x1d499: {0x0078},         // MATHEMATICAL BOLD ITALIC SMALL X
+	0x1d49a: {0x0079},         // MATHEMATICAL BOLD ITALIC SMALL Y
+	0x1d49b: {0x007a},         // MATHEMATICAL BOLD ITALIC SMALL Z
+	0x1d49c: {0x0041},         // MATHEMATICAL SCRIPT CAPITAL A
+	0x1d49e: {0x0043},         // MATHEMATICAL SCRIPT CAPITAL C
+	0x1d49f: {0x0044},         // MATHEMATICAL SCRIPT CAPITAL D
+	0x1d4a2: {0x0047},         // MATHEMATICAL SCRIPT CAPITAL G
+	0x1d4a5: {0x004a},         // MATHEMATICAL SCRIPT CAPITAL J
+	0x1d4a6: {0x004b},         // MATHEMATICAL SCRIPT CAPITAL K
+	0x1d4a9: {0x004e},         // MATHEMATICAL SCRIPT CAPITAL N
+	0x1d4aa: {0x004f},         // MATHEMATICAL SCRIPT CAPITAL O
+	0x1d4ab: {0x0050},         // MATHEMATICAL SCRIPT CAPITAL P
+	0x1d4ac: {0x0051},         // MATHEMATICAL SCRIPT CAPITAL Q
+	0x1d4ae: {0x0053},         // MATHEMATICAL SCRIPT CAPITAL S
+	0x1d4af: {0x0054},         // MATHEMATICAL SCRIPT CAPITAL T
+	0x1d4b0: {0x0055},         // MATHEMATICAL SCRIPT CAPITAL U
+	0x1d4b1: {0x0056},         // MATHEMATICAL SCRIPT CAPITAL V
+	0x1d4b2: {0x0057},         // MATHEMATICAL SCRIPT CAPITAL W
+	0x1d4b3: {0x0058},         // MATHEMATICAL SCRIPT CAPITAL X
+	0x1d4b4: {0x0059},         // MATHEMATICAL SCRIPT CAPITAL Y
+	0x1d4b5: {0x005a},         // MATHEMATICAL SCRIPT CAPITAL Z
+	0x1d4b6: {0x0061},         // MATHEMATICAL SCRIPT SMALL A
+	0x1d4b7: {0x0062},         // MATHEMATICAL SCRIPT SMALL B
+	0x1d4b8: {0x0063},         // MATHEMATICAL SCRIPT SMALL C
+	0x1d4b9: {0x0064},         // MATHEMATICAL SCRIPT SMALL D
+	0x1d4bb: {0x0066},         // MATHEMATICAL SCRIPT SMALL F
+	0x1d4bd: {0x0068},         // MATHEMATICAL SCRIPT SMALL H
+	0x1d4be: {0x0069},         // MATHEMATICAL SCRIPT SMALL I
+	0x1d4bf: {0x006a},         // MATHEMATICAL SCRIPT SMALL J
+	0x1d4c0: {0x006b},         // MATHEMATICAL SCRIPT SMALL K
+	0x1d4c1: {0x006c},         // MATHEMATICAL SCRIPT SMALL L
+	0x1d4c2: {0x0072, 0x006e}, // MATHEMATICAL SCRIPT SMALL M
+	0x1d4c3: {0x006e},         // MATHEMATICAL SCRIPT SMALL N
+	0x1d4c5: {0x0070},         // MATHEMATICAL SCRIPT SMALL P
+	0x1d4c6: {0x0071},         // MATHEMATICAL SCRIPT SMALL Q
+	0x1d4c7: {0x0072},         // MATHEMATICAL SCRIPT SMALL R
+	0x1d4c8: {0x0073},         // MATHEMATICAL SCRIPT SMALL S
+	0x1d4c9: {0x0074},         // MATHEMATICAL SCRIPT SMALL T
+	0x1d4ca: {0x0075},         // MATHEMATICAL SCRIPT SMALL U
+	0x1d4cb: {0x0076},         // MATHEMATICAL SCRIPT SMALL V
+	0x1d4cc: {0x0077},         // MATHEMATICAL SCRIPT SMALL W
+	0x1d4cd: {0x0078},         // MATHEMATICAL SCRIPT SMALL X
+	0x1d4ce: {0x0079},         // MATHEMATICAL SCRIPT SMALL Y
+	0x1d4cf: {0x007a},         // MATHEMATICAL SCRIPT SMALL Z
+	0x1d4d0: {0x0041},         // MATHEMATICAL BOLD SCRIPT CAPITAL A
+	0x1d4d1: {0x0042},         // MATHEMATICAL BOLD SCRIPT CAPITAL B
+	0x1d4d2: {0x0043},         // MATHEMATICAL BOLD SCRIPT CAPITAL C
+	0x1d4d3: {0x0044},         // MATHEMATICAL BOLD SCRIPT CAPITAL D
+	0x1d4d4: {0x0045},         // MATHEMATICAL BOLD SCRIPT CAPITAL E
+	0x1d4d5: {0x0046},         // MATHEMATICAL BOLD SCRIPT CAPITAL F
+	0x1d4d6: {0x0047},         // MATHEMATICAL BOLD SCRIPT CAPITAL G
+	0x1d4d7: {0x0048},         // MATHEMATICAL BOLD SCRIPT CAPITAL H
+	0x1d4d8: {0x006c},         // MATHEMATICAL BOLD SCRIPT CAPITAL I
+	0x1d4d9: {0x004a},         // MATHEMATICAL BOLD SCRIPT CAPITAL J
+	0x1d4da: {0x004b},         // MATHEMATICAL BOLD SCRIPT CAPITAL K
+	0x1d4db: {0x004c},         // MATHEMATICAL BOLD SCRIPT CAPITAL L
+	0x1d4dc: {0x004d},         // MATHEMATICAL BOLD SCRIPT CAPITAL M
+	0x1d4dd: {0x004e},         // MATHEMATICAL BOLD SCRIPT CAPITAL N
+	0x1d4de: {0x004f},         // MATHEMATICAL BOLD SCRIPT CAPITAL O
+	0x1d4df: {0x0050},         // MATHEMATICAL BOLD SCRIPT CAPITAL P
+	0x1d4e0: {0x0051},         // MATHEMATICAL BOLD SCRIPT CAPITAL Q
+	0x1d4e1: {0x0052},         // MATHEMATICAL BOLD SCRIPT CAPITAL R
+	0x1d4e2: {0x0053},         // MATHEMATICAL BOLD SCRIPT CAPITAL S
+	0x1d4e3: {0x0054},         // MATHEMATICAL BOLD SCRIPT CAPITAL T
+	0x1d4e4: {0x0055},         // MATHEMATICAL BOLD SCRIPT CAPITAL U
+	0x1d4e5: {0x0056},         // MATHEMATICAL BOLD SCRIPT CAPITAL V
+	0x1d4e6: {0x0057},         // MATHEMATICAL BOLD SCRIPT CAPITAL W
+	0x1d4e7: {0x0058},         // MATHEMATICAL BOLD SCRIPT CAPITAL X
+	0x1d4e8: {0x0059},         // MATHEMATICAL BOLD SCRIPT CAPITAL Y
+	0x1d4e9: {0x005a},         // MATHEMATICAL BOLD SCRIPT CAPITAL Z
+	0x1d4ea: {0x0061},         // MATHEMATICAL BOLD SCRIPT SMALL A
+	0x1d4eb: {0x0062},         // MATHEMATICAL BOLD SCRIPT SMALL B
+	0x1d4ec: {0x0063},         // MATHEMATICAL BOLD SCRIPT SMALL C
+	0x1d4ed: {0x0064},         // MATHEMATICAL BOLD SCRIPT SMALL D
+	0x1d4ee: {0x0065},         // MATHEMATICAL BOLD SCRIPT SMALL E
+	0x1d4ef: {0x0066},         // MATHEMATICAL BOLD SCRIPT SMALL F
+	0x1d4f0: {0x0067},         // MATHEMATICAL BOLD SCRIPT SMALL G
+	0x1d4f1: {0x0068},         // MATHEMATICAL BOLD SCRIPT SMALL H
+	0x1d4f2: {0x0069},         // MATHEMATICAL BOLD SCRIPT SMALL I
+	0x1d4f3: {0x006a},         // MATHEMATICAL BOLD SCRIPT SMALL J
+	0x1d4f4: {0x006b},         // MATHEMATICAL BOLD SCRIPT SMALL K
+	0x1d4f5: {0x006c},         // MATHEMATICAL BOLD SCRIPT SMALL L
+	0x1d4f6: {0x0072, 0x006e}, // MATHEMATICAL BOLD SCRIPT SMALL M
+	0x1d4f7: {0x006e},         // MATHEMATICAL BOLD SCRIPT SMALL N
+	0x1d4f8: {0x006f},         // MATHEMATICAL BOLD SCRIPT SMALL O
+	0x1d4f9: {0x0070},         // MATHEMATICAL BOLD SCRIPT SMALL P
+	0x1d4fa: {0x0071},         // MATHEMATICAL BOLD SCRIPT SMALL Q
+	0x1d4fb: {0x0072},         // MATHEMATICAL BOLD SCRIPT SMALL R
+	0x1d4fc: {0x0073},         // MATHEMATICAL BOLD SCRIPT SMALL S
+	0x1d4fd: {0x0074},         // MATHEMATICAL BOLD SCRIPT SMALL T
+	0x1d4fe: {0x0075},         // MATHEMATICAL BOLD SCRIPT SMALL U
+	0x1d4ff: {0x0076},         // MATHEMATICAL BOLD SCRIPT SMALL V
+
+	0x1d500: {0x0077},         // MATHEMATICAL BOLD SCRIPT SMALL W
+	0x1d501: {0x0078},         // MATHEMATICAL BOLD SCRIPT SMALL X
+	0x1d502: {0x0079},         // MATHEMATICAL BOLD SCRIPT SMALL Y
+	0x1d503: {0x007a},         // MATHEMATICAL BOLD SCRIPT SMALL Z
+	0x1d504: {0x0041},         // MATHEMATICAL FRAKTUR CAPITAL A
+	0x1d505: {0x0042},         // MATHEMATICAL FRAKTUR CAPITAL B
+	0x1d507: {0x0044},         // MATHEMATICAL FRAKTUR CAPITAL D
+	0x1d508: {0x0045},         // MATHEMATICAL FRAKTUR CAPITAL E
+	0x1d509: {0x0046},         // MATHEMATICAL FRAKTUR CAPITAL F
+	0x1d50a: {0x0047},         // MATHEMATICAL FRAKTUR CAPITAL G
+	0x1d50d: {0x004a},         // MATHEMATICAL FRAKTUR CAPITAL J
+	0x1d50e: {0x004b},         // MATHEMATICAL FRAKTUR CAPITAL K
+	0x1d50f: {0x004c},         // MATHEMATICAL FRAKTUR CAPITAL L
+	0x1d510: {0x004d},         // MATHEMATICAL FRAKTUR CAPITAL M
+	0x1d511: {0x004e},         // MATHEMATICAL FRAKTUR CAPITAL N
+	0x1d512: {0x004f},         // MATHEMATICAL FRAKTUR CAPITAL O
+	0x1d513: {0x0050},         // MATHEMATICAL FRAKTUR CAPITAL P
+	0x1d514: {0x0051},         // MATHEMATICAL FRAKTUR CAPITAL Q
+	0x1d516: {0x0053},         // MATHEMATICAL FRAKTUR CAPITAL S
+	0x1d517: {0x0054},         // MATHEMATICAL FRAKTUR CAPITAL T
+	0x1d518: {0x0055},         // MATHEMATICAL FRAKTUR CAPITAL U
+	0x1d519: {0x0056},         // MATHEMATICAL FRAKTUR CAPITAL V
+	0x1d51a: {0x0057},         // MATHEMATICAL FRAKTUR CAPITAL W
+	0x1d51b: {0x0058},         // MATHEMATICAL FRAKTUR CAPITAL X
+	0x1d51c: {0x0059},         // MATHEMATICAL FRAKTUR CAPITAL Y
+	0x1d51e: {0x0061},         // MATHEMATICAL FRAKTUR SMALL A
+	0x1d51f: {0x0062},         // MATHEMATICAL FRAKTUR SMALL B
+	0x1d520: {0x0063},         // MATHEMATICAL FRAKTUR SMALL C
+	0x1d521: {0x0064},         // MATHEMATICAL FRAKTUR SMALL D
+	0x1d522: {0x0065},         // MATHEMATICAL FRAKTUR SMALL E
+	0x1d523: {0x0066},         // MATHEMATICAL FRAKTUR SMALL F
+	0x1d524: {0x0067},         // MATHEMATICAL FRAKTUR SMALL G
+	0x1d525: {0x0068},         // MATHEMATICAL FRAKTUR SMALL H
+	0x1d526: {0x0069},         // MATHEMATICAL FRAKTUR SMALL I
+	0x1d527: {0x006a},         // MATHEMATICAL FRAKTUR SMALL J
+	0x1d528: {0x006b},         // MATHEMATICAL FRAKTUR SMALL K
+	0x1d529: {0x006c},         // MATHEMATICAL FRAKTUR SMALL L
+	0x1d52a: {0x0072, 0x006e}, // MATHEMATICAL FRAKTUR SMALL M
+	0x1d52b: {0x006e},         // MATHEMATICAL FRAKTUR SMALL N
+	0x1d52c: {0x006f},         // MATHEMATICAL FRAKTUR SMALL O
+	0x1d52d: {0x0070},         // MATHEMATICAL FRAKTUR SMALL P
+	0x1d52e: {0x0071},         // MATHEMATICAL FRAKTUR SMALL Q
+	0x1d52f: {0x0072},         // MATHEMATICAL FRAKTUR SMALL R
+	0x1d530: {0x0073},         // MATHEMATICAL FRAKTUR SMALL S
+	0x1d531: {0x0074},         // MATHEMATICAL FRAKTUR SMALL T
+	0x1d532: {0x0075},         // MATHEMATICAL FRAKTUR SMALL U
+	0x1d533: {0x0076},         // MATHEMATICAL FRAKTUR SMALL V
+	0x1d534: {0x0077},         // MATHEMATICAL FRAKTUR SMALL W
+	0x1d535: {0x0078},         // MATHEMATICAL FRAKTUR SMALL X
+	0x1d536: {0x0079},         // MATHEMATICAL FRAKTUR SMALL Y
+	0x1d537: {0x007a},         // MATHEMATICAL FRAKTUR SMALL Z
+	0x1d538: {0x0041},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL A
+	0x1d539: {0x0042},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL B
+	0x1d53b: {0x0044},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL D
+	0x1d53c: {0x0045},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL E
+	0x1d53d: {0x0046},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL F
+	0x1d53e: {0x0047},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL G
+	0x1d540: {0x006c},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL I
+	0x1d541: {0x004a},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL J
+	0x1d542: {0x004b},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL K
+	0x1d543: {0x004c},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL L
+	0x1d544: {0x004d},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL M
+	0x1d546: {0x004f},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL O
+	0x1d54a: {0x0053},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL S
+	0x1d54b: {0x0054},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL T
+	0x1d54c: {0x0055},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL U
+	0x1d54d: {0x0056},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL V
+	0x1d54e: {0x0057},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL W
+	0x1d54f: {0x0058},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL X
+	0x1d550: {0x0059},         // MATHEMATICAL DOUBLE-STRUCK CAPITAL Y
+	0x1d552: {0x0061},         // MATHEMATICAL DOUBLE-STRUCK SMALL A
+	0x1d553: {0x0062},         // MATHEMATICAL DOUBLE-STRUCK SMALL B
+	0x1d554: {0x0063},         // MATHEMATICAL DOUBLE-STRUCK SMALL C
+	0x1d555: {0x0064},         // MATHEMATICAL DOUBLE-STRUCK SMALL D
+	0x1d556: {0x0065},         // MATHEMATICAL DOUBLE-STRUCK SMALL E
+	0x1d557: {0x0066},         // MATHEMATICAL DOUBLE-STRUCK SMALL F
+	0x1d558: {0x0067},         // MATHEMATICAL DOUBLE-STRUCK SMALL G
+	0x1d559: {0x0068},         // MATHEMATICAL DOUBLE-STRUCK SMALL H
+	0x1d55a: {0x0069},         // MATHEMATICAL DOUBLE-STRUCK SMALL I
+	0x1d55b: {0x006a},         // MATHEMATICAL DOUBLE-STRUCK SMALL J
+	0x1d55c: {0x006b},         // MATHEMATICAL DOUBLE-STRUCK SMALL K
+	0x1d55d: {0x006c},         // MATHEMATICAL DOUBLE-STRUCK SMALL L
+	0x1d55e: {0x0072, 0x006e}, // MATHEMATICAL DOUBLE-STRUCK SMALL M
+	0x1d55f: {0x006e},         // MATHEMATICAL DOUBLE-STRUCK SMALL N
+	0x1d560: {0x006f},         // MATHEMATICAL DOUBLE-STRUCK SMALL O
+	0x1d561: {0x0070},         // MATHEMATICAL DOUBLE-STRUCK SMALL P
+	0x1d562: {0x0071},         // MATHEMATICAL DOUBLE-STRUCK SMALL Q
+	0x1d563: {0x0072},         // MATHEMATICAL DOUBLE-STRUCK SMALL R
+	0x1d564: {0x0073},         // MATHEMATICAL DOUBLE-STRUCK SMALL S
+	0x1d565: {0x0074},         // MATHEMATICAL DOUBLE-STRUCK SMALL T
+	0x1d566: {0x0075},         // MATHEMATICAL DOUBLE-STRUCK SMALL U
+	0x1d567: {0x0076},         // MATHEMATICAL DOUBLE-STRUCK SMALL V
+	0x1d568: {0x0077},         // MATHEMATICAL DOUBLE-STRUCK SMALL W
+	0x1d569: {0x0078},         // MATHEMATICAL DOUBLE-STRUCK SMALL X
+	0x1d56a: {0x0079},         // MATHEMATICAL DOUBLE-STRUCK SMALL Y
+	0x1d56b: {0x007a},         // MATHEMATICAL DOUBLE-STRUCK SMALL Z
+	0x1d56c: {0x0041},         // MATHEMATICAL BOLD FRAKTUR CAPITAL A
+	0x1d56d: {0x0042},         // MATHEMATICAL BOLD FRAKTUR CAPITAL B
+	0x1d56e: {0x0043},         // MATHEMATICAL BOLD FRAKTUR CAPITAL C
+	0x1d56f: {0x0044},         // MATHEMATICAL BOLD FRAKTUR CAPITAL D
+	0x1d570: {0x0045},         // MATHEMATICAL BOLD FRAKTUR CAPITAL E
+	0x1d571: {0x0046},         // MATHEMATICAL BOLD FRAKTUR CAPITAL F
+	0x1d572: {0x0047},         // MATHEMATICAL BOLD FRAKTUR CAPITAL G
+	0x1d573: {0x0048},         // MATHEMATICAL BOLD FRAKTUR CAPITAL H
+	0x1d574: {0x006c},         // MATHEMATICAL BOLD FRAKTUR CAPITAL I
+	0x1d575: {0x004a},         // MATHEMATICAL BOLD FRAKTUR CAPITAL J
+	0x1d576: {0x004b},         // MATHEMATICAL BOLD FRAKTUR CAPITAL K
+	0x1d577: {0x004c},         // MATHEMATICAL BOLD FRAKTUR CAPITAL L
+	0x1d578: {0x004d},         // MATHEMATICAL BOLD FRAKTUR CAPITAL M
+	0x1d579: {0x004e},         // MATHEMATICAL BOLD FRAKTUR CAPITAL N
+	0x1d57a: {0x004f},         // MATHEMATICAL BOLD FRAKTUR CAPITAL O
+	0x1d57b: {0x0050},         // MATHEMATICAL BOLD FRAKTUR CAPITAL P
+	0x1d57c: {0x0051},         // MATHEMATICAL BOLD FRAKTUR CAPITAL Q
+	0x1d57d: {0x0052},         // MATHEMATICAL BOLD FRAKTUR CAPITAL R
+	0x1d57e: {0x0053},         // MATHEMATICAL BOLD FRAKTUR CAPITAL S
+	0x1d57f: {0x0054},         // MATHEMATICAL BOLD FRAKTUR CAPITAL T
+	0x1d580: {0x0055},         // MATHEMATICAL BOLD FRAKTUR CAPITAL U
+	0x1d581: {0x0056},         // MATHEMATICAL BOLD FRAKTUR CAPITAL V
+	0x1d582: {0x0057},         // MATHEMATICAL BOLD FRAKTUR CAPITAL W
+	0x1d583: {0x0058},         // MATHEMATICAL BOLD FRAKTUR CAPITAL X
+	0x1d584: {0x0059},         // MATHEMATICAL BOLD FRAKTUR CAPITAL Y
+	0x1d585: {0x005a},         // MATHEMATICAL BOLD FRAKTUR CAPITAL Z
+	0x1d586: {0x0061},         // MATHEMATICAL BOLD FRAKTUR SMALL A
+	0x1d587: {0x0062},         // MATHEMATICAL BOLD FRAKTUR SMALL B
+	0x1d588: {0x0063},         // MATHEMATICAL BOLD FRAKTUR SMALL C
+	0x1d589: {0x0064},         // MATHEMATICAL BOLD FRAKTUR SMALL D
+	0x1d58a: {0x0065},         // MATHEMATICAL BOLD FRAKTUR SMALL E
+	0x1d58b: {0x0066},         // MATHEMATICAL BOLD FRAKTUR SMALL F
+	0x1d58c: {0x0067},         // MATHEMATICAL BOLD FRAKTUR SMALL G
+	0x1d58d: {0x0068},         // MATHEMATICAL BOLD FRAKTUR SMALL H
+	0x1d58e: {0x0069},         // MATHEMATICAL BOLD FRAKTUR SMALL I
+	0x1d58f: {0x006a},         // MATHEMATICAL BOLD FRAKTUR SMALL J
+	0x1d590: {0x006b},         // MATHEMATICAL BOLD FRAKTUR SMALL K
+	0x1d591: {0x006c},         // MATHEMATICAL BOLD FRAKTUR SMALL L
+	0x1d592: {0x0072, 0x006e}, // MATHEMATICAL BOLD FRAKTUR SMALL M
+	0x1d593: {0x006e},         // MATHEMATICAL BOLD FRAKTUR SMALL N
+	0x1d594: {0x006f},         // MATHEMATICAL BOLD FRAKTUR SMALL O
+	0x1d595: {0x0070},         // MATHEMATICAL BOLD FRAKTUR SMALL P
+	0x1d596: {0x0071},         // MATHEMATICAL BOLD FRAKTUR SMALL Q
+	0x1d597: {0x0072},         // MATHEMATICAL BOLD FRAKTUR SMALL R
+	0x1d598: {0x0073},         // MATHEMATICAL BOLD FRAKTUR SMALL S
+	0x1d599: {0x0074},         // MATHEMATICAL BOLD FRAKTUR SMALL T
+	0x1d59a: {0x0075},         // MATHEMATICAL BOLD FRAKTUR SMALL U
+	0x1d59b: {0x0076},         // MATHEMATICAL BOLD FRAKTUR SMALL V
+	0x1d59c: {0x0077},         // MATHEMATICAL BOLD FRAKTUR SMALL W
+	0x1d59d: {0x0078},         // MATHEMATICAL BOLD FRAKTUR SMALL X
+	0x1d59e: {0x0079},         // MATHEMATICAL BOLD FRAKTUR SMALL Y
+	0x1d59f: {0x007a},         // MATHEMATICAL BOLD FRAKTUR SMALL Z
+	0x1d5a0: {0x0041},         // MATHEMATICAL SANS-SERIF CAPITAL A
+	0x1d5a1: {0x0042},         // MATHEMATICAL SANS-SERIF CAPITAL B
+	0x1d5a2: {0x0043},         // MATHEMATICAL SANS-SERIF CAPITAL C
+	0x1d5a3: {0x0044},         // MATHEMATICAL SANS-SERIF CAPITAL D
+	0x1d5a4: {0x0045},         // MATHEMATICAL SANS-SERIF CAPITAL E
+	0x1d5a5: {0x0046},         // MATHEMATICAL SANS-SERIF CAPITAL F
+	0x1d5a6: {0x0047},         // MATHEMATICAL SANS-SERIF CAPITAL G
+	0x1d5a7: {0x0048},         // MATHEMATICAL SANS-SERIF CAPITAL H
+	0x1d5a8: {0x006c},         // MATHEMATICAL SANS-SERIF CAPITAL I
+	0x1d5a9: {0x004a},         // MATHEMATICAL SANS-SERIF CAPITAL J
+	0x1d5aa: {0x004b},         // MATHEMATICAL SANS-SERIF CAPITAL K
+	0x1d5ab: {0x004c},         // MATHEMATICAL SANS-SERIF CAPITAL L
+	0x1d5ac: {0x004d},         // MATHEMATICAL SANS-SERIF CAPITAL M
+	0x1d5ad: {0x004e},         // MATHEMATICAL SANS-SERIF CAPITAL N
+	0x1d5ae: {0x004f},         // MATHEMATICAL SANS-SERIF CAPITAL O
+	0x1d5af: {0x0050},         // MATHEMATICAL SANS-SERIF CAPITAL P
+	0x1d5b0: {0x0051},         // MATHEMATICAL SANS-SERIF CAPITAL Q
+	0x1d5b1: {0x0052},         // MATHEMATICAL SANS-SERIF CAPITAL R
+	0x1d5b2: {0x0053},         // MATHEMATICAL SANS-SERIF CAPITAL S
+	0x1d5b3: {0x0054},         // MATHEMATICAL SANS-SERIF CAPITAL T
+	0x1d5b4: {0x0055},         // MATHEMATICAL SANS-SERIF CAPITAL U
+	0x1d5b5: {0x0056},         // MATHEMATICAL SANS-SERIF CAPITAL V
+	0x1d5b6: {0x0057},         // MATHEMATICAL SANS-SERIF CAPITAL W
+	0x1d5b7: {0x0058},         // MATHEMATICAL SANS-SERIF CAPITAL X
+	0x1d5b8: {0x0059},         // MATHEMATICAL SANS-SERIF CAPITAL Y
+	0x1d5b9: {0x005a},         // MATHEMATICAL SANS-SERIF CAPITAL Z
+	0x1d5ba: {0x0061},         // MATHEMATICAL SANS-SERIF SMALL A
+	0x1d5bb: {0x0062},         // MATHEMATICAL SANS-SERIF SMALL B
+	0x1d5bc: {0x0063},         // MATHEMATICAL SANS-SERIF SMALL C
+	0x1d5bd: {0x0064},         // MATHEMATICAL SANS-SERIF SMALL D
+	0x1d5be: {0x0065},         // MATHEMATICAL SANS-SERIF SMALL E
+	0x1d5bf: {0x0066},         // MATHEMATICAL SANS-SERIF SMALL F
+	0x1d5c0: {0x0067},         // MATHEMATICAL SANS-SERIF SMALL G
+	0x1d5c1: {0x0068},         // MATHEMATICAL SANS-SERIF SMALL H
+	0x1d5c2: {0x0069},         // MATHEMATICAL SANS-SERIF SMALL I
+	0x1d5c3: {0x006a},         // MATHEMATICAL SANS-SERIF SMALL J
+	0x1d5c4: {0x006b},         // MATHEMATICAL SANS-SERIF SMALL K
+	0x1d5c5: {0x006c},         // MATHEMATICAL SANS-SERIF SMALL L
+	0x1d5c6: {0x0072, 0x006e}, // MATHEMATICAL SANS-SERIF SMALL M
+	0x1d5c7: {0x006e},         // MATHEMATICAL SANS-SERIF SMALL N
+	0x1d5c8: {0x006f},         // MATHEMATICAL SANS-SERIF SMALL O
+	0x1d5c9: {0x0070},         // MATHEMATICAL SANS-SERIF SMALL P
+	0x1d5ca: {0x0071},         // MATHEMATICAL SANS-SERIF SMALL Q
+	0x1d5cb: {0x0072},         // MATHEMATICAL SANS-SERIF SMALL R
+	0x1d5cc: {0x0073},         // MATHEMATICAL SANS-SERIF SMALL S
+	0x1d5cd: {0x0074},         // MATHEMATICAL SANS-SERIF SMALL T
+	0x1d5ce: {0x0075},         // MATHEMATICAL SANS-SERIF SMALL U
+	0x1d5cf: {0x0076},         // MATHEMATICAL SANS-SERIF SMALL V
+	0x1d5d0: {0x0077},         // MATHEMATICAL SANS-SERIF SMALL W
+	0x1d5d1: {0x0078},         // MATHEMATICAL SANS-SERIF SMALL X
+	0x1d5d2: {0x0079},         // MATHEMATICAL SANS-SERIF SMALL Y
+	0x1d5d3: {0x007a},         // MATHEMATICAL SANS-SERIF SMALL Z
+	0x1d5d4: {0x0041},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL A
+	0x1d5d5: {0x0042},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL B
+	0x1d5d6: {0x0043},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL C
+	0x1d5d7: {0x0044},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL D
+	0x1d5d8: {0x0045},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL E
+	0x1d5d9: {0x0046},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL F
+	0x1d5da: {0x0047},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL G
+	0x1d5db: {0x0048},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL H
+	0x1d5dc: {0x006c},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL I
+	0x1d5dd: {0x004a},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL J
+	0x1d5de: {0x004b},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL K
+	0x1d5df: {0x004c},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL L
+	0x1d5e0: {0x004d},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL M
+	0x1d5e1: {0x004e},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL N
+	0x1d5e2: {0x004f},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL O
+	0x1d5e3: {0x0050},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL P
+	0x1d5e4: {0x0051},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL Q
+	0x1d5e5: {0x0052},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL R
+	0x1d5e6: {0x0053},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL S
+	0x1d5e7: {0x0054},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL T
+	0x1d5e8: {0x0055},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL U
+	0x1d5e9: {0x0056},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL V
+	0x1d5ea: {0x0057},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL W
+	0x1d5eb: {0x0058},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL X
+	0x1d5ec: {0x0059},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL Y
+	0x1d5ed: {0x005a},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL Z
+	0x1d5ee: {0x0061},         // MATHEMATICAL SANS-SERIF BOLD SMALL A
+	0x1d5ef: {0x0062},         // MATHEMATICAL SANS-SERIF BOLD SMALL B
+	0x1d5f0: {0x0063},         // MATHEMATICAL SANS-SERIF BOLD SMALL C
+	0x1d5f1: {0x0064},         // MATHEMATICAL SANS-SERIF BOLD SMALL D
+	0x1d5f2: {0x0065},         // MATHEMATICAL SANS-SERIF BOLD SMALL E
+	0x1d5f3: {0x0066},         // MATHEMATICAL SANS-SERIF BOLD SMALL F
+	0x1d5f4: {0x0067},         // MATHEMATICAL SANS-SERIF BOLD SMALL G
+	0x1d5f5: {0x0068},         // MATHEMATICAL SANS-SERIF BOLD SMALL H
+	0x1d5f6: {0x0069},         // MATHEMATICAL SANS-SERIF BOLD SMALL I
+	0x1d5f7: {0x006a},         // MATHEMATICAL SANS-SERIF BOLD SMALL J
+	0x1d5f8: {0x006b},         // MATHEMATICAL SANS-SERIF BOLD SMALL K
+	0x1d5f9: {0x006c},         // MATHEMATICAL SANS-SERIF BOLD SMALL L
+	0x1d5fa: {0x0072, 0x006e}, // MATHEMATICAL SANS-SERIF BOLD SMALL M
+	0x1d5fb: {0x006e},         // MATHEMATICAL SANS-SERIF BOLD SMALL N
+	0x1d5fc: {0x006f},         // MATHEMATICAL SANS-SERIF BOLD SMALL O
+	0x1d5fd: {0x0070},         // MATHEMATICAL SANS-SERIF BOLD SMALL P
+	0x1d5fe: {0x0071},         // MATHEMATICAL SANS-SERIF BOLD SMALL Q
+	0x1d5ff: {0x0072},         // MATHEMATICAL SANS-SERIF BOLD SMALL R
+
+	0x1d600: {0x0073},         // MATHEMATICAL SANS-SERIF BOLD SMALL S
+	0x1d601: {0x0074},         // MATHEMATICAL SANS-SERIF BOLD SMALL T
+	0x1d602: {0x0075},         // MATHEMATICAL SANS-SERIF BOLD SMALL U
+	0x1d603: {0x0076},         // MATHEMATICAL SANS-SERIF BOLD SMALL V
+	0x1d604: {0x0077},         // MATHEMATICAL SANS-SERIF BOLD SMALL W
+	0x1d605: {0x0078},         // MATHEMATICAL SANS-SERIF BOLD SMALL X
+	0x1d606: {0x0079},         // MATHEMATICAL SANS-SERIF BOLD SMALL Y
+	0x1d607: {0x007a},         // MATHEMATICAL SANS-SERIF BOLD SMALL Z
+	0x1d608: {0x0041},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL A
+	0x1d609: {0x0042},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL B
+	0x1d60a: {0x0043},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL C
+	0x1d60b: {0x0044},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL D
+	0x1d60c: {0x0045},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL E
+	0x1d60d: {0x0046},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL F
+	0x1d60e: {0x0047},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL G
+	0x1d60f: {0x0048},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL H
+	0x1d610: {0x006c},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL I
+	0x1d611: {0x004a},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL J
+	0x1d612: {0x004b},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL K
+	0x1d613: {0x004c},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL L
+	0x1d614: {0x004d},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL M
+	0x1d615: {0x004e},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL N
+	0x1d616: {0x004f},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL O
+	0x1d617: {0x0050},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL P
+	0x1d618: {0x0051},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Q
+	0x1d619: {0x0052},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL R
+	0x1d61a: {0x0053},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL S
+	0x1d61b: {0x0054},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL T
+	0x1d61c: {0x0055},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL U
+	0x1d61d: {0x0056},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL V
+	0x1d61e: {0x0057},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL W
+	0x1d61f: {0x0058},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL X
+	0x1d620: {0x0059},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Y
+	0x1d621: {0x005a},         // MATHEMATICAL SANS-SERIF ITALIC CAPITAL Z
+	0x1d622: {0x0061},         // MATHEMATICAL SANS-SERIF ITALIC SMALL A
+	0x1d623: {0x0062},         // MATHEMATICAL SANS-SERIF ITALIC SMALL B
+	0x1d624: {0x0063},         // MATHEMATICAL SANS-SERIF ITALIC SMALL C
+	0x1d625: {0x0064},         // MATHEMATICAL SANS-SERIF ITALIC SMALL D
+	0x1d626: {0x0065},         // MATHEMATICAL SANS-SERIF ITALIC SMALL E
+	0x1d627: {0x0066},         // MATHEMATICAL SANS-SERIF ITALIC SMALL F
+	0x1d628: {0x0067},         // MATHEMATICAL SANS-SERIF ITALIC SMALL G
+	0x1d629: {0x0068},         // MATHEMATICAL SANS-SERIF ITALIC SMALL H
+	0x1d62a: {0x0069},         // MATHEMATICAL SANS-SERIF ITALIC SMALL I
+	0x1d62b: {0x006a},         // MATHEMATICAL SANS-SERIF ITALIC SMALL J
+	0x1d62c: {0x006b},         // MATHEMATICAL SANS-SERIF ITALIC SMALL K
+	0x1d62d: {0x006c},         // MATHEMATICAL SANS-SERIF ITALIC SMALL L
+	0x1d62e: {0x0072, 0x006e}, // MATHEMATICAL SANS-SERIF ITALIC SMALL M
+	0x1d62f: {0x006e},         // MATHEMATICAL SANS-SERIF ITALIC SMALL N
+	0x1d630: {0x006f},         // MATHEMATICAL SANS-SERIF ITALIC SMALL O
+	0x1d631: {0x0070},         // MATHEMATICAL SANS-SERIF ITALIC SMALL P
+	0x1d632: {0x0071},         // MATHEMATICAL SANS-SERIF ITALIC SMALL Q
+	0x1d633: {0x0072},         // MATHEMATICAL SANS-SERIF ITALIC SMALL R
+	0x1d634: {0x0073},         // MATHEMATICAL SANS-SERIF ITALIC SMALL S
+	0x1d635: {0x0074},         // MATHEMATICAL SANS-SERIF ITALIC SMALL T
+	0x1d636: {0x0075},         // MATHEMATICAL SANS-SERIF ITALIC SMALL U
+	0x1d637: {0x0076},         // MATHEMATICAL SANS-SERIF ITALIC SMALL V
+	0x1d638: {0x0077},         // MATHEMATICAL SANS-SERIF ITALIC SMALL W
+	0x1d639: {0x0078},         // MATHEMATICAL SANS-SERIF ITALIC SMALL X
+	0x1d63a: {0x0079},         // MATHEMATICAL SANS-SERIF ITALIC SMALL Y
+	0x1d63b: {0x007a},         // MATHEMATICAL SANS-SERIF ITALIC SMALL Z
+	0x1d63c: {0x0041},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL A
+	0x1d63d: {0x0042},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL B
+	0x1d63e: {0x0043},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL C
+	0x1d63f: {0x0044},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL D
+	0x1d640: {0x0045},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL E
+	0x1d641: {0x0046},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL F
+	0x1d642: {0x0047},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL G
+	0x1d643: {0x0048},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL H
+	0x1d644: {0x006c},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL I
+	0x1d645: {0x004a},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL J
+	0x1d646: {0x004b},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL K
+	0x1d647: {0x004c},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL L
+	0x1d648: {0x004d},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL M
+	0x1d649: {0x004e},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL N
+	0x1d64a: {0x004f},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL O
+	0x1d64b: {0x0050},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL P
+	0x1d64c: {0x0051},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Q
+	0x1d64d: {0x0052},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL R
+	0x1d64e: {0x0053},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL S
+	0x1d64f: {0x0054},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL T
+	0x1d650: {0x0055},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL U
+	0x1d651: {0x0056},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL V
+	0x1d652: {0x0057},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL W
+	0x1d653: {0x0058},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL X
+	0x1d654: {0x0059},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Y
+	0x1d655: {0x005a},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Z
+	0x1d656: {0x0061},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL A
+	0x1d657: {0x0062},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL B
+	0x1d658: {0x0063},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL C
+	0x1d659: {0x0064},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL D
+	0x1d65a: {0x0065},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL E
+	0x1d65b: {0x0066},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL F
+	0x1d65c: {0x0067},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL G
+	0x1d65d: {0x0068},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL H
+	0x1d65e: {0x0069},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL I
+	0x1d65f: {0x006a},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL J
+	0x1d660: {0x006b},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL K
+	0x1d661: {0x006c},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL L
+	0x1d662: {0x0072, 0x006e}, // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL M
+	0x1d663: {0x006e},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL N
+	0x1d664: {0x006f},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL O
+	0x1d665: {0x0070},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL P
+	0x1d666: {0x0071},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Q
+	0x1d667: {0x0072},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL R
+	0x1d668: {0x0073},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL S
+	0x1d669: {0x0074},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL T
+	0x1d66a: {0x0075},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL U
+	0x1d66b: {0x0076},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL V
+	0x1d66c: {0x0077},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL W
+	0x1d66d: {0x0078},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL X
+	0x1d66e: {0x0079},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Y
+	0x1d66f: {0x007a},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Z
+	0x1d670: {0x0041},         // MATHEMATICAL MONOSPACE CAPITAL A
+	0x1d671: {0x0042},         // MATHEMATICAL MONOSPACE CAPITAL B
+	0x1d672: {0x0043},         // MATHEMATICAL MONOSPACE CAPITAL C
+	0x1d673: {0x0044},         // MATHEMATICAL MONOSPACE CAPITAL D
+	0x1d674: {0x0045},         // MATHEMATICAL MONOSPACE CAPITAL E
+	0x1d675: {0x0046},         // MATHEMATICAL MONOSPACE CAPITAL F
+	0x1d676: {0x0047},         // MATHEMATICAL MONOSPACE CAPITAL G
+	0x1d677: {0x0048},         // MATHEMATICAL MONOSPACE CAPITAL H
+	0x1d678: {0x006c},         // MATHEMATICAL MONOSPACE CAPITAL I
+	0x1d679: {0x004a},         // MATHEMATICAL MONOSPACE CAPITAL J
+	0x1d67a: {0x004b},         // MATHEMATICAL MONOSPACE CAPITAL K
+	0x1d67b: {0x004c},         // MATHEMATICAL MONOSPACE CAPITAL L
+	0x1d67c: {0x004d},         // MATHEMATICAL MONOSPACE CAPITAL M
+	0x1d67d: {0x004e},         // MATHEMATICAL MONOSPACE CAPITAL N
+	0x1d67e: {0x004f},         // MATHEMATICAL MONOSPACE CAPITAL O
+	0x1d67f: {0x0050},         // MATHEMATICAL MONOSPACE CAPITAL P
+	0x1d680: {0x0051},         // MATHEMATICAL MONOSPACE CAPITAL Q
+	0x1d681: {0x0052},         // MATHEMATICAL MONOSPACE CAPITAL R
+	0x1d682: {0x0053},         // MATHEMATICAL MONOSPACE CAPITAL S
+	0x1d683: {0x0054},         // MATHEMATICAL MONOSPACE CAPITAL T
+	0x1d684: {0x0055},         // MATHEMATICAL MONOSPACE CAPITAL U
+	0x1d685: {0x0056},         // MATHEMATICAL MONOSPACE CAPITAL V
+	0x1d686: {0x0057},         // MATHEMATICAL MONOSPACE CAPITAL W
+	0x1d687: {0x0058},         // MATHEMATICAL MONOSPACE CAPITAL X
+	0x1d688: {0x0059},         // MATHEMATICAL MONOSPACE CAPITAL Y
+	0x1d689: {0x005a},         // MATHEMATICAL MONOSPACE CAPITAL Z
+	0x1d68a: {0x0061},         // MATHEMATICAL MONOSPACE SMALL A
+	0x1d68b: {0x0062},         // MATHEMATICAL MONOSPACE SMALL B
+	0x1d68c: {0x0063},         // MATHEMATICAL MONOSPACE SMALL C
+	0x1d68d: {0x0064},         // MATHEMATICAL MONOSPACE SMALL D
+	0x1d68e: {0x0065},         // MATHEMATICAL MONOSPACE SMALL E
+	0x1d68f: {0x0066},         // MATHEMATICAL MONOSPACE SMALL F
+	0x1d690: {0x0067},         // MATHEMATICAL MONOSPACE SMALL G
+	0x1d691: {0x0068},         // MATHEMATICAL MONOSPACE SMALL H
+	0x1d692: {0x0069},         // MATHEMATICAL MONOSPACE SMALL I
+	0x1d693: {0x006a},         // MATHEMATICAL MONOSPACE SMALL J
+	0x1d694: {0x006b},         // MATHEMATICAL MONOSPACE SMALL K
+	0x1d695: {0x006c},         // MATHEMATICAL MONOSPACE SMALL L
+	0x1d696: {0x0072, 0x006e}, // MATHEMATICAL MONOSPACE SMALL M
+	0x1d697: {0x006e},         // MATHEMATICAL MONOSPACE SMALL N
+	0x1d698: {0x006f},         // MATHEMATICAL MONOSPACE SMALL O
+	0x1d699: {0x0070},         // MATHEMATICAL MONOSPACE SMALL P
+	0x1d69a: {0x0071},         // MATHEMATICAL MONOSPACE SMALL Q
+	0x1d69b: {0x0072},         // MATHEMATICAL MONOSPACE SMALL R
+	0x1d69c: {0x0073},         // MATHEMATICAL MONOSPACE SMALL S
+	0x1d69d: {0x0074},         // MATHEMATICAL MONOSPACE SMALL T
+	0x1d69e: {0x0075},         // MATHEMATICAL MONOSPACE SMALL U
+	0x1d69f: {0x0076},         // MATHEMATICAL MONOSPACE SMALL V
+	0x1d6a0: {0x0077},         // MATHEMATICAL MONOSPACE SMALL W
+	0x1d6a1: {0x0078},         // MATHEMATICAL MONOSPACE SMALL X
+	0x1d6a2: {0x0079},         // MATHEMATICAL MONOSPACE SMALL Y
+	0x1d6a3: {0x007a},         // MATHEMATICAL MONOSPACE SMALL Z
+	0x1d6a4: {0x0069},         // MATHEMATICAL ITALIC SMALL DOTLESS I
+	0x1d6a5: {0x0237},         // MATHEMATICAL ITALIC SMALL DOTLESS J
+	0x1d6a8: {0x0041},         // MATHEMATICAL BOLD CAPITAL ALPHA
+	0x1d6a9: {0x0042},         // MATHEMATICAL BOLD CAPITAL BETA
+	0x1d6aa: {0x0393},         // MATHEMATICAL BOLD CAPITAL GAMMA
+	0x1d6ab: {0x0394},         // MATHEMATICAL BOLD CAPITAL DELTA
+	0x1d6ac: {0x0045},         // MATHEMATICAL BOLD CAPITAL EPSILON
+	0x1d6ad: {0x005a},         // MATHEMATICAL BOLD CAPITAL ZETA
+	0x1d6ae: {0x0048},         // MATHEMATICAL BOLD CAPITAL ETA
+	0x1d6af: {0x004f, 0x0335}, // MATHEMATICAL BOLD CAPITAL THETA
+	0x1d6b0: {0x006c},         // MATHEMATICAL BOLD CAPITAL IOTA
+	0x1d6b1: {0x004b},         // MATHEMATICAL BOLD CAPITAL KAPPA
+	0x1d6b2: {0x0245},         // MATHEMATICAL BOLD CAPITAL LAMDA
+	0x1d6b3: {0x004d},         // MATHEMATICAL BOLD CAPITAL MU
+	0x1d6b4: {0x004e},         // MATHEMATICAL BOLD CAPITAL NU
+	0x1d6b5: {0x039e},         // MATHEMATICAL BOLD CAPITAL XI
+	0x1d6b6: {0x004f},         // MATHEMATICAL BOLD CAPITAL OMICRON
+	0x1d6b7: {0x03a0},         // MATHEMATICAL BOLD CAPITAL PI
+	0x1d6b8: {0x0050},         // MATHEMATICAL BOLD CAPITAL RHO
+	0x1d6b9: {0x004f, 0x0335}, // MATHEMATICAL BOLD CAPITAL THETA SYMBOL
+	0x1d6ba: {0x01a9},         // MATHEMATICAL BOLD CAPITAL SIGMA
+	0x1d6bb: {0x0054},         // MATHEMATICAL BOLD CAPITAL TAU
+	0x1d6bc: {0x0059},         // MATHEMATICAL BOLD CAPITAL UPSILON
+	0x1d6bd: {0x03a6},         // MATHEMATICAL BOLD CAPITAL PHI
+	0x1d6be: {0x0058},         // MATHEMATICAL BOLD CAPITAL CHI
+	0x1d6bf: {0x03a8},         // MATHEMATICAL BOLD CAPITAL PSI
+	0x1d6c0: {0x03a9},         // MATHEMATICAL BOLD CAPITAL OMEGA
+	0x1d6c1: {0x2207},         // MATHEMATICAL BOLD NABLA
+	0x1d6c2: {0x0061},         // MATHEMATICAL BOLD SMALL ALPHA
+	0x1d6c3: {0x00df},         // MATHEMATICAL BOLD SMALL BETA
+	0x1d6c4: {0x0079},         // MATHEMATICAL BOLD SMALL GAMMA
+	0x1d6c5: {0x1e9f},         // MATHEMATICAL BOLD SMALL DELTA
+	0x1d6c6: {0xa793},         // MATHEMATICAL BOLD SMALL EPSILON
+	0x1d6c7: {0x03b6},         // MATHEMATICAL BOLD SMALL ZETA
+	0x1d6c8: {0x006e, 0x0329}, // MATHEMATICAL BOLD SMALL ETA
+	0x1d6c9: {0x004f, 0x0335}, // MATHEMATICAL BOLD SMALL THETA
+	0x1d6ca: {0x0069},         // MATHEMATICAL BOLD SMALL IOTA
+	0x1d6cb: {0x0138},         // MATHEMATICAL BOLD SMALL KAPPA
+	0x1d6cc: {0x03bb},         // MATHEMATICAL BOLD SMALL LAMDA
+	0x1d6cd: {0x03bc},         // MATHEMATICAL BOLD SMALL MU
+	0x1d6ce: {0x0076},         // MATHEMATICAL BOLD SMALL NU
+	0x1d6cf: {0x03be},         // MATHEMATICAL BOLD SMALL XI
+	0x1d6d0: {0x006f},         // MATHEMATICAL BOLD SMALL OMICRON
+	0x1d6d1: {0x03c0},         // MATHEMATICAL BOLD SMALL PI
+	0x1d6d2: {0x0070},         // MATHEMATICAL BOLD SMALL RHO
+	0x1d6d3: {0x03c2},         // MATHEMATICAL BOLD SMALL FINAL SIGMA
+	0x1d6d4: {0x006f},         // MATHEMATICAL BOLD SMALL SIGMA
+	0x1d6d5: {0x1d1b},         // MATHEMATICAL BOLD SMALL TAU
+	0x1d6d6: {0x0075},         // MATHEMATICAL BOLD SMALL UPSILON
+	0x1d6d7: {0x0278},         // MATHEMATICAL BOLD SMALL PHI
+	0x1d6d8: {0x03c7},         // MATHEMATICAL BOLD SMALL CHI
+	0x1d6d9: {0x03c8},         // MATHEMATICAL BOLD SMALL PSI
+	0x1d6da: {0x03c9},         // MATHEMATICAL BOLD SMALL OMEGA
+	0x1d6db: {0x2202},         // MATHEMATICAL BOLD PARTIAL DIFFERENTIAL
+	0x1d6dc: {0xa793},         // MATHEMATICAL BOLD EPSILON SYMBOL
+	0x1d6dd: {0x004f, 0x0335}, // MATHEMATICAL BOLD THETA SYMBOL
+	0x1d6de: {0x0138},         // MATHEMATICAL BOLD KAPPA SYMBOL
+	0x1d6df: {0x0278},         // MATHEMATICAL BOLD PHI SYMBOL
+	0x1d6e0: {0x0070},         // MATHEMATICAL BOLD RHO SYMBOL
+	0x1d6e1: {0x03c0},         // MATHEMATICAL BOLD PI SYMBOL
+	0x1d6e2: {0x0041},         // MATHEMATICAL ITALIC CAPITAL ALPHA
+	0x1d6e3: {0x0042},         // MATHEMATICAL ITALIC CAPITAL BETA
+	0x1d6e4: {0x0393},         // MATHEMATICAL ITALIC CAPITAL GAMMA
+	0x1d6e5: {0x0394},         // MATHEMATICAL ITALIC CAPITAL DELTA
+	0x1d6e6: {0x0045},         // MATHEMATICAL ITALIC CAPITAL EPSILON
+	0x1d6e7: {0x005a},         // MATHEMATICAL ITALIC CAPITAL ZETA
+	0x1d6e8: {0x0048},         // MATHEMATICAL ITALIC CAPITAL ETA
+	0x1d6e9: {0x004f, 0x0335}, // MATHEMATICAL ITALIC CAPITAL THETA
+	0x1d6ea: {0x006c},         // MATHEMATICAL ITALIC CAPITAL IOTA
+	0x1d6eb: {0x004b},         // MATHEMATICAL ITALIC CAPITAL KAPPA
+	0x1d6ec: {0x0245},         // MATHEMATICAL ITALIC CAPITAL LAMDA
+	0x1d6ed: {0x004d},         // MATHEMATICAL ITALIC CAPITAL MU
+	0x1d6ee: {0x004e},         // MATHEMATICAL ITALIC CAPITAL NU
+	0x1d6ef: {0x039e},         // MATHEMATICAL ITALIC CAPITAL XI
+	0x1d6f0: {0x004f},         // MATHEMATICAL ITALIC CAPITAL OMICRON
+	0x1d6f1: {0x03a0},         // MATHEMATICAL ITALIC CAPITAL PI
+	0x1d6f2: {0x0050},         // MATHEMATICAL ITALIC CAPITAL RHO
+	0x1d6f3: {0x004f, 0x0335}, // MATHEMATICAL ITALIC CAPITAL THETA SYMBOL
+	0x1d6f4: {0x01a9},         // MATHEMATICAL ITALIC CAPITAL SIGMA
+	0x1d6f5: {0x0054},         // MATHEMATICAL ITALIC CAPITAL TAU
+	0x1d6f6: {0x0059},         // MATHEMATICAL ITALIC CAPITAL UPSILON
+	0x1d6f7: {0x03a6},         // MATHEMATICAL ITALIC CAPITAL PHI
+	0x1d6f8: {0x0058},         // MATHEMATICAL ITALIC CAPITAL CHI
+	0x1d6f9: {0x03a8},         // MATHEMATICAL ITALIC CAPITAL PSI
+	0x1d6fa: {0x03a9},         // MATHEMATICAL ITALIC CAPITAL OMEGA
+	0x1d6fb: {0x2207},         // MATHEMATICAL ITALIC NABLA
+	0x1d6fc: {0x0061},         // MATHEMATICAL ITALIC SMALL ALPHA
+	0x1d6fd: {0x00df},         // MATHEMATICAL ITALIC SMALL BETA
+	0x1d6fe: {0x0079},         // MATHEMATICAL ITALIC SMALL GAMMA
+	0x1d6ff: {0x1e9f},         // MATHEMATICAL ITALIC SMALL DELTA
+
+	0x1d700: {0xa793},         // MATHEMATICAL ITALIC SMALL EPSILON
+	0x1d701: {0x03b6},         // MATHEMATICAL ITALIC SMALL ZETA
+	0x1d702: {0x006e, 0x0329}, // MATHEMATICAL ITALIC SMALL ETA
+	0x1d703: {0x004f, 0x0335}, // MATHEMATICAL ITALIC SMALL THETA
+	0x1d704: {0x0069},         // MATHEMATICAL ITALIC SMALL IOTA
+	0x1d705: {0x0138},         // MATHEMATICAL ITALIC SMALL KAPPA
+	0x1d706: {0x03bb},         // MATHEMATICAL ITALIC SMALL LAMDA
+	0x1d707: {0x03bc},         // MATHEMATICAL ITALIC SMALL MU
+	0x1d708: {0x0076},         // MATHEMATICAL ITALIC SMALL NU
+	0x1d709: {0x03be},         // MATHEMATICAL ITALIC SMALL XI
+	0x1d70a: {0x006f},         // MATHEMATICAL ITALIC SMALL OMICRON
+	0x1d70b: {0x03c0},         // MATHEMATICAL ITALIC SMALL PI
+	0x1d70c: {0x0070},         // MATHEMATICAL ITALIC SMALL RHO
+	0x1d70d: {0x03c2},         // MATHEMATICAL ITALIC SMALL FINAL SIGMA
+	0x1d70e: {0x006f},         // MATHEMATICAL ITALIC SMALL SIGMA
+	0x1d70f: {0x1d1b},         // MATHEMATICAL ITALIC SMALL TAU
+	0x1d710: {0x0075},         // MATHEMATICAL ITALIC SMALL UPSILON
+	0x1d711: {0x0278},         // MATHEMATICAL ITALIC SMALL PHI
+	0x1d712: {0x03c7},         // MATHEMATICAL ITALIC SMALL CHI
+	0x1d713: {0x03c8},         // MATHEMATICAL ITALIC SMALL PSI
+	0x1d714: {0x03c9},         // MATHEMATICAL ITALIC SMALL OMEGA
+	0x1d715: {0x2202},         // MATHEMATICAL ITALIC PARTIAL DIFFERENTIAL
+	0x1d716: {0xa793},         // MATHEMATICAL ITALIC EPSILON SYMBOL
+	0x1d717: {0x004f, 0x0335}, // MATHEMATICAL ITALIC THETA SYMBOL
+	0x1d718: {0x0138},         // MATHEMATICAL ITALIC KAPPA SYMBOL
+	0x1d719: {0x0278},         // MATHEMATICAL ITALIC PHI SYMBOL
+	0x1d71a: {0x0070},         // MATHEMATICAL ITALIC RHO SYMBOL
+	0x1d71b: {0x03c0},         // MATHEMATICAL ITALIC PI SYMBOL
+	0x1d71c: {0x0041},         // MATHEMATICAL BOLD ITALIC CAPITAL ALPHA
+	0x1d71d: {0x0042},         // MATHEMATICAL BOLD ITALIC CAPITAL BETA
+	0x1d71e: {0x0393},         // MATHEMATICAL BOLD ITALIC CAPITAL GAMMA
+	0x1d71f: {0x0394},         // MATHEMATICAL BOLD ITALIC CAPITAL DELTA
+	0x1d720: {0x0045},         // MATHEMATICAL BOLD ITALIC CAPITAL EPSILON
+	0x1d721: {0x005a},         // MATHEMATICAL BOLD ITALIC CAPITAL ZETA
+	0x1d722: {0x0048},         // MATHEMATICAL BOLD ITALIC CAPITAL ETA
+	0x1d723: {0x004f, 0x0335}, // MATHEMATICAL BOLD ITALIC CAPITAL THETA
+	0x1d724: {0x006c},         // MATHEMATICAL BOLD ITALIC CAPITAL IOTA
+	0x1d725: {0x004b},         // MATHEMATICAL BOLD ITALIC CAPITAL KAPPA
+	0x1d726: {0x0245},         // MATHEMATICAL BOLD ITALIC CAPITAL LAMDA
+	0x1d727: {0x004d},         // MATHEMATICAL BOLD ITALIC CAPITAL MU
+	0x1d728: {0x004e},         // MATHEMATICAL BOLD ITALIC CAPITAL NU
+	0x1d729: {0x039e},         // MATHEMATICAL BOLD ITALIC CAPITAL XI
+	0x1d72a: {0x004f},         // MATHEMATICAL BOLD ITALIC CAPITAL OMICRON
+	0x1d72b: {0x03a0},         // MATHEMATICAL BOLD ITALIC CAPITAL PI
+	0x1d72c: {0x0050},         // MATHEMATICAL BOLD ITALIC CAPITAL RHO
+	0x1d72d: {0x004f, 0x0335}, // MATHEMATICAL BOLD ITALIC CAPITAL THETA SYMBOL
+	0x1d72e: {0x01a9},         // MATHEMATICAL BOLD ITALIC CAPITAL SIGMA
+	0x1d72f: {0x0054},         // MATHEMATICAL BOLD ITALIC CAPITAL TAU
+	0x1d730: {0x0059},         // MATHEMATICAL BOLD ITALIC CAPITAL UPSILON
+	0x1d731: {0x03a6},         // MATHEMATICAL BOLD ITALIC CAPITAL PHI
+	0x1d732: {0x0058},         // MATHEMATICAL BOLD ITALIC CAPITAL CHI
+	0x1d733: {0x03a8},         // MATHEMATICAL BOLD ITALIC CAPITAL PSI
+	0x1d734: {0x03a9},         // MATHEMATICAL BOLD ITALIC CAPITAL OMEGA
+	0x1d735: {0x2207},         // MATHEMATICAL BOLD ITALIC NABLA
+	0x1d736: {0x0061},         // MATHEMATICAL BOLD ITALIC SMALL ALPHA
+	0x1d737: {0x00df},         // MATHEMATICAL BOLD ITALIC SMALL BETA
+	0x1d738: {0x0079},         // MATHEMATICAL BOLD ITALIC SMALL GAMMA
+	0x1d739: {0x1e9f},         // MATHEMATICAL BOLD ITALIC SMALL DELTA
+	0x1d73a: {0xa793},         // MATHEMATICAL BOLD ITALIC SMALL EPSILON
+	0x1d73b: {0x03b6},         // MATHEMATICAL BOLD ITALIC SMALL ZETA
+	0x1d73c: {0x006e, 0x0329}, // MATHEMATICAL BOLD ITALIC SMALL ETA
+	0x1d73d: {0x004f, 0x0335}, // MATHEMATICAL BOLD ITALIC SMALL THETA
+	0x1d73e: {0x0069},         // MATHEMATICAL BOLD ITALIC SMALL IOTA
+	0x1d73f: {0x0138},         // MATHEMATICAL BOLD ITALIC SMALL KAPPA
+	0x1d740: {0x03bb},         // MATHEMATICAL BOLD ITALIC SMALL LAMDA
+	0x1d741: {0x03bc},         // MATHEMATICAL BOLD ITALIC SMALL MU
+	0x1d742: {0x0076},         // MATHEMATICAL BOLD ITALIC SMALL NU
+	0x1d743: {0x03be},         // MATHEMATICAL BOLD ITALIC SMALL XI
+	0x1d744: {0x006f},         // MATHEMATICAL BOLD ITALIC SMALL OMICRON
+	0x1d745: {0x03c0},         // MATHEMATICAL BOLD ITALIC SMALL PI
+	0x1d746: {0x0070},         // MATHEMATICAL BOLD ITALIC SMALL RHO
+	0x1d747: {0x03c2},         // MATHEMATICAL BOLD ITALIC SMALL FINAL SIGMA
+	0x1d748: {0x006f},         // MATHEMATICAL BOLD ITALIC SMALL SIGMA
+	0x1d749: {0x1d1b},         // MATHEMATICAL BOLD ITALIC SMALL TAU
+	0x1d74a: {0x0075},         // MATHEMATICAL BOLD ITALIC SMALL UPSILON
+	0x1d74b: {0x0278},         // MATHEMATICAL BOLD ITALIC SMALL PHI
+	0x1d74c: {0x03c7},         // MATHEMATICAL BOLD ITALIC SMALL CHI
+	0x1d74d: {0x03c8},         // MATHEMATICAL BOLD ITALIC SMALL PSI
+	0x1d74e: {0x03c9},         // MATHEMATICAL BOLD ITALIC SMALL OMEGA
+	0x1d74f: {0x2202},         // MATHEMATICAL BOLD ITALIC PARTIAL DIFFERENTIAL
+	0x1d750: {0xa793},         // MATHEMATICAL BOLD ITALIC EPSILON SYMBOL
+	0x1d751: {0x004f, 0x0335}, // MATHEMATICAL BOLD ITALIC THETA SYMBOL
+	0x1d752: {0x0138},         // MATHEMATICAL BOLD ITALIC KAPPA SYMBOL
+	0x1d753: {0x0278},         // MATHEMATICAL BOLD ITALIC PHI SYMBOL
+	0x1d754: {0x0070},         // MATHEMATICAL BOLD ITALIC RHO SYMBOL
+	0x1d755: {0x03c0},         // MATHEMATICAL BOLD ITALIC PI SYMBOL
+	0x1d756: {0x0041},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL ALPHA
+	0x1d757: {0x0042},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL BETA
+	0x1d758: {0x0393},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL GAMMA
+	0x1d759: {0x0394},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL DELTA
+	0x1d75a: {0x0045},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL EPSILON
+	0x1d75b: {0x005a},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL ZETA
+	0x1d75c: {0x0048},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL ETA
+	0x1d75d: {0x004f, 0x0335}, // MATHEMATICAL SANS-SERIF BOLD CAPITAL THETA
+	0x1d75e: {0x006c},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL IOTA
+	0x1d75f: {0x004b},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL KAPPA
+	0x1d760: {0x0245},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL LAMDA
+	0x1d761: {0x004d},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL MU
+	0x1d762: {0x004e},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL NU
+	0x1d763: {0x039e},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL XI
+	0x1d764: {0x004f},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL OMICRON
+	0x1d765: {0x03a0},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL PI
+	0x1d766: {0x0050},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL RHO
+	0x1d767: {0x004f, 0x0335}, // MATHEMATICAL SANS-SERIF BOLD CAPITAL THETA SYMBOL
+	0x1d768: {0x01a9},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL SIGMA
+	0x1d769: {0x0054},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL TAU
+	0x1d76a: {0x0059},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL UPSILON
+	0x1d76b: {0x03a6},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL PHI
+	0x1d76c: {0x0058},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL CHI
+	0x1d76d: {0x03a8},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL PSI
+	0x1d76e: {0x03a9},         // MATHEMATICAL SANS-SERIF BOLD CAPITAL OMEGA
+	0x1d76f: {0x2207},         // MATHEMATICAL SANS-SERIF BOLD NABLA
+	0x1d770: {0x0061},         // MATHEMATICAL SANS-SERIF BOLD SMALL ALPHA
+	0x1d771: {0x00df},         // MATHEMATICAL SANS-SERIF BOLD SMALL BETA
+	0x1d772: {0x0079},         // MATHEMATICAL SANS-SERIF BOLD SMALL GAMMA
+	0x1d773: {0x1e9f},         // MATHEMATICAL SANS-SERIF BOLD SMALL DELTA
+	0x1d774: {0xa793},         // MATHEMATICAL SANS-SERIF BOLD SMALL EPSILON
+	0x1d775: {0x03b6},         // MATHEMATICAL SANS-SERIF BOLD SMALL ZETA
+	0x1d776: {0x006e, 0x0329}, // MATHEMATICAL SANS-SERIF BOLD SMALL ETA
+	0x1d777: {0x004f, 0x0335}, // MATHEMATICAL SANS-SERIF BOLD SMALL THETA
+	0x1d778: {0x0069},         // MATHEMATICAL SANS-SERIF BOLD SMALL IOTA
+	0x1d779: {0x0138},         // MATHEMATICAL SANS-SERIF BOLD SMALL KAPPA
+	0x1d77a: {0x03bb},         // MATHEMATICAL SANS-SERIF BOLD SMALL LAMDA
+	0x1d77b: {0x03bc},         // MATHEMATICAL SANS-SERIF BOLD SMALL MU
+	0x1d77c: {0x0076},         // MATHEMATICAL SANS-SERIF BOLD SMALL NU
+	0x1d77d: {0x03be},         // MATHEMATICAL SANS-SERIF BOLD SMALL XI
+	0x1d77e: {0x006f},         // MATHEMATICAL SANS-SERIF BOLD SMALL OMICRON
+	0x1d77f: {0x03c0},         // MATHEMATICAL SANS-SERIF BOLD SMALL PI
+	0x1d780: {0x0070},         // MATHEMATICAL SANS-SERIF BOLD SMALL RHO
+	0x1d781: {0x03c2},         // MATHEMATICAL SANS-SERIF BOLD SMALL FINAL SIGMA
+	0x1d782: {0x006f},         // MATHEMATICAL SANS-SERIF BOLD SMALL SIGMA
+	0x1d783: {0x1d1b},         // MATHEMATICAL SANS-SERIF BOLD SMALL TAU
+	0x1d784: {0x0075},         // MATHEMATICAL SANS-SERIF BOLD SMALL UPSILON
+	0x1d785: {0x0278},         // MATHEMATICAL SANS-SERIF BOLD SMALL PHI
+	0x1d786: {0x03c7},         // MATHEMATICAL SANS-SERIF BOLD SMALL CHI
+	0x1d787: {0x03c8},         // MATHEMATICAL SANS-SERIF BOLD SMALL PSI
+	0x1d788: {0x03c9},         // MATHEMATICAL SANS-SERIF BOLD SMALL OMEGA
+	0x1d789: {0x2202},         // MATHEMATICAL SANS-SERIF BOLD PARTIAL DIFFERENTIAL
+	0x1d78a: {0xa793},         // MATHEMATICAL SANS-SERIF BOLD EPSILON SYMBOL
+	0x1d78b: {0x004f, 0x0335}, // MATHEMATICAL SANS-SERIF BOLD THETA SYMBOL
+	0x1d78c: {0x0138},         // MATHEMATICAL SANS-SERIF BOLD KAPPA SYMBOL
+	0x1d78d: {0x0278},         // MATHEMATICAL SANS-SERIF BOLD PHI SYMBOL
+	0x1d78e: {0x0070},         // MATHEMATICAL SANS-SERIF BOLD RHO SYMBOL
+	0x1d78f: {0x03c0},         // MATHEMATICAL SANS-SERIF BOLD PI SYMBOL
+	0x1d790: {0x0041},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ALPHA
+	0x1d791: {0x0042},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL BETA
+	0x1d792: {0x0393},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL GAMMA
+	0x1d793: {0x0394},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL DELTA
+	0x1d794: {0x0045},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL EPSILON
+	0x1d795: {0x005a},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ZETA
+	0x1d796: {0x0048},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ETA
+	0x1d797: {0x004f, 0x0335}, // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL THETA
+	0x1d798: {0x006c},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL IOTA
+	0x1d799: {0x004b},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL KAPPA
+	0x1d79a: {0x0245},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL LAMDA
+	0x1d79b: {0x004d},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL MU
+	0x1d79c: {0x004e},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL NU
+	0x1d79d: {0x039e},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL XI
+	0x1d79e: {0x004f},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMICRON
+	0x1d79f: {0x03a0},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PI
+	0x1d7a0: {0x0050},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL RHO
+	0x1d7a1: {0x004f, 0x0335}, // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL THETA SYMBOL
+	0x1d7a2: {0x01a9},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL SIGMA
+	0x1d7a3: {0x0054},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL TAU
+	0x1d7a4: {0x0059},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL UPSILON
+	0x1d7a5: {0x03a6},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PHI
+	0x1d7a6: {0x0058},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL CHI
+	0x1d7a7: {0x03a8},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PSI
+	0x1d7a8: {0x03a9},         // MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMEGA
+	0x1d7a9: {0x2207},         // MATHEMATICAL SANS-SERIF BOLD ITALIC NABLA
+	0x1d7aa: {0x0061},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ALPHA
+	0x1d7ab: {0x00df},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL BETA
+	0x1d7ac: {0x0079},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL GAMMA
+	0x1d7ad: {0x1e9f},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL DELTA
+	0x1d7ae: {0xa793},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL EPSILON
+	0x1d7af: {0x03b6},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ZETA
+	0x1d7b0: {0x006e, 0x0329}, // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ETA
+	0x1d7b1: {0x004f, 0x0335}, // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL THETA
+	0x1d7b2: {0x0069},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL IOTA
+	0x1d7b3: {0x0138},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL KAPPA
+	0x1d7b4: {0x03bb},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL LAMDA
+	0x1d7b5: {0x03bc},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL MU
+	0x1d7b6: {0x0076},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL NU
+	0x1d7b7: {0x03be},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL XI
+	0x1d7b8: {0x006f},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMICRON
+	0x1d7b9: {0x03c0},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PI
+	0x1d7ba: {0x0070},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL RHO
+	0x1d7bb: {0x03c2},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL FINAL SIGMA
+	0x1d7bc: {0x006f},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL SIGMA
+	0x1d7bd: {0x1d1b},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL TAU
+	0x1d7be: {0x0075},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL UPSILON
+	0x1d7bf: {0x0278},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PHI
+	0x1d7c0: {0x03c7},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL CHI
+	0x1d7c1: {0x03c8},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PSI
+	0x1d7c2: {0x03c9},         // MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMEGA
+	0x1d7c3: {0x2202},         // MATHEMATICAL SANS-SERIF BOLD ITALIC PARTIAL DIFFERENTIAL
+	0x1d7c4: {0xa793},         // MATHEMATICAL SANS-SERIF BOLD ITALIC EPSILON SYMBOL
+	0x1d7c5: {0x004f, 0x0335}, // MATHEMATICAL SANS-SERIF BOLD ITALIC THETA SYMBOL
+	0x1d7c6: {0x0138},         // MATHEMATICAL SANS-SERIF BOLD ITALIC KAPPA SYMBOL
+	0x1d7c7: {0x0278},         // MATHEMATICAL SANS-SERIF BOLD ITALIC PHI SYMBOL
+	0x1d7c8: {0x0070},         // MATHEMATICAL SANS-SERIF BOLD ITALIC RHO SYMBOL
+	0x1d7c9: {0x03c0},         // MATHEMATICAL SANS-SERIF BOLD ITALIC PI SYMBOL
+	0x1d7ca: {0x0046},         // MATHEMATICAL BOLD CAPITAL DIGAMMA
+	0x1d7cb: {0x03dd},         // MATHEMATICAL BOLD SMALL DIGAMMA
+	0x1d7ce: {0x004f},         // MATHEMATICAL BOLD DIGIT ZERO
+	0x1d7cf: {0x006c},         // MATHEMATICAL BOLD DIGIT ONE
+	0x1d7d0: {0x0032},         // MATHEMATICAL BOLD DIGIT TWO
+	0x1d7d1: {0x0033},         // MATHEMATICAL BOLD DIGIT THREE
+	0x1d7d2: {0x0034},         // MATHEMATICAL BOLD DIGIT FOUR
+	0x1d7d3: {0x0035},         // MATHEMATICAL BOLD DIGIT FIVE
+	0x1d7d4: {0x0036},         // MATHEMATICAL BOLD DIGIT SIX
+	0x1d7d5: {0x0037},         // MATHEMATICAL BOLD DIGIT SEVEN
+	0x1d7d6: {0x0038},         // MATHEMATICAL BOLD DIGIT EIGHT
+	0x1d7d7: {0x0039},         // MATHEMATICAL BOLD DIGIT NINE
+	0x1d7d8: {0x004f},         // MATHEMATICAL DOUBLE-STRUCK DIGIT ZERO
+	0x1d7d9: {0x006c},         // MATHEMATICAL DOUBLE-STRUCK DIGIT ONE
+	0x1d7da: {0x0032},         // MATHEMATICAL DOUBLE-STRUCK DIGIT TWO
+	0x1d7db: {0x0033},         // MATHEMATICAL DOUBLE-STRUCK DIGIT THREE
+	0x1d7dc: {0x0034},         // MATHEMATICAL DOUBLE-STRUCK DIGIT FOUR
+	0x1d7dd: {0x0035},         // MATHEMATICAL DOUBLE-STRUCK DIGIT FIVE
+	0x1d7de: {0x0036},         // MATHEMATICAL DOUBLE-STRUCK DIGIT SIX
+	0x1d7df: {0x0037},         // MATHEMATICAL DOUBLE-STRUCK DIGIT SEVEN
+	0x1d7e0: {0x0038},         // MATHEMATICAL DOUBLE-STRUCK DIGIT EIGHT
+	0x1d7e1: {0x0039},         // MATHEMATICAL DOUBLE-STRUCK DIGIT NINE
+	0x1d7e2: {0x004f},         // MATHEMATICAL SANS-SERIF DIGIT ZERO
+	0x1d7e3: {0x006c},         // MATHEMATICAL SANS-SERIF DIGIT ONE
+	0x1d7e4: {0x0032},         // MATHEMATICAL SANS-SERIF DIGIT TWO
+	0x1d7e5: {0x0033},         // MATHEMATICAL SANS-SERIF DIGIT THREE
+	0x1d7e6: {0x0034},         // MATHEMATICAL SANS-SERIF DIGIT FOUR
+	0x1d7e7: {0x0035},         // MATHEMATICAL SANS-SERIF DIGIT FIVE
+	0x1d7e8: {0x0036},         // MATHEMATICAL SANS-SERIF DIGIT SIX
+	0x1d7e9: {0x0037},         // MATHEMATICAL SANS-SERIF DIGIT SEVEN
+	0x1d7ea: {0x0038},         // MATHEMATICAL SANS-SERIF DIGIT EIGHT
+	0x1d7eb: {0x0039},         // MATHEMATICAL SANS-SERIF DIGIT NINE
+	0x1d7ec: {0x004f},         // MATHEMATICAL SANS-SERIF BOLD DIGIT ZERO
+	0x1d7ed: {0x006c},         // MATHEMATICAL SANS-SERIF BOLD DIGIT ONE
+	0x1d7ee: {0x0032},         // MATHEMATICAL SANS-SERIF BOLD DIGIT TWO
+	0x1d7ef: {0x0033},         // MATHEMATICAL SANS-SERIF BOLD DIGIT THREE
+	0x1d7f0: {0x0034},         // MATHEMATICAL SANS-SERIF BOLD DIGIT FOUR
+	0x1d7f1: {0x0035},         // MATHEMATICAL SANS-SERIF BOLD DIGIT FIVE
+	0x1d7f2: {0x0036},         // MATHEMATICAL SANS-SERIF BOLD DIGIT SIX
+	0x1d7f3: {0x0037},         // MATHEMATICAL SANS-SERIF BOLD DIGIT SEVEN
+	0x1d7f4: {0x0038},         // MATHEMATICAL SANS-SERIF BOLD DIGIT EIGHT
+	0x1d7f5: {0x0039},         // MATHEMATICAL SANS-SERIF BOLD DIGIT NINE
+	0x1d7f6: {0x004f},         // MATHEMATICAL MONOSPACE DIGIT ZERO
+	0x1d7f7: {0x006c},         // MATHEMATICAL MONOSPACE DIGIT ONE
+	0x1d7f8: {0x0032},         // MATHEMATICAL MONOSPACE DIGIT TWO
+	0x1d7f9: {0x0033},         // MATHEMATICAL MONOSPACE DIGIT THREE
+	0x1d7fa: {0x0034},         // MATHEMATICAL MONOSPACE DIGIT FOUR
+	0x1d7fb: {0x0035},         // MATHEMATICAL MONOSPACE DIGIT FIVE
+	0x1d7fc: {0x0036},         // MATHEMATICAL MONOSPACE DIGIT SIX
+	0x1d7fd: {0x0037},         // MATHEMATICAL MONOSPACE DIGIT SEVEN
+	0x1d7fe: {0x0038},         // MATHEMATICAL MONOSPACE DIGIT EIGHT
+	0x1d7ff: {0x0039},         // MATHEMATICAL MONOSPACE DIGIT NINE
+
+	0x1e8c7: {0x006c},         // MENDE KIKAKUI DIGIT ONE
+	0x1e8c8: {0x2220},         // MENDE KIKAKUI DIGIT TWO
+	0x1e8c9: {0x0663},         // MENDE KIKAKUI DIGIT THREE
+	0x1e8cb: {0x0038},         // MENDE KIKAKUI DIGIT FIVE
+	0x1e8cc: {0x2202},         // MENDE KIKAKUI DIGIT SIX
+	0x1e8cd: {0x2202, 0x0335}, // MENDE KIKAKUI DIGIT SEVEN
+
+	0x1ee00: {0x006c},         // ARABIC MATHEMATICAL ALEF
+	0x1ee01: {0x0628},         // ARABIC MATHEMATICAL BEH
+	0x1ee02: {0x062c},         // ARABIC MATHEMATICAL JEEM
+	0x1ee03: {0x062f},         // ARABIC MATHEMATICAL DAL
+	0x1ee05: {0x0648},         // ARABIC MATHEMATICAL WAW
+	0x1ee06: {0x0632},         // ARABIC MATHEMATICAL ZAIN
+	0x1ee07: {0x062d},         // ARABIC MATHEMATICAL HAH
+	0x1ee08: {0x0637},         // ARABIC MATHEMATICAL TAH
+	0x1ee09: {0x0649},         // ARABIC MATHEMATICAL YEH
+	0x1ee0a: {0x0643},         // ARABIC MATHEMATICAL KAF
+	0x1ee0b: {0x0644},         // ARABIC MATHEMATICAL LAM
+	0x1ee0c: {0x0645},         // ARABIC MATHEMATICAL MEEM
+	0x1ee0d: {0x0646},         // ARABIC MATHEMATICAL NOON
+	0x1ee0e: {0x0633},         // ARABIC MATHEMATICAL SEEN
+	0x1ee0f: {0x0639},         // ARABIC MATHEMATICAL AIN
+	0x1ee10: {0x0641},         // ARABIC MATHEMATICAL FEH
+	0x1ee11: {0x0635},         // ARABIC MATHEMATICAL SAD
+	0x1ee12: {0x0642},         // ARABIC MATHEMATICAL QAF
+	0x1ee13: {0x0631},         // ARABIC MATHEMATICAL REH
+	0x1ee14: {0x0633, 0x06db}, // ARABIC MATHEMATICAL SHEEN
+	0x1ee15: {0x062a},         // ARABIC MATHEMATICAL TEH
+	0x1ee16: {0x0649, 0x06db}, // ARABIC MATHEMATICAL THEH
+	0x1ee17: {0x062e},         // ARABIC MATHEMATICAL KHAH
+	0x1ee18: {0x0630},         // ARABIC MATHEMATICAL THAL
+	0x1ee19: {0x0636},         // ARABIC MATHEMATICAL DAD
+	0x1ee1a: {0x0638},         // ARABIC MATHEMATICAL ZAH
+	0x1ee1b: {0x063a},         // ARABIC MATHEMATICAL GHAIN
+	0x1ee1c: {0x0649},         // ARABIC MATHEMATICAL DOTLESS BEH
+	0x1ee1d: {0x0649},         // ARABIC MATHEMATICAL DOTLESS NOON
+	0x1ee1e: {0x06a1},         // ARABIC MATHEMATICAL DOTLESS FEH
+	0x1ee1f: {0x06a1},         // ARABIC MATHEMATICAL DOTLESS QAF
+	0x1ee21: {0x0628},         // ARABIC MATHEMATICAL INITIAL BEH
+	0x1ee22: {0x062c},         // ARABIC MATHEMATICAL INITIAL JEEM
+	0x1ee24: {0x006f},         // ARABIC MATHEMATICAL INITIAL HEH
+	0x1ee27: {0x062d},         // ARABIC MATHEMATICAL INITIAL HAH
+	0x1ee29: {0x0649},         // ARABIC MATHEMATICAL INITIAL YEH
+	0x1ee2a: {0x0643},         // ARABIC MATHEMATICAL INITIAL KAF
+	0x1ee2b: {0x0644},         // ARABIC MATHEMATICAL INITIAL LAM
+	0x1ee2c: {0x0645},         // ARABIC MATHEMATICAL INITIAL MEEM
+	0x1ee2d: {0x0646},         // ARABIC MATHEMATICAL INITIAL NOON
+	0x1ee2e: {0x0633},         // ARABIC MATHEMATICAL INITIAL SEEN
+	0x1ee2f: {0x0639},         // ARABIC MATHEMATICAL INITIAL AIN
+	0x1ee30: {0x0641},         // ARABIC MATHEMATICAL INITIAL FEH
+	0x1ee31: {0x0635},         // ARABIC MATHEMATICAL INITIAL SAD
+	0x1ee32: {0x0642},         // ARABIC MATHEMATICAL INITIAL QAF
+	0x1ee34: {0x0633, 0x06db}, // ARABIC MATHEMATICAL INITIAL SHEEN
+	0x1ee35: {0x062a},         // ARABIC MATHEMATICAL INITIAL TEH
+	0x1ee36: {0x0649, 0x06db}, // ARABIC MATHEMATICAL INITIAL THEH
+	0x1ee37: {0x062e},         // ARABIC MATHEMATICAL INITIAL KHAH
+	0x1ee39: {0x0636},         // ARABIC MATHEMATICAL INITIAL DAD
+	0x1ee3b: {0x063a},         // ARABIC MATHEMATICAL INITIAL GHAIN
+	0x1ee42: {0x062c},         // ARABIC MATHEMATICAL TAILED JEEM
+	0x1ee47: {0x062d},         // ARABIC MATHEMATICAL TAILED HAH
+	0x1ee49: {0x0649},         // ARABIC MATHEMATICAL TAILED YEH
+	0x1ee4b: {0x0644},         // ARABIC MATHEMATICAL TAILED LAM
+	0x1ee4d: {0x0646},         // ARABIC MATHEMATICAL TAILED NOON
+	0x1ee4e: {0x0633},         // ARABIC MATHEMATICAL TAILED SEEN
+	0x1ee4f: {0x0639},         // ARABIC MATHEMATICAL TAILED AIN
+	0x1ee51: {0x0635},         // ARABIC MATHEMATICAL TAILED SAD
+	0x1ee52: {0x0642},         // ARABIC MATHEMATICAL TAILED QAF
+	0x1ee54: {0x0633, 0x06db}, // ARABIC MATHEMATICAL TAILED SHEEN
+	0x1ee57: {0x062e},         // ARABIC MATHEMATICAL TAILED KHAH
+	0x1ee59: {0x0636},         // ARABIC MATHEMATICAL TAILED DAD
+	0x1ee5b: {0x063a},         // ARABIC MATHEMATICAL TAILED GHAIN
+	0x1ee5d: {0x0649},         // ARABIC MATHEMATICAL TAILED DOTLESS NOON
+	0x1ee5f: {0x06a1},         // ARABIC MATHEMATICAL TAILED DOTLESS QAF
+	0x1ee61: {0x0628},         // ARABIC MATHEMATICAL STRETCHED BEH
+	0x1ee62: {0x062c},         // ARABIC MATHEMATICAL STRETCHED JEEM
+	0x1ee64: {0x006f},         // ARABIC MATHEMATICAL STRETCHED HEH
+	0x1ee67: {0x062d},         // ARABIC MATHEMATICAL STRETCHED HAH
+	0x1ee68: {0x0637},         // ARABIC MATHEMATICAL STRETCHED TAH
+	0x1ee69: {0x0649},         // ARABIC MATHEMATICAL STRETCHED YEH
+	0x1ee6a: {0x0643},         // ARABIC MATHEMATICAL STRETCHED KAF
+	0x1ee6c: {0x0645},         // ARABIC MATHEMATICAL STRETCHED MEEM
+	0x1ee6d: {0x0646},         // ARABIC MATHEMATICAL STRETCHED NOON
+	0x1ee6e: {0x0633},         // ARABIC MATHEMATICAL STRETCHED SEEN
+	0x1ee6f: {0x0639},         // ARABIC MATHEMATICAL STRETCHED AIN
+	0x1ee70: {0x0641},         // ARABIC MATHEMATICAL STRETCHED FEH
+	0x1ee71: {0x0635},         // ARABIC MATHEMATICAL STRETCHED SAD
+	0x1ee72: {0x0642},         // ARABIC MATHEMATICAL STRETCHED QAF
+	0x1ee74: {0x0633, 0x06db}, // ARABIC MATHEMATICAL STRETCHED SHEEN
+	0x1ee75: {0x062a},         // ARABIC MATHEMATICAL STRETCHED TEH
+	0x1ee76: {0x0649, 0x06db}, // ARABIC MATHEMATICAL STRETCHED THEH
+	0x1ee77: {0x062e},         // ARABIC MATHEMATICAL STRETCHED KHAH
+	0x1ee79: {0x0636},         // ARABIC MATHEMATICAL STRETCHED DAD
+	0x1ee7a: {0x0638},         // ARABIC MATHEMATICAL STRETCHED ZAH
+	0x1ee7b: {0x063a},         // ARABIC MATHEMATICAL STRETCHED GHAIN
+	0x1ee7c: {0x0649},         // ARABIC MATHEMATICAL STRETCHED DOTLESS BEH
+	0x1ee7e: {0x06a1},         // ARABIC MATHEMATICAL STRETCHED DOTLESS FEH
+	0x1ee80: {0x006c},         // ARABIC MATHEMATICAL LOOPED ALEF
+	0x1ee81: {0x0628},         // ARABIC MATHEMATICAL LOOPED BEH
+	0x1ee82: {0x062c},         // ARABIC MATHEMATICAL LOOPED JEEM
+	0x1ee83: {0x062f},         // ARABIC MATHEMATICAL LOOPED DAL
+	0x1ee84: {0x006f},         // ARABIC MATHEMATICAL LOOPED HEH
+	0x1ee85: {0x0648},         // ARABIC MATHEMATICAL LOOPED WAW
+	0x1ee86: {0x0632},         // ARABIC MATHEMATICAL LOOPED ZAIN
+	0x1ee87: {0x062d},         // ARABIC MATHEMATICAL LOOPED HAH
+	0x1ee88: {0x0637},         // ARABIC MATHEMATICAL LOOPED TAH
+	0x1ee89: {0x0649},         // ARABIC MATHEMATICAL LOOPED YEH
+	0x1ee8b: {0x0644},         // ARABIC MATHEMATICAL LOOPED LAM
+	0x1ee8c: {0x0645},         // ARABIC MATHEMATICAL LOOPED MEEM
+	0x1ee8d: {0x0646},         // ARABIC MATHEMATICAL LOOPED NOON
+	0x1ee8e: {0x0633},         // ARABIC MATHEMATICAL LOOPED SEEN
+	0x1ee8f: {0x0639},         // ARABIC MATHEMATICAL LOOPED AIN
+	0x1ee90: {0x0641},         // ARABIC MATHEMATICAL LOOPED FEH
+	0x1ee91: {0x0635},         // ARABIC MATHEMATICAL LOOPED SAD
+	0x1ee92: {0x0642},         // ARABIC MATHEMATICAL LOOPED QAF
+	0x1ee93: {0x0631},         // ARABIC MATHEMATICAL LOOPED REH
+	0x1ee94: {0x0633, 0x06db}, // ARABIC MATHEMATICAL LOOPED SHEEN
+	0x1ee95: {0x062a},         // ARABIC MATHEMATICAL LOOPED TEH
+	0x1ee96: {0x0649, 0x06db}, // ARABIC MATHEMATICAL LOOPED THEH
+	0x1ee97: {0x062e},         // ARABIC MATHEMATICAL LOOPED KHAH
+	0x1ee98: {0x0630},         // ARABIC MATHEMATICAL LOOPED THAL
+	0x1ee99: {0x0636},         // ARABIC MATHEMATICAL LOOPED DAD
+	0x1ee9a: {0x0638},         // ARABIC MATHEMATICAL LOOPED ZAH
+	0x1ee9b: {0x063a},         // ARABIC MATHEMATICAL LOOPED GHAIN
+	0x1eea1: {0x0628},         // ARABIC MATHEMATICAL DOUBLE-STRUCK BEH
+	0x1eea2: {0x062c},         // ARABIC MATHEMATICAL DOUBLE-STRUCK JEEM
+	0x1eea3: {0x062f},         // ARABIC MATHEMATICAL DOUBLE-STRUCK DAL
+	0x1eea5: {0x0648},         // ARABIC MATHEMATICAL DOUBLE-STRUCK WAW
+	0x1eea6: {0x0632},         // ARABIC MATHEMATICAL DOUBLE-STRUCK ZAIN
+	0x1eea7: {0x062d},         // ARABIC MATHEMATICAL DOUBLE-STRUCK HAH
+	0x1eea8: {0x0637},         // ARABIC MATHEMATICAL DOUBLE-STRUCK TAH
+	0x1eea9: {0x0649},         // ARABIC MATHEMATICAL DOUBLE-STRUCK YEH
+	0x1eeab: {0x0644},         // ARABIC MATHEMATICAL DOUBLE-STRUCK LAM
+	0x1eeac: {0x0645},         // ARABIC MATHEMATICAL DOUBLE-STRUCK MEEM
+	0x1eead: {0x0646},         // ARABIC MATHEMATICAL DOUBLE-STRUCK NOON
+	0x1eeae: {0x0633},         // ARABIC MATHEMATICAL DOUBLE-STRUCK SEEN
+	0x1eeaf: {0x0639},         // ARABIC MATHEMATICAL DOUBLE-STRUCK AIN
+	0x1eeb0: {0x0641},         // ARABIC MATHEMATICAL DOUBLE-STRUCK FEH
+	0x1eeb1: {0x0635},         // ARABIC MATHEMATICAL DOUBLE-STRUCK SAD
+	0x1eeb2: {0x0642},         // ARABIC MATHEMATICAL DOUBLE-STRUCK QAF
+	0x1eeb3: {0x0631},         // ARABIC MATHEMATICAL DOUBLE-STRUCK REH
+	0x1eeb4: {0x0633, 0x06db}, // ARABIC MATHEMATICAL DOUBLE-STRUCK SHEEN
+	0x1eeb5: {0x062a},         // ARABIC MATHEMATICAL DOUBLE-STRUCK TEH
+	0x1eeb6: {0x0649, 0x06db}, // ARABIC MATHEMATICAL DOUBLE-STRUCK THEH
+	0x1eeb7: {0x062e},         // ARABIC MATHEMATICAL DOUBLE-STRUCK KHAH
+	0x1eeb8: {0x0630},         // ARABIC MATHEMATICAL DOUBLE-STRUCK THAL
+	0x1eeb9: {0x0636},         // ARABIC MATHEMATICAL DOUBLE-STRUCK DAD
+	0x1eeba: {0x0638},         // ARABIC MATHEMATICAL DOUBLE-STRUCK ZAH
+	0x1eebb: {0x063a},         // ARABIC MATHEMATICAL DOUBLE-STRUCK GHAIN
+
+	0x1f100: {0x004f, 0x002e},         // DIGIT ZERO FULL STOP
+	0x1f101: {0x004f, 0x002c},         // DIGIT ZERO COMMA
+	0x1f102: {0x006c, 0x002c},         // DIGIT ONE COMMA
+	0x1f103: {0x0032, 0x002c},         // DIGIT TWO COMMA
+	0x1f104: {0x0033, 0x002c},         // DIGIT THREE COMMA
+	0x1f105: {0x0034, 0x002c},         // DIGIT FOUR COMMA
+	0x1f106: {0x0035, 0x002c},         // DIGIT FIVE COMMA
+	0x1f107: {0x0036, 0x002c},         // DIGIT SIX COMMA
+	0x1f108: {0x0037, 0x002c},         // DIGIT SEVEN COMMA
+	0x1f109: {0x0038, 0x002c},         // DIGIT EIGHT COMMA
+	0x1f10a: {0x0039, 0x002c},         // DIGIT NINE COMMA
+	0x1f10f: {0x0024, 0x20e0},         // CIRCLED DOLLAR SIGN WITH OVERLAID BACKSLASH
+	0x1f110: {0x0028, 0x0041, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER A
+	0x1f111: {0x0028, 0x0042, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER B
+	0x1f112: {0x0028, 0x0043, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER C
+	0x1f113: {0x0028, 0x0044, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER D
+	0x1f114: {0x0028, 0x0045, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER E
+	0x1f115: {0x0028, 0x0046, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER F
+	0x1f116: {0x0028, 0x0047, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER G
+	0x1f117: {0x0028, 0x0048, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER H
+	0x1f118: {0x0028, 0x006c, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER I
+	0x1f119: {0x0028, 0x004a, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER J
+	0x1f11a: {0x0028, 0x004b, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER K
+	0x1f11b: {0x0028, 0x004c, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER L
+	0x1f11c: {0x0028, 0x004d, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER M
+	0x1f11d: {0x0028, 0x004e, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER N
+	0x1f11e: {0x0028, 0x004f, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER O
+	0x1f11f: {0x0028, 0x0050, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER P
+	0x1f120: {0x0028, 0x0051, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER Q
+	0x1f121: {0x0028, 0x0052, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER R
+	0x1f122: {0x0028, 0x0053, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER S
+	0x1f123: {0x0028, 0x0054, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER T
+	0x1f124: {0x0028, 0x0055, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER U
+	0x1f125: {0x0028, 0x0056, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER V
+	0x1f126: {0x0028, 0x0057, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER W
+	0x1f127: {0x0028, 0x0058, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER X
+	0x1f128: {0x0028, 0x0059, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER Y
+	0x1f129: {0x0028, 0x005a, 0x0029}, // PARENTHESIZED LATIN CAPITAL LETTER Z
+	0x1f12a: {0x0028, 0x0053, 0x0029}, // TORTOISE SHELL BRACKETED LATIN CAPITAL LETTER S
+	0x1f16d: {0x33c4, 0x0009, 0x20dd}, // CIRCLED CC
+	0x1f16e: {0x0043, 0x20e0},         // CIRCLED C WITH OVERLAID BACKSLASH
+
+	0x1f240: {0x0028, 0x672c, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-672C
+	0x1f241: {0x0028, 0x4e09, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-4E09
+	0x1f242: {0x0028, 0x4e8c, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-4E8C
+	0x1f243: {0x0028, 0x5b89, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-5B89
+	0x1f244: {0x0028, 0x70b9, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-70B9
+	0x1f245: {0x0028, 0x6253, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6253
+	0x1f246: {0x0028, 0x76d7, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-76D7
+	0x1f247: {0x0028, 0x52dd, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-52DD
+	0x1f248: {0x0028, 0x6557, 0x0029}, // TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6557
+
+	0x1f312: {0x263d}, // WAXING CRESCENT MOON SYMBOL
+	0x1f318: {0x263e}, // WANING CRESCENT MOON SYMBOL
+	0x1f319: {0x263d}, // CRESCENT MOON
+
+	0x1f700: {0x0051, 0x0045},         // ALCHEMICAL SYMBOL FOR QUINTESSENCE
+	0x1f701: {0xa658},                 // ALCHEMICAL SYMBOL FOR AIR
+	0x1f702: {0x0394},                 // ALCHEMICAL SYMBOL FOR FIRE
+	0x1f704: {0x102bc},                // ALCHEMICAL SYMBOL FOR WATER
+	0x1f707: {0x0041, 0x0052},         // ALCHEMICAL SYMBOL FOR AQUA REGIA-2
+	0x1f708: {0x0056, 0x1de4},         // ALCHEMICAL SYMBOL FOR AQUA VITAE
+	0x1f70a: {0x2629},                 // ALCHEMICAL SYMBOL FOR VINEGAR
+	0x1f714: {0x004f, 0x0335},         // ALCHEMICAL SYMBOL FOR SALT
+	0x1f728: {0x102a8},                // ALCHEMICAL SYMBOL FOR VERDIGRIS
+	0x1f73a: {0x29df},                 // ALCHEMICAL SYMBOL FOR ARSENIC
+	0x1f74c: {0x0043},                 // ALCHEMICAL SYMBOL FOR CALX
+	0x1f754: {0x16dc},                 // ALCHEMICAL SYMBOL FOR SOAP
+	0x1f755: {0x22a1},                 // ALCHEMICAL SYMBOL FOR URINE
+	0x1f75c: {0x0073, 0x0073, 0x0073}, // ALCHEMICAL SYMBOL FOR STRATUM SUPER STRATUM
+	0x1f75e: {0x224f},                 // ALCHEMICAL SYMBOL FOR SUBLIMATION
+	0x1f768: {0x0054},                 // ALCHEMICAL SYMBOL FOR CRUCIBLE-4
+	0x1f76b: {0x004d, 0x0042},         // ALCHEMICAL SYMBOL FOR BATH OF MARY
+	0x1f76c: {0x0056, 0x0042},         // ALCHEMICAL SYMBOL FOR BATH OF VAPOURS
+	0x1f771: {0x22a0},                 // ALCHEMICAL SYMBOL FOR MONTH
+
+	0x1fbf0: {0x004f}, // SEGMENTED DIGIT ZERO
+	0x1fbf1: {0x006c}, // SEGMENTED DIGIT ONE
+	0x1fbf2: {0x0032}, // SEGMENTED DIGIT TWO
+	0x1fbf3: {0x0033}, // SEGMENTED DIGIT THREE
+	0x1fbf4: {0x0034}, // SEGMENTED DIGIT FOUR
+	0x1fbf5: {0x0035}, // SEGMENTED DIGIT FIVE
+	0x1fbf6: {0x0036}, // SEGMENTED DIGIT SIX
+	0x1fbf7: {0x0037}, // SEGMENTED DIGIT SEVEN
+	0x1fbf8: {0x0038}, // SEGMENTED DIGIT EIGHT
+	0x1fbf9: {0x0039}, // SEGMENTED DIGIT NINE
+
+	0x21fe8: {0x276c}, // CJK UNIFIED IDEOGRAPH-21FE8
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+//go:generate go run rxp-confusable-tables-gen.go
+
+import (
+	"unicode"
+)
+
+// isDefaultIgnorable returns true for the runes with the Unicode derived
+// Default_Ignorable_Code_Point property, such as the zero-width joiners,
+// the soft hyphen and the variation selectors
+func isDefaultIgnorable(r rune) bool {
+	switch {
+	case r < 0xad:
+		return false
+	case r == 0xad, r == 0x034f, r == 0x061c, r == 0x115f, r == 0x1160, r == 0x3164, r == 0xffa0:
+		return true
+	case 0xfff9 <= r && r <= 0xfffb:
+		return false
+	case unicode.In(r, unicode.White_Space, unicode.Prepended_Concatenation_Mark):
+		return false
+	}
+	return unicode.In(r, unicode.Cf, unicode.Variation_Selector, unicode.Other_Default_Ignorable_Code_Point)
+}
+
+// confusableSkeleton appends the confusables skeleton of r to the key given,
+// the default ignorable runes have an empty skeleton and are skipped
+//
+// The rune given is one rune of a canonical decomposition and the prototype
+// runes are decomposed in turn, see foldUnit for the canonical ordering of
+// the whole unit, following the NFD, map and NFD steps of the UTS #39
+// skeleton
+//
+// The skeleton is case-sensitive, so when case-insensitivity is in effect the
+// rune is lowered before the skeleton lookup, allowing the case folding of
+// the skeleton runes to behave the same as with the runes themselves
+func confusableSkeleton(key []rune, r rune, scope Flags) []rune {
+	if isDefaultIgnorable(r) {
+		return key
+	}
+	if scope&gCaseFlags != 0 {
+		r = unicode.ToLower(r)
+	}
+	if prototype, ok := gConfusableSkeleton[r]; ok {
+		for _, pr := range prototype {
+			key = appendDecomposed(key, pr)
+		}
+		return key
+	}
+	return append(key, r)
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestConfusable(t *testing.T) {
+	c.Convey("isDefaultIgnorable", t, func() {
+		for idx, test := range []struct {
+			input  rune
+			output bool
+		}{
+			{'a', false},
+			{' ', false},
+			{'\u00ad', true},
+			{'\u200b', true},
+			{'\u200d', true},
+			{'\u2060', true},
+			{'\ufe0f', true},
+			{'\ufeff', true},
+			{'\u0600', false},
+			{'\ufffa', false},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), isDefaultIgnorable(test.input), c.ShouldEqual, test.output)
+		}
+	})
+
+	c.Convey("confusableSkeleton", t, func() {
+		for idx, test := range []struct {
+			input  string
+			scope  Flags
+			output string
+		}{
+			{"paypal", DefaultFlags, "paypal"},
+			{"p\u0430yp\u0430l", DefaultFlags, "paypal"},
+			{"\U0001d429\U0001d41a\U0001d432", DefaultFlags, "pay"},
+			{"g00gle", DefaultFlags, "gOOgle"},
+			{"Il1|", DefaultFlags, "llll"},
+			{"\u0406ndia", AnyCaseFlag, "india"},
+			{"a\u200db", DefaultFlags, "ab"},
+			{"m", DefaultFlags, "rn"},
+			{"\ufb01", DefaultFlags, "fi"},
+			{"\u0461\u0461", DefaultFlags, "ww"},
+			{"\u00a2", DefaultFlags, "c\u0338"},
+			{"\u2474", DefaultFlags, "(l)"},
+		} {
+			var key []rune
+			for _, r := range test.input {
+				key = confusableSkeleton(key, r, test.scope)
+			}
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), string(key), c.ShouldEqual, test.output)
+		}
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"unicode"
+)
+
+const (
+	emojiZWJ         = 0x200d
+	emojiTextStyle   = 0xfe0e
+	emojiStyle       = 0xfe0f
+	emojiKeycap      = 0x20e3
+	emojiTagCancel   = 0xe007f
+	emojiTagFirst    = 0xe0020
+	emojiTagLast     = 0xe007e
+	emojiModifierLo  = 0x1f3fb
+	emojiModifierHi  = 0x1f3ff
+	emojiRegionalLo  = 0x1f1e6
+	emojiRegionalHi  = 0x1f1ff
+	emojiPictureBase = 0xa9
+)
+
+// RuneIsEmoji returns true for runes with the Unicode Emoji property, note
+// that this includes the ASCII digits, the number sign and the asterisk
+// because these are the bases of the keycap sequences
+func RuneIsEmoji(r rune) bool {
+	return r == '#' || r == '*' || ('0' <= r && r <= '9') || (r >= emojiPictureBase && unicode.Is(gEmoji, r))
+}
+
+// RuneIsEmojiPresentation returns true for runes with the Unicode
+// Emoji_Presentation property, which are displayed as emoji by default
+func RuneIsEmojiPresentation(r rune) bool {
+	return r >= 0x231a && unicode.Is(gEmojiPresentation, r)
+}
+
+// RuneIsRegionalIndicator returns true for the regional indicator symbols,
+// pairs of which make up the flag emoji
+func RuneIsRegionalIndicator(r rune) bool {
+	return emojiRegionalLo <= r && r <= emojiRegionalHi
+}
+
+// RuneIsEmojiModifier returns true for the emoji skin tone modifiers
+func RuneIsEmojiModifier(r rune) bool {
+	return emojiModifierLo <= r && r <= emojiModifierHi
+}
+
+// regionalFlagSize returns the size of the pair of regional indicators at the
+// index given, or zero if there is no pair
+func regionalFlagSize(input *InputReader, index int) (size int) {
+	if r, rs, ok := input.Get(index); ok && RuneIsRegionalIndicator(r) {
+		if nr, ns, present := input.Get(index + rs); present && RuneIsRegionalIndicator(nr) {
+			return rs + ns
+		}
+	}
+	return 0
+}
+
+// emojiElementSize returns the size of the emoji at the index given, which is
+// one of the following, as described by Unicode Technical Standard #51:
+//
+//	| Element           | Example                                       |
+//	|-------------------|-----------------------------------------------|
+//	| flag              | two regional indicators                       |
+//	| keycap            | [0-9#*] with an optional U+FE0F then U+20E3   |
+//	| emoji character   | with an optional U+FE0E or U+FE0F             |
+//	| modifier sequence | emoji character and a skin tone modifier      |
+//	| tag sequence      | emoji character, tags and the cancel tag      |
+//
+// The presented result is true when the element is displayed as an emoji
+// instead of as text
+func emojiElementSize(input *InputReader, index int) (size int, presented bool) {
+	if size = regionalFlagSize(input, index); size > 0 {
+		return size, true
+	}
+
+	r, rs, ok := input.Get(index)
+	if !ok || !RuneIsEmoji(r) || RuneIsRegionalIndicator(r) {
+		// a regional indicator without its pair is not a flag
+		return 0, false
+	}
+
+	next, ns, _ := input.Get(index + rs)
+	if r < emojiPictureBase {
+		// keycap bases are only emoji in a keycap sequence
+		if next == emojiStyle {
+			rs += ns
+			next, ns, _ = input.Get(index + rs)
+		}
+		if next == emojiKeycap {
+			return rs + ns, true
+		}
+		return 0, false
+	}
+
+	size, presented = rs, RuneIsEmojiPresentation(r)
+	switch {
+	case next == emojiStyle:
+		size, presented = size+ns, true
+	case next == emojiTextStyle:
+		return size + ns, false
+	case RuneIsEmojiModifier(next) && !RuneIsEmojiModifier(r):
+		size, presented = size+ns, true
+	}
+
+	// a tag sequence is only complete with the cancel tag
+	tags := size
+	for {
+		tr, ts, present := input.Get(index + tags)
+		if !present || tr < emojiTagFirst || tr > emojiTagCancel {
+			break
+		}
+		tags += ts
+		if tr == emojiTagCancel {
+			if tags > size+ts {
+				size, presented = tags, true
+			}
+			break
+		}
+	}
+
+	return size, presented
+}
+
+// emojiSequenceSize returns the size of the emoji sequence at the index
+// given, which is one or more emoji elements joined with U+200D (ZWJ), or zero
+// if there is no emoji at the index
+//
+// When presentation is true, emoji characters displayed as text by default
+// are only matched when followed by the emoji variation selector (U+FE0F), or
+// as part of a longer sequence
+func emojiSequenceSize(input *InputReader, index int, presentation bool) (size int) {
+	var presented bool
+	if size, presented = emojiElementSize(input, index); size == 0 {
+		return 0
+	}
+	for {
+		r, rs, ok := input.Get(index + size)
+		if !ok || r != emojiZWJ {
+			break
+		}
+		es, _ := emojiElementSize(input, index+size+rs)
+		if es == 0 {
+			break
+		}
+		size += rs + es
+		presented = true
+	}
+	if presentation && !presented {
+		return 0
+	}
+	return size
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEmoji(t *testing.T) {
+	c.Convey("RuneIsEmoji", t, func() {
+		c.So(RuneIsEmoji('a'), c.ShouldBeFalse)
+		c.So(RuneIsEmoji('#'), c.ShouldBeTrue)
+		c.So(RuneIsEmoji('©'), c.ShouldBeTrue)
+		c.So(RuneIsEmoji('★'), c.ShouldBeFalse)
+		c.So(RuneIsEmoji('\U0001f600'), c.ShouldBeTrue)
+		c.So(RuneIsEmojiPresentation('©'), c.ShouldBeFalse)
+		c.So(RuneIsEmojiPresentation('⌚'), c.ShouldBeTrue)
+		c.So(RuneIsEmojiPresentation('\U0001f600'), c.ShouldBeTrue)
+		c.So(RuneIsRegionalIndicator('\U0001f1ef'), c.ShouldBeTrue)
+		c.So(RuneIsEmojiModifier('\U0001f3fd'), c.ShouldBeTrue)
+	})
+
+	c.Convey("emojiSequenceSize", t, func() {
+		for idx, test := range []struct {
+			input        string
+			emoji        int
+			presentation int
+		}{
+			{"", 0, 0},
+			{"a", 0, 0},
+			{"1", 0, 0},
+			{"1\u20e3", 4, 4},
+			{"1\ufe0f\u20e3", 7, 7},
+			{"©", 2, 0},
+			{"©\ufe0f", 5, 5},
+			{"\U0001f600\ufe0e", 7, 0},
+			{"\U0001f44b\U0001f3fd!", 8, 8},
+			{"\U0001f468\u200d\U0001f469\u200d\U0001f467", 18, 18},
+			{"\U0001f468\u200d", 4, 4},
+			{"\U0001f1ef\U0001f1f5\U0001f1fa", 8, 8},
+			{"\U0001f1ef", 0, 0},
+			{"\U0001f3f4\U000e0067\U000e0062\U000e0065\U000e006e\U000e0067\U000e007f", 28, 28},
+			{"\U0001f3f4\U000e0067\U000e0062", 4, 4},
+		} {
+			input := NewInputReader(test.input)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), emojiSequenceSize(input, 0, false), c.ShouldEqual, test.emoji)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), emojiSequenceSize(input, 0, true), c.ShouldEqual, test.presentation)
+		}
+	})
+}
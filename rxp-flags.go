@@ -20,7 +20,7 @@ import (
 	"strings"
 )
 
-type Flags uint16
+type Flags uint32
 
 const (
 	DefaultFlags Flags = 0
@@ -34,12 +34,32 @@ const (
 	ZeroOrOneFlag
 	OneOrMoreFlag
 	LessFlag
+	UnicodeFlag
+	FullFoldFlag
+	TurkicFlag
+	NormalizeFlag
+	AccentFlag
+	WidthFlag
+	KanaFlag
+	ConfusableFlag
+	GraphemeFlag
+	TransposeFlag
 )
 
-// ParseOptions accepts Pattern, Matcher and string options and recasts them
-// into their specific types
+// gNamedFlags are the Flags which are given by name instead of by rune,
+// named flags must be separated from other flags with spaces
+var gNamedFlags = map[string]Flags{
+	"tr":         TurkicFlag,
+	"az":         TurkicFlag,
+	"confusable": ConfusableFlag,
+	"transpose":  TransposeFlag,
+}
+
+// ParseOptions accepts Pattern, Matcher, RuneClass and string options and
+// recasts them into their specific types
 //
-// ParseOptions will panic with any type other than Pattern, Matcher or string
+// ParseOptions will panic with any type other than Pattern, Matcher, RuneClass
+// or string
 func ParseOptions(options ...interface{}) (pattern Pattern, flags []string, argv []interface{}) {
 
 	for idx, value := range options {
@@ -59,6 +79,9 @@ func ParseOptions(options ...interface{}) (pattern Pattern, flags []string, argv
 		case Pattern:
 			pattern = append(pattern, t...)
 
+		case RuneClass:
+			pattern = append(pattern, t.Matcher())
+
 		default:
 			panic(fmt.Errorf("invalid argument #%d: %#+v", idx, t))
 		}
@@ -76,8 +99,15 @@ func ParseOptions(options ...interface{}) (pattern Pattern, flags []string, argv
 //	|    ^    | Invert the meaning of this match group                                                  |
 //	|    m    | Multiline mode Caret and Dollar match begin/end of line in addition to begin/end text   |
 //	|    s    | DotNL allows Dot to match newlines (\n)                                                 |
-//	|    i    | AnyCase is case-insensitive matching of unicode text                                    |
+//	|    i    | AnyCase is case-insensitive matching of unicode text, using simple case folding         |
+//	|    f    | FullFold is case-insensitive matching using full case folding, ie: ß matches ss         |
+//	|    n    | Normalize is canonical equivalence matching, ie: é matches e followed by U+0301         |
+//	|    a    | Accent-insensitive matching ignores combining marks, ie: resume matches résumé          |
+//	|    w    | Width-insensitive matching of the halfwidth and fullwidth forms of runes                |
+//	|    k    | Kana-insensitive matching of hiragana with the equivalent katakana                      |
+//	|    g    | Grapheme mode Dot and the classes consume whole extended grapheme clusters              |
 //	|    c    | Capture allows this Matcher to be included in Pattern substring results                 |
+//	|    u    | Unicode changes the Perl classes (D, S, W and B) to use Unicode definitions             |
 //	|    *    | zero or more repetitions, prefer more                                                   |
 //	|    +    | one or more repetitions, prefer more                                                    |
 //	|    ?    | zero or one repetition, prefer one                                                      |
@@ -91,8 +121,18 @@ func ParseOptions(options ...interface{}) (pattern Pattern, flags []string, argv
 //	|  {l,}?  | range of repetitions, l minimum, prefer less                                            |
 //	|  {l}?   | range of repetitions, l minimum, prefer less                                            |
 //
+// ParseFlags also accepts the following named flags:
+//
+//	|   Named    | Description                                                                             |
+//	|------------|-----------------------------------------------------------------------------------------|
+//	|     tr     | Turkic special casing of dotted and dotless i with AnyCase and FullFold, see WithLocale |
+//	|     az     | same as tr, Azeri and Turkish share the same special casing rules                       |
+//	| confusable | Text compares confusables skeletons, ie: paypal matches the Cyrillic a in pаypаl        |
+//	| transpose  | Fuzzy counts swapping two adjacent runes as one edit, ie: invoice matches inovice       |
+//
 // The flags presented above can be combined into a single string argument, or
-// can be individually given to ParseFlags
+// can be individually given to ParseFlags, named flags must be separated from
+// other flags with spaces
 //
 // Any parsing errors will result in a runtime panic
 func ParseFlags(flags ...string) (Reps, Flags) {
@@ -101,25 +141,32 @@ func ParseFlags(flags ...string) (Reps, Flags) {
 
 	for _, flag := range flags {
 
-		flag = strings.ToLower(strings.TrimSpace(flag))
-		lower := []rune(flag)
-		if size := len(lower); size == 1 {
-			if flg, lh, ok := f.parseFlag(lower[0]); ok {
+		for _, field := range strings.Fields(strings.ToLower(flag)) {
+
+			if named, ok := gNamedFlags[field]; ok {
+				f = f.Set(named)
+				continue
+			}
+
+			lower := []rune(field)
+			if size := len(lower); size == 1 {
+				if flg, lh, ok := f.parseFlag(lower[0]); ok {
+					if lh != nil {
+						reps = lh
+					}
+					f = flg
+					continue
+				}
+			} else if flg, lh, ok := f.parseFlags(lower); ok {
 				if lh != nil {
 					reps = lh
 				}
 				f = flg
 				continue
 			}
-		} else if flg, lh, ok := f.parseFlags(lower); ok {
-			if lh != nil {
-				reps = lh
-			}
-			f = flg
-			continue
-		}
 
-		panic(fmt.Errorf("invalid flag: %q", flags))
+			panic(fmt.Errorf("invalid flag: %q", flags))
+		}
 	}
 
 	return reps, f
@@ -161,6 +208,46 @@ func (f Flags) AnyCase() bool {
 	return f&AnyCaseFlag == AnyCaseFlag
 }
 
+func (f Flags) Unicode() bool {
+	return f&UnicodeFlag == UnicodeFlag
+}
+
+func (f Flags) FullFold() bool {
+	return f&FullFoldFlag == FullFoldFlag
+}
+
+func (f Flags) Turkic() bool {
+	return f&TurkicFlag == TurkicFlag
+}
+
+func (f Flags) Normalize() bool {
+	return f&NormalizeFlag == NormalizeFlag
+}
+
+func (f Flags) Accent() bool {
+	return f&AccentFlag == AccentFlag
+}
+
+func (f Flags) Width() bool {
+	return f&WidthFlag == WidthFlag
+}
+
+func (f Flags) Kana() bool {
+	return f&KanaFlag == KanaFlag
+}
+
+func (f Flags) Confusable() bool {
+	return f&ConfusableFlag == ConfusableFlag
+}
+
+func (f Flags) Grapheme() bool {
+	return f&GraphemeFlag == GraphemeFlag
+}
+
+func (f Flags) Transpose() bool {
+	return f&TransposeFlag == TransposeFlag
+}
+
 func (f Flags) Less() bool {
 	return f&LessFlag == LessFlag
 }
@@ -206,9 +293,48 @@ func (f Flags) String() string {
 	if f.AnyCase() {
 		buf.WriteRune('i')
 	}
+	if f.FullFold() {
+		buf.WriteRune('f')
+	}
+	if f.Normalize() {
+		buf.WriteRune('n')
+	}
+	if f.Accent() {
+		buf.WriteRune('a')
+	}
+	if f.Width() {
+		buf.WriteRune('w')
+	}
+	if f.Kana() {
+		buf.WriteRune('k')
+	}
+	if f.Grapheme() {
+		buf.WriteRune('g')
+	}
 	if f.Capture() {
 		buf.WriteRune('c')
 	}
+	if f.Unicode() {
+		buf.WriteRune('u')
+	}
+	if f.Turkic() {
+		if buf.Len() > 0 {
+			buf.WriteRune(' ')
+		}
+		buf.WriteString("tr")
+	}
+	if f.Confusable() {
+		if buf.Len() > 0 {
+			buf.WriteRune(' ')
+		}
+		buf.WriteString("confusable")
+	}
+	if f.Transpose() {
+		if buf.Len() > 0 {
+			buf.WriteRune(' ')
+		}
+		buf.WriteString("transpose")
+	}
 	return buf.String()
 }
 
@@ -232,6 +358,27 @@ func (f Flags) parseFlag(lower rune) (flags Flags, reps Reps, ok bool) {
 	case 'c':
 		flags = flags.Set(CaptureFlag)
 
+	case 'u':
+		flags = flags.Set(UnicodeFlag)
+
+	case 'f':
+		flags = flags.Set(FullFoldFlag)
+
+	case 'n':
+		flags = flags.Set(NormalizeFlag)
+
+	case 'a':
+		flags = flags.Set(AccentFlag)
+
+	case 'w':
+		flags = flags.Set(WidthFlag)
+
+	case 'k':
+		flags = flags.Set(KanaFlag)
+
+	case 'g':
+		flags = flags.Set(GraphemeFlag)
+
 	case '*':
 		reps = Reps{-1, -1}
 		flags = flags.Unset(LessFlag).Set(ZeroOrMoreFlag)
@@ -313,7 +460,7 @@ func (f Flags) parseFlags(input []rune) (flags Flags, reps Reps, ok bool) {
 		case ' ':
 		// nop is allowed
 
-		case '^', 'm', 's', 'i', 'c':
+		case '^', 'm', 's', 'i', 'f', 'n', 'a', 'w', 'k', 'g', 'c', 'u':
 			flags, _, _ = flags.parseFlag(this)
 			continue
 
@@ -42,6 +42,15 @@ func TestFlags(t *testing.T) {
 			{[]string{"s"}, Reps(nil), `s`, c.ShouldNotPanic},
 			{[]string{"i"}, Reps(nil), `i`, c.ShouldNotPanic},
 			{[]string{"c"}, Reps(nil), `c`, c.ShouldNotPanic},
+			{[]string{"u"}, Reps(nil), `u`, c.ShouldNotPanic},
+			{[]string{"f"}, Reps(nil), `f`, c.ShouldNotPanic},
+			{[]string{"ciu"}, Reps(nil), `icu`, c.ShouldNotPanic},
+			{[]string{"cni"}, Reps(nil), `inc`, c.ShouldNotPanic},
+			{[]string{"anc"}, Reps(nil), `nac`, c.ShouldNotPanic},
+			{[]string{"kcw"}, Reps(nil), `wkc`, c.ShouldNotPanic},
+			{[]string{"c confusable"}, Reps(nil), `c confusable`, c.ShouldNotPanic},
+			{[]string{"transpose ic"}, Reps(nil), `ic transpose`, c.ShouldNotPanic},
+			{[]string{"gc"}, Reps(nil), `gc`, c.ShouldNotPanic},
 			{[]string{"*"}, Reps{-1, -1}, `*`, c.ShouldNotPanic},
 			{[]string{"+"}, Reps{1, -1}, `+`, c.ShouldNotPanic},
 			{[]string{"?"}, Reps{0, 1}, `?`, c.ShouldNotPanic},
@@ -61,7 +70,7 @@ func TestFlags(t *testing.T) {
 			{[]string{"{1,-1}"}, Reps(nil), ``, c.ShouldPanic},
 			{[]string{"{1,0}"}, Reps(nil), ``, c.ShouldPanic},
 			{[]string{"NOPE"}, Reps(nil), ``, c.ShouldPanic},
-			{[]string{"F"}, Reps(nil), ``, c.ShouldPanic},
+			{[]string{"Q"}, Reps(nil), ``, c.ShouldPanic},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d (panic)", idx),
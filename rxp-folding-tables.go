@@ -0,0 +1,125 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+// gFullCaseFolding is the status F (full) mappings of the Unicode
+// CaseFolding.txt file, the status C (common) and S (simple) mappings are
+// covered by unicode.SimpleFold
+var gFullCaseFolding = map[rune][]rune{
+	0x00df: {0x0073, 0x0073},         // LATIN SMALL LETTER SHARP S
+	0x0130: {0x0069, 0x0307},         // LATIN CAPITAL LETTER I WITH DOT ABOVE
+	0x0149: {0x02bc, 0x006e},         // LATIN SMALL LETTER N PRECEDED BY APOSTROPHE
+	0x01f0: {0x006a, 0x030c},         // LATIN SMALL LETTER J WITH CARON
+	0x0390: {0x03b9, 0x0308, 0x0301}, // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+	0x03b0: {0x03c5, 0x0308, 0x0301}, // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS
+	0x0587: {0x0565, 0x0582},         // ARMENIAN SMALL LIGATURE ECH YIWN
+	0x1e96: {0x0068, 0x0331},         // LATIN SMALL LETTER H WITH LINE BELOW
+	0x1e97: {0x0074, 0x0308},         // LATIN SMALL LETTER T WITH DIAERESIS
+	0x1e98: {0x0077, 0x030a},         // LATIN SMALL LETTER W WITH RING ABOVE
+	0x1e99: {0x0079, 0x030a},         // LATIN SMALL LETTER Y WITH RING ABOVE
+	0x1e9a: {0x0061, 0x02be},         // LATIN SMALL LETTER A WITH RIGHT HALF RING
+	0x1e9e: {0x0073, 0x0073},         // LATIN CAPITAL LETTER SHARP S
+	0x1f50: {0x03c5, 0x0313},         // GREEK SMALL LETTER UPSILON WITH PSILI
+	0x1f52: {0x03c5, 0x0313, 0x0300}, // GREEK SMALL LETTER UPSILON WITH PSILI AND VARIA
+	0x1f54: {0x03c5, 0x0313, 0x0301}, // GREEK SMALL LETTER UPSILON WITH PSILI AND OXIA
+	0x1f56: {0x03c5, 0x0313, 0x0342}, // GREEK SMALL LETTER UPSILON WITH PSILI AND PERISPOMENI
+	0x1f80: {0x1f00, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH PSILI AND YPOGEGRAMMENI
+	0x1f81: {0x1f01, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH DASIA AND YPOGEGRAMMENI
+	0x1f82: {0x1f02, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1f83: {0x1f03, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1f84: {0x1f04, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1f85: {0x1f05, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1f86: {0x1f06, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1f87: {0x1f07, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1f88: {0x1f00, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PROSGEGRAMMENI
+	0x1f89: {0x1f01, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PROSGEGRAMMENI
+	0x1f8a: {0x1f02, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1f8b: {0x1f03, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1f8c: {0x1f04, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1f8d: {0x1f05, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1f8e: {0x1f06, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1f8f: {0x1f07, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1f90: {0x1f20, 0x03b9},         // GREEK SMALL LETTER ETA WITH PSILI AND YPOGEGRAMMENI
+	0x1f91: {0x1f21, 0x03b9},         // GREEK SMALL LETTER ETA WITH DASIA AND YPOGEGRAMMENI
+	0x1f92: {0x1f22, 0x03b9},         // GREEK SMALL LETTER ETA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1f93: {0x1f23, 0x03b9},         // GREEK SMALL LETTER ETA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1f94: {0x1f24, 0x03b9},         // GREEK SMALL LETTER ETA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1f95: {0x1f25, 0x03b9},         // GREEK SMALL LETTER ETA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1f96: {0x1f26, 0x03b9},         // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1f97: {0x1f27, 0x03b9},         // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1f98: {0x1f20, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH PSILI AND PROSGEGRAMMENI
+	0x1f99: {0x1f21, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH DASIA AND PROSGEGRAMMENI
+	0x1f9a: {0x1f22, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1f9b: {0x1f23, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1f9c: {0x1f24, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1f9d: {0x1f25, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1f9e: {0x1f26, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1f9f: {0x1f27, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1fa0: {0x1f60, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH PSILI AND YPOGEGRAMMENI
+	0x1fa1: {0x1f61, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH DASIA AND YPOGEGRAMMENI
+	0x1fa2: {0x1f62, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1fa3: {0x1f63, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1fa4: {0x1f64, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1fa5: {0x1f65, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1fa6: {0x1f66, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1fa7: {0x1f67, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1fa8: {0x1f60, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PROSGEGRAMMENI
+	0x1fa9: {0x1f61, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PROSGEGRAMMENI
+	0x1faa: {0x1f62, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1fab: {0x1f63, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1fac: {0x1f64, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1fad: {0x1f65, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1fae: {0x1f66, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1faf: {0x1f67, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1fb2: {0x1f70, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH VARIA AND YPOGEGRAMMENI
+	0x1fb3: {0x03b1, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH YPOGEGRAMMENI
+	0x1fb4: {0x03ac, 0x03b9},         // GREEK SMALL LETTER ALPHA WITH OXIA AND YPOGEGRAMMENI
+	0x1fb6: {0x03b1, 0x0342},         // GREEK SMALL LETTER ALPHA WITH PERISPOMENI
+	0x1fb7: {0x03b1, 0x0342, 0x03b9}, // GREEK SMALL LETTER ALPHA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1fbc: {0x03b1, 0x03b9},         // GREEK CAPITAL LETTER ALPHA WITH PROSGEGRAMMENI
+	0x1fc2: {0x1f74, 0x03b9},         // GREEK SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI
+	0x1fc3: {0x03b7, 0x03b9},         // GREEK SMALL LETTER ETA WITH YPOGEGRAMMENI
+	0x1fc4: {0x03ae, 0x03b9},         // GREEK SMALL LETTER ETA WITH OXIA AND YPOGEGRAMMENI
+	0x1fc6: {0x03b7, 0x0342},         // GREEK SMALL LETTER ETA WITH PERISPOMENI
+	0x1fc7: {0x03b7, 0x0342, 0x03b9}, // GREEK SMALL LETTER ETA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1fcc: {0x03b7, 0x03b9},         // GREEK CAPITAL LETTER ETA WITH PROSGEGRAMMENI
+	0x1fd2: {0x03b9, 0x0308, 0x0300}, // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND VARIA
+	0x1fd3: {0x03b9, 0x0308, 0x0301}, // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND OXIA
+	0x1fd6: {0x03b9, 0x0342},         // GREEK SMALL LETTER IOTA WITH PERISPOMENI
+	0x1fd7: {0x03b9, 0x0308, 0x0342}, // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND PERISPOMENI
+	0x1fe2: {0x03c5, 0x0308, 0x0300}, // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND VARIA
+	0x1fe3: {0x03c5, 0x0308, 0x0301}, // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND OXIA
+	0x1fe4: {0x03c1, 0x0313},         // GREEK SMALL LETTER RHO WITH PSILI
+	0x1fe6: {0x03c5, 0x0342},         // GREEK SMALL LETTER UPSILON WITH PERISPOMENI
+	0x1fe7: {0x03c5, 0x0308, 0x0342}, // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND PERISPOMENI
+	0x1ff2: {0x1f7c, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI
+	0x1ff3: {0x03c9, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH YPOGEGRAMMENI
+	0x1ff4: {0x03ce, 0x03b9},         // GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	0x1ff6: {0x03c9, 0x0342},         // GREEK SMALL LETTER OMEGA WITH PERISPOMENI
+	0x1ff7: {0x03c9, 0x0342, 0x03b9}, // GREEK SMALL LETTER OMEGA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1ffc: {0x03c9, 0x03b9},         // GREEK CAPITAL LETTER OMEGA WITH PROSGEGRAMMENI
+	0xfb00: {0x0066, 0x0066},         // LATIN SMALL LIGATURE FF
+	0xfb01: {0x0066, 0x0069},         // LATIN SMALL LIGATURE FI
+	0xfb02: {0x0066, 0x006c},         // LATIN SMALL LIGATURE FL
+	0xfb03: {0x0066, 0x0066, 0x0069}, // LATIN SMALL LIGATURE FFI
+	0xfb04: {0x0066, 0x0066, 0x006c}, // LATIN SMALL LIGATURE FFL
+	0xfb05: {0x0073, 0x0074},         // LATIN SMALL LIGATURE LONG S T
+	0xfb06: {0x0073, 0x0074},         // LATIN SMALL LIGATURE ST
+	0xfb13: {0x0574, 0x0576},         // ARMENIAN SMALL LIGATURE MEN NOW
+	0xfb14: {0x0574, 0x0565},         // ARMENIAN SMALL LIGATURE MEN ECH
+	0xfb15: {0x0574, 0x056b},         // ARMENIAN SMALL LIGATURE MEN INI
+	0xfb16: {0x057e, 0x0576},         // ARMENIAN SMALL LIGATURE VEW NOW
+	0xfb17: {0x0574, 0x056d},         // ARMENIAN SMALL LIGATURE MEN XEH
+}
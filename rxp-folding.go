@@ -0,0 +1,286 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// gFoldingFlags are the Flags which change how the text matchers compare
+// runes with each other
+const gFoldingFlags = AnyCaseFlag | FullFoldFlag | TurkicFlag | NormalizeFlag | AccentFlag | gWidthFlags | ConfusableFlag
+
+// gCaseFlags are the Flags which enable case-insensitive comparisons
+const gCaseFlags = AnyCaseFlag | FullFoldFlag
+
+// foldingMode returns the folding Flags present in the scope given, without
+// the case tailoring Flags when there is no case-insensitivity in effect
+func foldingMode(scope Flags) (mode Flags) {
+	if mode = scope & gFoldingFlags; mode&gCaseFlags == 0 {
+		mode &^= TurkicFlag
+	}
+	return
+}
+
+// foldRune returns the simple case folding of r, which is the lowest rune
+// in the unicode.SimpleFold orbit of r, for example: k, K and the Kelvin
+// sign all fold to K
+func foldRune(r rune) rune {
+	if r < utf8.RuneSelf {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}
+	folded := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < folded {
+			folded = f
+		}
+	}
+	return folded
+}
+
+// foldCase returns the simple case folding of r, with the Turkic special
+// casing of the dotted and dotless i when the scope has TurkicFlag set
+func foldCase(r rune, scope Flags) rune {
+	if scope&TurkicFlag == TurkicFlag && (r == 'I' || r == 'İ') {
+		r = unicode.TurkishCase.ToLower(r)
+	}
+	return foldRune(r)
+}
+
+// foldEqual returns true if the runes are equal, using simple case folding
+// when the scope has AnyCase or FullFold set
+func foldEqual(a, b rune, scope Flags) bool {
+	if a == b {
+		return true
+	} else if scope&gCaseFlags != 0 {
+		return foldCase(a, scope) == foldCase(b, scope)
+	}
+	return false
+}
+
+// foldKey appends the comparison key of r to the key given, for the folding
+// modes present in the scope given
+func foldKey(key []rune, r rune, scope Flags) []rune {
+	if scope&(gWidthFlags|ConfusableFlag) != 0 {
+		var buf, skeleton [4]rune
+		runes := append(buf[:0], r)
+		if scope&gWidthFlags != 0 {
+			runes = appendWidthKey(buf[:0], r, scope)
+		}
+		if scope&ConfusableFlag == ConfusableFlag {
+			prototypes := skeleton[:0]
+			for _, wr := range runes {
+				prototypes = confusableSkeleton(prototypes, wr, scope)
+			}
+			runes = prototypes
+		}
+		for _, wr := range runes {
+			key = foldCaseKey(key, wr, scope)
+		}
+		return key
+	}
+	return foldCaseKey(key, r, scope)
+}
+
+// foldCaseKey appends the case folding of r to the key given, for the case
+// folding modes present in the scope given
+func foldCaseKey(key []rune, r rune, scope Flags) []rune {
+	if scope.FullFold() {
+		if scope.Turkic() && r == 'İ' {
+			// the Turkic status T mapping, instead of the status F mapping
+			return append(key, foldCase(r, scope))
+		} else if full, ok := gFullCaseFolding[r]; ok {
+			for _, fr := range full {
+				key = append(key, foldRune(fr))
+			}
+			return key
+		}
+		return append(key, foldCase(r, scope))
+	} else if scope.AnyCase() {
+		return append(key, foldCase(r, scope))
+	}
+	return append(key, r)
+}
+
+// foldUnit appends the comparison key of the input unit at the index given
+// to the key given, returning the size of the input unit
+//
+// An input unit is one rune, unless the scope has NormalizeFlag, AccentFlag
+// or ConfusableFlag set, in which case the unit is the rune along with all
+// the marks following it and the key is made from the decomposition of the
+// unit, see normalizedUnit
+func foldUnit(key []rune, scope Flags, input *InputReader, index int) (folded []rune, size int, ok bool) {
+	if scope&(NormalizeFlag|AccentFlag|ConfusableFlag) == 0 {
+		var r rune
+		if r, size, ok = input.Get(index); ok {
+			key = foldKey(key, r, scope)
+		}
+		return key, size, ok
+	}
+	var buf [16]rune
+	var nfd []rune
+	if nfd, size, ok = normalizedUnit(buf[:0], scope, input, index); ok {
+		start := len(key)
+		for _, r := range nfd {
+			key = foldKey(key, r, scope)
+		}
+		if scope&ConfusableFlag == ConfusableFlag {
+			// the prototypes can add marks out of the canonical order
+			canonicalOrder(key[start:])
+		}
+	}
+	return key, size, ok
+}
+
+// matchFoldKey compares the key given with the folded input units starting
+// at the index given, returning the size of the input consumed
+//
+// Each input unit can fold into more than one key rune, and a match must
+// consume all the key runes of the last input unit
+func matchFoldKey(key []rune, scope Flags, input *InputReader, index int) (size int, ok bool) {
+	var buf [16]rune
+	for pos := 0; pos < len(key); {
+		unit, us, present := foldUnit(buf[:0], scope, input, index+size)
+		if !present || pos+len(unit) > len(key) || (pos == 0 && len(unit) == 0) {
+			// matches do not start with ignorable units
+			return 0, false
+		}
+		for idx, u := range unit {
+			if key[pos+idx] != u {
+				return 0, false
+			}
+		}
+		pos += len(unit)
+		size += us
+	}
+	return size, true
+}
+
+// cFoldedText is the content of a text Matcher, with the comparison keys
+// for each of the folding modes the text is used with
+type cFoldedText struct {
+	text   []rune
+	folded []rune
+	keys   sync.Map
+}
+
+func newFoldedText(text []rune) *cFoldedText {
+	folded := make([]rune, len(text))
+	for idx, r := range text {
+		folded[idx] = foldRune(r)
+	}
+	return &cFoldedText{text: text, folded: folded}
+}
+
+// key returns the cached comparison key for the folding mode given
+func (t *cFoldedText) key(mode Flags) []rune {
+	if v, ok := t.keys.Load(mode); ok {
+		return v.([]rune)
+	}
+	var key []rune
+	input := NewInputReader(t.text)
+	for idx, size := 0, 0; idx < len(t.text); idx += size {
+		key, size, _ = foldUnit(key, mode, input, idx)
+	}
+	t.keys.Store(mode, key)
+	return key
+}
+
+// match returns the size of the input matching this text at the index given
+func (t *cFoldedText) match(scope Flags, input *InputReader, index int) (size int, ok bool) {
+	if len(t.text) == 0 {
+		return 0, false
+	}
+
+	// using the for-loop approach and foldRune reduces the actual number of
+	// times unicode.SimpleFold is called compared to strings.ToLower which
+	// has to scan the entire string each time, see the commented benchmarks
+	// in rxp_x_test.go
+
+	switch mode := foldingMode(scope); mode {
+	case DefaultFlags:
+		for _, c := range t.text {
+			r, rs, present := input.Get(index + size)
+			if !present || r != c {
+				return 0, false
+			}
+			size += rs
+		}
+		return size, true
+	case AnyCaseFlag:
+		for _, c := range t.folded {
+			r, rs, present := input.Get(index + size)
+			if !present || foldRune(r) != c {
+				return 0, false
+			}
+			size += rs
+		}
+		return size, true
+	default:
+		key := t.key(mode)
+		if len(key) == 0 {
+			// the text is entirely ignorable runes
+			return 0, false
+		}
+		return matchFoldKey(key, mode, input, index)
+	}
+}
+
+// matchAnyCase returns true if matcher accepts r or any other rune in the
+// unicode.SimpleFold orbit of r, or the Turkic special casing of r when the
+// scope has TurkicFlag set
+func matchAnyCase(matcher RuneMatcher, r rune, scope Flags) bool {
+	if matcher(r) {
+		return true
+	} else if scope&TurkicFlag == TurkicFlag {
+		switch r {
+		case 'I', 'İ', 'i', 'ı':
+			return matcher(unicode.TurkishCase.ToLower(r)) || matcher(unicode.TurkishCase.ToUpper(r))
+		}
+	}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if matcher(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFolded returns true if matcher accepts r, or any of the runes which
+// are equivalent to r for the case, width and kana folding modes present in
+// the scope given
+func matchFolded(matcher RuneMatcher, r rune, scope Flags) bool {
+	if scope&gCaseFlags != 0 {
+		if matchAnyCase(matcher, r, scope) {
+			return true
+		}
+	} else if matcher(r) {
+		return true
+	}
+	if scope&gWidthFlags != 0 {
+		var buf [4]rune
+		for _, form := range widthForms(buf[:0], r, scope) {
+			if form != r && matchFolded(matcher, form, scope&^gWidthFlags) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,64 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFolding(t *testing.T) {
+	c.Convey("foldRune", t, func() {
+		for idx, test := range []struct {
+			input  []rune
+			output rune
+		}{
+			{[]rune{'a', 'A'}, 'A'},
+			{[]rune{'k', 'K', 'K'}, 'K'},
+			{[]rune{'s', 'S', 'ſ'}, 'S'},
+			{[]rune{'σ', 'ς', 'Σ'}, 'Σ'},
+			{[]rune{'ǆ', 'ǅ', 'Ǆ'}, 'Ǆ'},
+			{[]rune{'1'}, '1'},
+			{[]rune{'ı'}, 'ı'},
+		} {
+			for jdx, r := range test.input {
+				c.SoMsg(fmt.Sprintf("test #%d.%d - %q", idx, jdx, r), foldRune(r), c.ShouldEqual, test.output)
+			}
+		}
+	})
+
+	c.Convey("foldKey", t, func() {
+		c.So(string(foldKey(nil, 'ß', DefaultFlags)), c.ShouldEqual, "ß")
+		c.So(string(foldKey(nil, 'ß', AnyCaseFlag)), c.ShouldEqual, "ß")
+		c.So(string(foldKey(nil, 'ß', FullFoldFlag)), c.ShouldEqual, "SS")
+		c.So(string(foldKey(nil, 'ﬁ', FullFoldFlag)), c.ShouldEqual, "FI")
+	})
+
+	c.Convey("cFoldedText", t, func() {
+		text := newFoldedText([]rune("ﬁnal"))
+		size, ok := text.match(FullFoldFlag, NewInputReader("FINAL"), 0)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(size, c.ShouldEqual, 5)
+		_, ok = text.match(AnyCaseFlag, NewInputReader("FINAL"), 0)
+		c.So(ok, c.ShouldBeFalse)
+		size, ok = newFoldedText([]rune("final")).match(FullFoldFlag, NewInputReader([]rune("ﬁnal")), 0)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(size, c.ShouldEqual, 4)
+		_, ok = newFoldedText(nil).match(DefaultFlags, NewInputReader("FINAL"), 0)
+		c.So(ok, c.ShouldBeFalse)
+	})
+}
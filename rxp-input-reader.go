@@ -15,6 +15,8 @@
 package rxp
 
 import (
+	"unicode/utf8"
+
 	"github.com/go-corelibs/runes"
 )
 
@@ -81,6 +83,18 @@ func (rb *InputReader) Prev(index int) (r rune, size int, ok bool) {
 		if r, size, err = rb.buf.ReadPrevRuneFrom(int64(index)); err == nil {
 			ok = true
 			return
+		} else if index == rb.len {
+			// the end of input is a valid position to look back from
+			for back := 1; back <= utf8.UTFMax && back <= index; back++ {
+				if r, size, ok = rb.Get(index - back); ok && size == back {
+					if r != utf8.RuneError || size > 1 {
+						// RuneError with a size of one is not a rune start
+						return
+					}
+				}
+			}
+			// the last byte is not a valid utf8 sequence
+			return rb.Get(index - 1)
 		}
 	}
 	// this is no previous rune from the given index
@@ -74,6 +74,18 @@ func TestInputReader(t *testing.T) {
 		c.So(ok, c.ShouldBeFalse)
 		c.So(r, c.ShouldEqual, 0)
 		c.So(size, c.ShouldEqual, 0)
+		r, size, ok = rb.Prev(5)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(r, c.ShouldEqual, 'f')
+		c.So(size, c.ShouldEqual, 1)
+		r, size, ok = NewInputReader("café").Prev(5)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(r, c.ShouldEqual, 'é')
+		c.So(size, c.ShouldEqual, 2)
+		r, size, ok = NewInputReader("a\ufffd").Prev(4)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(r, c.ShouldEqual, '\ufffd')
+		c.So(size, c.ShouldEqual, 3)
 		// next
 		r, size, ok = rb.Next(1)
 		c.So(ok, c.ShouldBeTrue)
@@ -41,15 +41,14 @@ func _spBytesBufferSetter(v *bytes.Buffer) *bytes.Buffer {
 
 func pushByte(slice [][]byte, data []byte) [][]byte {
 	have := len(slice)
-	size := len(data)
-	need := have + size
+	need := have + 1
 	if need > cap(slice) {
 		grown := make([][]byte, have+need) // double the existing space
 		copy(grown, slice)                 // transfer to new slice
 		slice = grown                      // grown becomes slice
 	}
-	slice = slice[0:need]                  // truncate in case too many present
-	copy(slice[have:need], [][]byte{data}) // populate with data
+	slice = slice[0:need] // truncate in case too many present
+	slice[need-1] = data  // populate with data
 	return slice
 }
 
@@ -178,3 +177,25 @@ func clamp[V int | int64](v, cap V) V {
 	}
 	return v
 }
+
+// editDistance returns the Levenshtein distance between the runes of a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	row := make([]int, len(rb)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		diag := row[0]
+		row[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			next := min(row[j]+1, row[j-1]+1, diag+cost)
+			diag, row[j] = row[j], next
+		}
+	}
+	return row[len(rb)]
+}
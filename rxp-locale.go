@@ -0,0 +1,63 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"strings"
+	"unicode"
+)
+
+// localeLanguage returns the lower-cased primary language subtag of the
+// BCP 47 (or POSIX) language tag given, for example: "tr-TR" and "tr_TR.UTF-8"
+// both return "tr"
+func localeLanguage(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if idx := strings.IndexAny(tag, "-_.@"); idx >= 0 {
+		tag = tag[:idx]
+	}
+	return tag
+}
+
+// LookupSpecialCase returns the unicode.SpecialCase tailoring for the
+// language tag given, only the Turkish (tr) and Azeri (az) languages have
+// tailored case mappings
+func LookupSpecialCase(tag string) (special unicode.SpecialCase, ok bool) {
+	switch localeLanguage(tag) {
+	case "tr", "tur":
+		return unicode.TurkishCase, true
+	case "az", "aze":
+		return unicode.AzeriCase, true
+	}
+	return nil, false
+}
+
+// WithLocale returns the named flag for the case tailoring of the language
+// tag given, or an empty string (a nop flag) when the language has no
+// tailored case mappings
+//
+// WithLocale is intended to be used with the AnyCase (i) or FullFold (f)
+// flags, for example:
+//
+//	// matches "İSTANBUL" and not "ISTANBUL"
+//	Text("istanbul", "i", WithLocale("tr-TR"))
+func WithLocale(tag string) (flag string) {
+	switch localeLanguage(tag) {
+	case "tr", "tur":
+		return "tr"
+	case "az", "aze":
+		return "az"
+	}
+	return ""
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+	"unicode"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLocale(t *testing.T) {
+
+	c.Convey("LookupSpecialCase", t, func() {
+		special, ok := LookupSpecialCase("tr-TR")
+		c.So(ok, c.ShouldBeTrue)
+		c.So(special, c.ShouldEqual, unicode.TurkishCase)
+		special, ok = LookupSpecialCase("az_AZ.UTF-8")
+		c.So(ok, c.ShouldBeTrue)
+		c.So(special, c.ShouldEqual, unicode.AzeriCase)
+		special, ok = LookupSpecialCase("en-US")
+		c.So(ok, c.ShouldBeFalse)
+		c.So(special, c.ShouldBeNil)
+	})
+
+	c.Convey("WithLocale", t, func() {
+		c.So(WithLocale("TR"), c.ShouldEqual, "tr")
+		c.So(WithLocale("tur"), c.ShouldEqual, "tr")
+		c.So(WithLocale("az-Latn-AZ"), c.ShouldEqual, "az")
+		c.So(WithLocale("en"), c.ShouldEqual, "")
+		c.So(WithLocale(""), c.ShouldEqual, "")
+
+		_, flags := ParseFlags("ic", WithLocale("tr"))
+		c.So(flags.String(), c.ShouldEqual, "ic tr")
+		_, flags = ParseFlags("ic az")
+		c.So(flags.String(), c.ShouldEqual, "ic tr")
+		_, flags = ParseFlags("ic", WithLocale("en"))
+		c.So(flags.String(), c.ShouldEqual, "ic")
+		c.So(func() { ParseFlags("ictr") }, c.ShouldPanic)
+	})
+
+	c.Convey("Matching", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+			{
+				input:   "İSTANBUL ISTANBUL istanbul",
+				pattern: Pattern{}.Text("istanbul", "i", "c"),
+				output:  [][]string{{"ISTANBUL", "ISTANBUL"}, {"istanbul", "istanbul"}},
+			},
+			{
+				input:   "İSTANBUL ISTANBUL istanbul",
+				pattern: Pattern{}.Text("istanbul", "i", "c", WithLocale("tr")),
+				output:  [][]string{{"İSTANBUL", "İSTANBUL"}, {"istanbul", "istanbul"}},
+			},
+			{
+				input:   "DİYARBAKIR diyarbakır DIYARBAKIR",
+				pattern: Pattern{}.Text("diyarbakır", "i", "c", WithLocale("az")),
+				output:  [][]string{{"DİYARBAKIR", "DİYARBAKIR"}, {"diyarbakır", "diyarbakır"}},
+			},
+			{
+				input:   "İSTANBUL",
+				pattern: Pattern{}.Text("istanbul", "f", "c", "tr"),
+				output:  [][]string{{"İSTANBUL", "İSTANBUL"}},
+			},
+			{
+				input:   "İSTANBUL",
+				pattern: Pattern{}.Text("istanbul", "c", "tr"),
+				output:  [][]string(nil),
+			},
+			{
+				input:   "I İ i ı",
+				pattern: Pattern{}.R("i", "i", "c", "tr"),
+				output:  [][]string{{"İ", "İ"}, {"i", "i"}},
+			},
+			{
+				input:   "Iı iİ",
+				pattern: Pattern{}.Dot("c").BackRef(1, "i tr"),
+				output:  [][]string{{"Iı", "I"}, {"iİ", "i"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
+	c.Convey("Replace", t, func() {
+		p := Pattern{}.W("+", "u")
+		c.So(p.ReplaceAllString("istanbul ılık", Replace[string]{}.ToUpperLocale("tr")), c.ShouldEqual, "İSTANBUL ILIK")
+		c.So(p.ReplaceAllString("İSTANBUL ILIK", Replace[string]{}.ToLowerLocale("tr")), c.ShouldEqual, "istanbul ılık")
+		c.So(p.ReplaceAllString("istanbul", Replace[string]{}.ToUpperLocale("en")), c.ShouldEqual, "ISTANBUL")
+		c.So(p.ReplaceAllString("ISTANBUL", Replace[string]{}.ToLowerLocale("en")), c.ShouldEqual, "istanbul")
+		c.So(string(p.ReplaceAllBytes([]byte("istanbul"), Replace[[]byte]{}.ToUpperLocale("az"))), c.ShouldEqual, "İSTANBUL")
+		c.So(string(p.ReplaceAllBytes([]byte("ILIK"), Replace[[]byte]{}.ToLowerLocale("az"))), c.ShouldEqual, "ılık")
+		c.So(string(p.ReplaceAllRunes([]rune("istanbul"), Replace[[]rune]{}.ToUpperLocale("tr"))), c.ShouldEqual, "İSTANBUL")
+		c.So(string(p.ReplaceAllRunes([]rune("ILIK"), Replace[[]rune]{}.ToLowerLocale("tr"))), c.ShouldEqual, "ılık")
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"sync"
+)
+
+// gCaptureNameShift is the position of the capture name id within the Flags
+// returned by Named Matchers, above all of the Flags bits
+const gCaptureNameShift = 21
+
+// gCaptureNameMask is the capture name id bits of a Flags value
+const gCaptureNameMask = ^Flags(0) >> gCaptureNameShift << gCaptureNameShift
+
+// gCaptureNames are the capture names given to Named, the capture name id is
+// the index of the name, zero being no name
+var gCaptureNames = struct {
+	sync.RWMutex
+	ids   map[string]Flags
+	names []string
+}{
+	ids:   map[string]Flags{},
+	names: []string{""},
+}
+
+// captureNameFlags returns the Flags with the capture name id of the name
+// given, adding the name to gCaptureNames as needed
+func captureNameFlags(name string) (id Flags) {
+	gCaptureNames.Lock()
+	defer gCaptureNames.Unlock()
+	if id = gCaptureNames.ids[name]; id != 0 {
+		return
+	} else if next := Flags(len(gCaptureNames.names)); next > gCaptureNameMask>>gCaptureNameShift {
+		panic(fmt.Errorf("too many capture names, limit is %d", next-1))
+	} else {
+		id = next << gCaptureNameShift
+	}
+	gCaptureNames.ids[name] = id
+	gCaptureNames.names = append(gCaptureNames.names, name)
+	return
+}
+
+// captureName returns the capture name of the Flags given
+func captureName(scoping Flags) (name string) {
+	gCaptureNames.RLock()
+	defer gCaptureNames.RUnlock()
+	if id := int(scoping >> gCaptureNameShift); id < len(gCaptureNames.names) {
+		name = gCaptureNames.names[id]
+	}
+	return
+}
+
+// Match is a single Pattern match found within an input, along with any of
+// its capture groups
+//
+// Group zero is the entire match and the capture groups follow in the order
+// of the capturing Matchers within the Pattern. The zero Match has a Len of
+// zero, no groups and an empty Text
+//
+// The indexes of a Match are byte offsets for string and []byte inputs and
+// rune offsets for []rune inputs, the same as the Index methods of Pattern
+type Match struct {
+	input  *InputReader
+	groups [][2]int
+	names  []string
+}
+
+// Start returns the index of the start of this Match
+func (m Match) Start() int {
+	if len(m.groups) > 0 {
+		return m.groups[0][0]
+	}
+	return 0
+}
+
+// End returns the index of the end of this Match
+func (m Match) End() int {
+	if len(m.groups) > 0 {
+		return m.groups[0][1]
+	}
+	return 0
+}
+
+// Text returns the matched text of this Match
+func (m Match) Text() string {
+	return m.Group(0)
+}
+
+// Len returns the number of groups in this Match, including group zero
+func (m Match) Len() int {
+	return len(m.groups)
+}
+
+// Index returns the starting and ending indexes of the group n, ok is false
+// when there is no group n
+func (m Match) Index(n int) (index [2]int, ok bool) {
+	if ok = 0 <= n && n < len(m.groups); ok {
+		index = m.groups[n]
+	}
+	return
+}
+
+// Group returns the text of the group n, group zero is the entire match and
+// an empty string is returned when there is no group n
+func (m Match) Group(n int) string {
+	if index, ok := m.Index(n); ok {
+		return m.input.String(index[0], index[1]-index[0])
+	}
+	return ""
+}
+
+// NamedGroup returns the text of the capture group with the name given and
+// an empty string when there is no group with the name given
+//
+// Capture groups are named with the Named Matcher, used directly within the
+// Pattern
+func (m Match) NamedGroup(name string) string {
+	for idx, named := range m.names {
+		if named == name {
+			return m.Group(idx + 1)
+		}
+	}
+	return ""
+}
+
+// Groups returns the text of all the groups of this Match, including group
+// zero
+func (m Match) Groups() (groups []string) {
+	for idx := range m.groups {
+		groups = append(groups, m.Group(idx))
+	}
+	return
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMatch(t *testing.T) {
+
+	c.Convey("zero", t, func() {
+		var m Match
+		c.So(m.Len(), c.ShouldEqual, 0)
+		c.So(m.Start(), c.ShouldEqual, 0)
+		c.So(m.End(), c.ShouldEqual, 0)
+		c.So(m.Text(), c.ShouldEqual, "")
+		c.So(m.Group(0), c.ShouldEqual, "")
+		c.So(m.NamedGroup("year"), c.ShouldEqual, "")
+		c.So(m.Groups(), c.ShouldEqual, []string(nil))
+		_, ok := m.Index(0)
+		c.So(ok, c.ShouldBeFalse)
+	})
+
+	c.Convey("batch", t, func() {
+
+		date := Pattern{}.D("{4}", "c").Text("-").D("{2}", "c").Text("-").D("{2}", "c")
+		named := Pattern{}.Named("year", D("{4}")).Text("-").Named("month", D("{2}")).Text("-").Named("day", D("{2}"))
+		reordered := Pattern{}.Named("day", D("{2}")).Text(".").Named("month", D("{2}")).Text(".").Named("year", D("{4}"))
+		mixed := Pattern{}.D("{4}", "c").Text("-").Named("month", D("{2}")).Text("-").D("{2}", "c")
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			start   int
+			end     int
+			text    string
+			groups  []string
+			named   map[string]string
+		}{
+
+			{
+				input: "nothing", pattern: named,
+				start: 0, end: 0, text: "",
+				groups: []string(nil), named: map[string]string{"year": ""},
+			},
+			{
+				input: "on 2024-06-15.", pattern: date,
+				start: 3, end: 13, text: "2024-06-15",
+				groups: []string{"2024-06-15", "2024", "06", "15"}, named: map[string]string{"year": ""},
+			},
+			{
+				input: "on 2024-06-15.", pattern: named,
+				start: 3, end: 13, text: "2024-06-15",
+				groups: []string{"2024-06-15", "2024", "06", "15"},
+				named:  map[string]string{"year": "2024", "month": "06", "day": "15", "hour": ""},
+			},
+			{
+				input: "on 15.06.2024.", pattern: reordered,
+				start: 3, end: 13, text: "15.06.2024",
+				groups: []string{"15.06.2024", "15", "06", "2024"},
+				named:  map[string]string{"year": "2024", "month": "06", "day": "15", "hour": ""},
+			},
+			{
+				input: "on 2024-06-15.", pattern: mixed,
+				start: 3, end: 13, text: "2024-06-15",
+				groups: []string{"2024-06-15", "2024", "06", "15"},
+				named:  map[string]string{"year": "", "month": "06", "day": ""},
+			},
+			{
+				input: "1a", pattern: Pattern{}.Group(Named("digit", D()), Text("a")),
+				start: 0, end: 2, text: "1a",
+				groups: []string{"1a"}, named: map[string]string{"digit": ""},
+			},
+			{
+				input: "día 2024", pattern: Pattern{}.D("+"),
+				start: 5, end: 9, text: "2024",
+				groups: []string{"2024"}, named: map[string]string{},
+			},
+		} {
+			for _, m := range []Match{
+				test.pattern.FindStringMatch(test.input),
+				test.pattern.FindBytesMatch([]byte(test.input)),
+			} {
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.Start(), c.ShouldEqual, test.start)
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.End(), c.ShouldEqual, test.end)
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.Text(), c.ShouldEqual, test.text)
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.Len(), c.ShouldEqual, len(test.groups))
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.Groups(), c.ShouldEqual, test.groups)
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.Group(len(test.groups)), c.ShouldEqual, "")
+				c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.Group(-1), c.ShouldEqual, "")
+				for name, text := range test.named {
+					c.SoMsg(fmt.Sprintf("test #%d - %q (%s)", idx, test.input, name), m.NamedGroup(name), c.ShouldEqual, text)
+				}
+			}
+			m := test.pattern.FindRunesMatch([]rune(test.input))
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.Text(), c.ShouldEqual, test.text)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), m.Groups(), c.ShouldEqual, test.groups)
+			for name, text := range test.named {
+				c.SoMsg(fmt.Sprintf("test #%d - %q (%s)", idx, test.input, name), m.NamedGroup(name), c.ShouldEqual, text)
+			}
+		}
+
+		c.So(func() { _ = Named("") }, c.ShouldPanic)
+
+	})
+
+	c.Convey("named groups while iterating", t, func() {
+
+		pair := Pattern{}.Named("key", W("+")).Text("=").Named("value", W("+"))
+		input := "a=1 b=22"
+		expected := [][2]string{{"a", "1"}, {"b", "22"}}
+
+		var found [][2]string
+		collect := func(m Match) bool {
+			found = append(found, [2]string{m.NamedGroup("key"), m.NamedGroup("value")})
+			return true
+		}
+
+		for name, iterate := range map[string]func(){
+			"EachStringMatch": func() { pair.EachStringMatch(input, collect) },
+			"EachBytesMatch":  func() { pair.EachBytesMatch([]byte(input), collect) },
+			"EachRunesMatch":  func() { pair.EachRunesMatch([]rune(input), collect) },
+			"StringMatchSeq":  func() { pair.StringMatchSeq(input)(collect) },
+			"BytesMatchSeq":   func() { pair.BytesMatchSeq([]byte(input))(collect) },
+			"RunesMatchSeq":   func() { pair.RunesMatchSeq([]rune(input))(collect) },
+		} {
+			found = nil
+			iterate()
+			c.SoMsg(name, found, c.ShouldEqual, expected)
+		}
+
+		found = nil
+		Pattern{}.W("+", "c").Text("=").W("+", "c").EachStringMatch(input, collect)
+		c.So(found, c.ShouldEqual, [][2]string{{"", ""}, {"", ""}})
+
+	})
+
+}
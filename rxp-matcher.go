@@ -39,20 +39,75 @@ type RuneMatcher func(r rune) bool
 
 // WrapMatcher creates a Matcher using MakeMatcher and wrapping a RuneMatcher
 func WrapMatcher(matcher RuneMatcher, flags ...string) Matcher {
+	return wrapMatchers(matcher, nil, flags...)
+}
+
+// gWrapMatcherFlags are the Flags which change how wrapMatchers reads and
+// compares the input runes
+const gWrapMatcherFlags = UnicodeFlag | GraphemeFlag | NormalizeFlag | AccentFlag | gCaseFlags | gWidthFlags
+
+// wrapMatchers is the WrapMatcher implementation, the optional wide
+// RuneMatcher is used instead of the ascii one when the UnicodeFlag is set
+//
+// With the GraphemeFlag set, the RuneMatcher is given the first rune of each
+// extended grapheme cluster and a match consumes the whole cluster, nothing
+// matches from within a cluster
+func wrapMatchers(ascii, wide RuneMatcher, flags ...string) Matcher {
+	if wide == nil {
+		wide = ascii
+	}
 	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
 		scoped = scope
+		if scoped&gWrapMatcherFlags == 0 {
+			// the common case of one plain rune, kept apart from the grapheme,
+			// normalization and folding checks below which cost more than the
+			// RuneMatcher itself
+			if r, size, ok := input.Get(index); ok {
+				if proceed = ascii(r) != (scoped&NegatedFlag == NegatedFlag); proceed {
+					consumed = size
+				}
+			}
+			return
+		}
+		if scoped&GraphemeFlag == GraphemeFlag && !graphemeBoundary(input, index) {
+			// not the start of an extended grapheme cluster
+			return
+		}
 		if 0 <= index && index < input.len {
 			r, size, _ := input.Get(index)
-			if proceed = matcher(r); scoped&NegatedFlag == NegatedFlag {
+			if scoped&(NormalizeFlag|AccentFlag) != 0 {
+				// the composition of the rune and any marks following, or the
+				// base rune when ignoring accents
+				if nr, ns, ok := normalizedRune(scoped, input, index); ok {
+					r, size = nr, ns
+				}
+			}
+			if voiced, vs, ok := widthVoiced(r, scoped, input, index+size); ok {
+				// halfwidth katakana with a halfwidth voiced sound mark
+				r, size = voiced, size+vs
+			}
+			matcher := ascii
+			if scoped&UnicodeFlag == UnicodeFlag {
+				matcher = wide
+			}
+			if scoped&(gCaseFlags|gWidthFlags) != 0 {
+				proceed = matchFolded(matcher, r, scoped)
+			} else {
+				proceed = matcher(r)
+			}
+			if scoped&NegatedFlag == NegatedFlag {
 				proceed = !proceed
 			}
+			if proceed && scoped&GraphemeFlag == GraphemeFlag {
+				// the rest of the extended grapheme cluster started by r
+				size = max(size, graphemeClusterSize(input, index))
+			}
 			if proceed {
 				consumed += size
 			}
 		}
 		return
 	}, flags...)
-
 }
 
 // MakeMatcher creates a rxp standard Matcher implementation wrapped
@@ -139,3 +139,25 @@ func Group(options ...interface{}) Matcher {
 		return
 	}, flags...)
 }
+
+// Named is a capturing Group with the name given, the capture group can then
+// be found by name with Match.NamedGroup instead of by its position within
+// the Pattern
+//
+// Only the Named Matchers used directly within a Pattern name their capture
+// groups, the same as only the Matchers used directly within a Pattern are
+// capture groups
+//
+// Named will panic if the name is empty
+func Named(name string, options ...interface{}) Matcher {
+	if name == "" {
+		panic("Named requires a non-empty name argument")
+	}
+	id := captureNameFlags(name)
+	group := Group(append(options, "c")...)
+	return func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
+		scoped, consumed, proceed = group(scope, reps, input, index, sm)
+		scoped |= id
+		return
+	}
+}
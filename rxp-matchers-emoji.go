@@ -0,0 +1,75 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+// Emoji creates a Matcher for one whole emoji sequence, including the ZWJ
+// sequences, skin tone modifier sequences, keycaps, flags and tag sequences,
+// for example:
+//
+//	Pattern{}.Emoji("c").FindAllString("hi \U0001f44b\U0001f3fd #\ufe0f\u20e3 \u00a9", -1)
+//	// ["\U0001f44b\U0001f3fd", "#\ufe0f\u20e3", "\u00a9"]
+//
+// Emoji matches the emoji characters which are displayed as text by default,
+// such as the copyright sign, see EmojiPresentation for only matching the
+// emoji which are displayed as emoji
+//
+// With the negation (^) flag, Emoji matches one rune which does not start an
+// emoji sequence
+func Emoji(flags ...string) Matcher {
+	return sequenceMatcher(func(input *InputReader, index int) int {
+		return emojiSequenceSize(input, index, false)
+	}, flags...)
+}
+
+// EmojiPresentation is like Emoji, except that the emoji characters which are
+// displayed as text by default are only matched when followed by the emoji
+// variation selector (U+FE0F) or when part of a longer emoji sequence
+func EmojiPresentation(flags ...string) Matcher {
+	return sequenceMatcher(func(input *InputReader, index int) int {
+		return emojiSequenceSize(input, index, true)
+	}, flags...)
+}
+
+// RegionalFlag creates a Matcher for one pair of regional indicator symbols,
+// which are the country and region flag emoji
+func RegionalFlag(flags ...string) Matcher {
+	return sequenceMatcher(regionalFlagSize, flags...)
+}
+
+// sequenceMatcher is the Emoji, EmojiPresentation and RegionalFlag
+// implementation, the size function returns the size of the sequence at the
+// index given, or zero when there is no sequence
+func sequenceMatcher(size func(input *InputReader, index int) int, flags ...string) Matcher {
+	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
+		scoped = scope
+		_, rs, ok := input.Get(index)
+		if !ok {
+			// out-of-bounds is a negative negated making it positive with
+			// zero consumed
+			proceed = scoped.Negated()
+			return
+		}
+		if consumed = size(input, index); scoped.Negated() {
+			if proceed = consumed == 0; proceed {
+				consumed = rs
+			} else {
+				consumed = 0
+			}
+			return
+		}
+		proceed = consumed > 0
+		return
+	}, flags...)
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestEmojiMatchers(t *testing.T) {
+	c.Convey("Emoji, EmojiPresentation and RegionalFlag", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "hi \U0001f44b\U0001f3fd \U0001f468\u200d\U0001f469\u200d\U0001f467 1\ufe0f\u20e3 © 12",
+				pattern: Pattern{}.Emoji("c"),
+				output:  [][]string{{"\U0001f44b\U0001f3fd", "\U0001f44b\U0001f3fd"}, {"\U0001f468\u200d\U0001f469\u200d\U0001f467", "\U0001f468\u200d\U0001f469\u200d\U0001f467"}, {"1\ufe0f\u20e3", "1\ufe0f\u20e3"}, {"©", "©"}},
+			},
+
+			{
+				input:   "© ©\ufe0f \U0001f600",
+				pattern: Pattern{}.EmojiPresentation("c"),
+				output:  [][]string{{"©\ufe0f", "©\ufe0f"}, {"\U0001f600", "\U0001f600"}},
+			},
+
+			{
+				input:   "\U0001f1ef\U0001f1f5\U0001f1fa\U0001f1f8\U0001f1eb",
+				pattern: Pattern{}.RegionalFlag("c"),
+				output:  [][]string{{"\U0001f1ef\U0001f1f5", "\U0001f1ef\U0001f1f5"}, {"\U0001f1fa\U0001f1f8", "\U0001f1fa\U0001f1f8"}},
+			},
+
+			{
+				input:   "\U0001f600\U0001f601\U0001f602 ok",
+				pattern: Pattern{}.Emoji("{2}", "c"),
+				output:  [][]string{{"\U0001f600\U0001f601", "\U0001f600\U0001f601"}},
+			},
+
+			{
+				input:   "hi \U0001f44b\U0001f3fd there",
+				pattern: Pattern{}.Emoji("^+", "c"),
+				output:  [][]string{{"hi ", "hi "}, {" there", " there"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+}
@@ -0,0 +1,206 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+)
+
+// Fuzzy creates a Matcher for the plain text given, accepting up to the
+// budget number of edits, where an edit is the substitution, insertion or
+// deletion of one rune
+//
+// With the named transpose flag, swapping two adjacent runes counts as one
+// edit instead of two and with the AnyCase (i) or FullFold (f) flags, runes
+// are compared with simple case folding
+//
+// At each index, Fuzzy matches the input with the lowest cost (number of
+// edits), preferring the longest input for the same cost. For example, with a
+// budget of two, Fuzzy("invoice", 2) matches "lnvoice" (cost 1), "inv0ce"
+// (cost 2) and the "invoice" of "invoices" (cost 0). Use FuzzyCost to find the
+// cost of the text matched, for ranking the results
+//
+// Fuzzy will panic if the budget is negative
+func Fuzzy(text string, budget int, flags ...string) Matcher {
+	if budget < 0 {
+		panic(fmt.Errorf("invalid Fuzzy budget: %d", budget))
+	}
+	content := []rune(text)
+
+	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
+		scoped = scope
+
+		if scoped&NegatedFlag == NegatedFlag {
+			// the meaning of "proceed" is inverted in a negation context
+
+			if proceed = 0 > index || index >= input.len; proceed {
+				// out-of-bounds is a negative negated making it positive with
+				// zero consumed
+				return
+			}
+
+			// track this index size to increment []byte and string readers correctly
+			_, size, _ := input.Get(index)
+
+			if _, _, matched := fuzzyMatch(content, budget, scoped, input, index); !matched {
+				// not matched is true proceed, consuming the size of this index
+				proceed = true
+				consumed = size
+			}
+
+			return
+		}
+
+		if 0 > index || index >= input.len {
+			// negative index, or index oob
+			return
+		}
+
+		consumed, _, proceed = fuzzyMatch(content, budget, scoped, input, index)
+		return
+	}, flags...)
+}
+
+// FuzzyCost returns the number of edits between the text and the matched
+// input given, using the same flags as were given to Fuzzy
+func FuzzyCost(text, matched string, flags ...string) (cost int) {
+	_, scope := ParseFlags(flags...)
+	d := newFuzzyDistance([]rune(text), scope)
+	for _, r := range matched {
+		d.next(r)
+	}
+	return d.cost()
+}
+
+// fuzzyMatch returns the size and cost of the input at the index given which
+// is within the budget number of edits of the text given
+//
+// Matches do not start with an inserted rune and are not reported at an index
+// when the match at the following rune costs less, so that for example the
+// leading space of " invoice" is not included
+func fuzzyMatch(text []rune, budget int, scope Flags, input *InputReader, index int) (size, cost int, ok bool) {
+	if size, cost, ok = fuzzyMatchAt(text, budget, scope, input, index); ok && cost > 0 {
+		if _, rs, present := input.Get(index); present {
+			if _, nc, nok := fuzzyMatchAt(text, cost-1, scope, input, index+rs); nok && nc < cost {
+				return 0, 0, false
+			}
+		}
+	}
+	return
+}
+
+// fuzzyMatchAt is the fuzzyMatch implementation for a single index
+func fuzzyMatchAt(text []rune, budget int, scope Flags, input *InputReader, index int) (size, cost int, ok bool) {
+	d := newFuzzyDistance(text, scope)
+	d.anchored = true
+	cost = budget + 1
+
+	// the longest possible match is the text with budget insertions
+	for count, consumed := 0, 0; count < len(text)+budget; count++ {
+		r, rs, present := input.Get(index + consumed)
+		if !present {
+			break
+		}
+		consumed += rs
+		if d.next(r) > budget {
+			// no further input can be within the budget
+			break
+		} else if c := d.cost(); c <= cost {
+			// lowest cost, preferring longer
+			size, cost = consumed, c
+		}
+	}
+
+	if ok = size > 0 && cost <= budget; !ok {
+		return 0, 0, false
+	}
+	return
+}
+
+// cFuzzyDistance is the column-wise calculation of the optimal string
+// alignment distance between a text and the runes given to next
+type cFuzzyDistance struct {
+	text  []rune
+	scope Flags
+	// prev2, prev and this are the last three columns of distances, where
+	// the value at position n is the distance of the text[:n]
+	prev2, prev, this []int
+	// last is the previous input rune, for transpositions
+	last  rune
+	count int
+	// anchored prevents the insertion of input runes before the first rune
+	// of the text
+	anchored bool
+}
+
+func newFuzzyDistance(text []rune, scope Flags) (d *cFuzzyDistance) {
+	size := len(text) + 1
+	buf := make([]int, size*3)
+	d = &cFuzzyDistance{
+		text:  text,
+		scope: scope,
+		prev2: buf[0:size],
+		prev:  buf[size : size*2],
+		this:  buf[size*2:],
+	}
+	for idx := range d.this {
+		// empty input is a deletion of each text rune
+		d.this[idx] = idx
+	}
+	return
+}
+
+// cost returns the distance between the text and the input given so far
+func (d *cFuzzyDistance) cost() int {
+	return d.this[len(d.text)]
+}
+
+// next adds the next input rune and returns the lowest distance of all the
+// text prefixes, more input can only increase the lowest distance
+func (d *cFuzzyDistance) next(r rune) (lowest int) {
+	d.prev2, d.prev, d.this = d.prev, d.this, d.prev2
+	d.count += 1
+
+	if d.this[0] = d.count; d.anchored {
+		// more than any distance of the text prefixes
+		d.this[0] = len(d.text) + d.count + 1
+	}
+	lowest = d.this[0]
+	for n := 1; n <= len(d.text); n++ {
+		tr := d.text[n-1]
+
+		substitution := d.prev[n-1]
+		if !foldEqual(tr, r, d.scope) {
+			substitution += 1
+		}
+
+		cost := min(
+			d.prev[n]+1,   // insertion of r
+			d.this[n-1]+1, // deletion of tr
+			substitution,
+		)
+
+		if d.scope.Transpose() && n > 1 && d.count > 1 &&
+			foldEqual(tr, d.last, d.scope) && foldEqual(d.text[n-2], r, d.scope) {
+			cost = min(cost, d.prev2[n-2]+1)
+		}
+
+		d.this[n] = cost
+		lowest = min(lowest, cost)
+	}
+
+	d.last = r
+	return
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFuzzy(t *testing.T) {
+	c.Convey("Fuzzy", t, func() {
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+			{
+				input:   "invoice lnvoice inv0ce invoices nothing",
+				pattern: Pattern{}.Fuzzy("invoice", 2, "c"),
+				output:  [][]string{{"invoice", "invoice"}, {"lnvoice", "lnvoice"}, {"inv0ce", "inv0ce"}, {"invoice", "invoice"}},
+			},
+			{
+				input:   "invoice lnvoice inv0ce",
+				pattern: Pattern{}.Fuzzy("invoice", 1, "c"),
+				output:  [][]string{{"invoice", "invoice"}, {"lnvoice", "lnvoice"}},
+			},
+			{
+				input:   "invoice inovice",
+				pattern: Pattern{}.Fuzzy("invoice", 1, "c"),
+				output:  [][]string{{"invoice", "invoice"}},
+			},
+			{
+				input:   "invoice inovice",
+				pattern: Pattern{}.Fuzzy("invoice", 1, "transpose", "c"),
+				output:  [][]string{{"invoice", "invoice"}, {"inovice", "inovice"}},
+			},
+			{
+				input:   "INVOICE lNV0ICE",
+				pattern: Pattern{}.Fuzzy("invoice", 2, "c"),
+				output:  [][]string(nil),
+			},
+			{
+				input:   "INVOICE lNV0ICE",
+				pattern: Pattern{}.Fuzzy("invoice", 2, "i", "c"),
+				output:  [][]string{{"INVOICE", "INVOICE"}, {"lNV0ICE", "lNV0ICE"}},
+			},
+			{
+				input:   "Invoice #: 1234, lnvoice#:99",
+				pattern: Pattern{}.Fuzzy("invoice", 1, "i", "c").S("*").Text("#").S("*").Text(":").S("*").D("+", "c"),
+				output:  [][]string{{"Invoice #: 1234", "Invoice", "1234"}, {"lnvoice#:99", "lnvoice", "99"}},
+			},
+			{
+				input:   "xinvoice",
+				pattern: Pattern{}.Fuzzy("invoice", 2, "c"),
+				output:  [][]string{{"invoice", "invoice"}},
+			},
+			{
+				input:   "ab",
+				pattern: Pattern{}.Fuzzy("a", 1, "c"),
+				output:  [][]string{{"a", "a"}, {"b", "b"}},
+			},
+			{
+				input:   "façade facade",
+				pattern: Pattern{}.Fuzzy("façade", 1, "c"),
+				output:  [][]string{{"façade", "façade"}, {"facade", "facade"}},
+			},
+			{
+				input:   "cat dog",
+				pattern: Pattern{}.Fuzzy("cat", 0, "^", "+", "c"),
+				output:  [][]string{{"at dog", "at dog"}},
+			},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), test.pattern.FindAllStringSubmatch(test.input, -1), c.ShouldEqual, test.output)
+		}
+
+		c.So(func() { Fuzzy("invoice", -1) }, c.ShouldPanic)
+	})
+
+	c.Convey("FuzzyCost", t, func() {
+		for idx, test := range []struct {
+			text    string
+			matched string
+			flags   []string
+			cost    int
+		}{
+			{"invoice", "invoice", nil, 0},
+			{"invoice", "lnvoice", nil, 1},
+			{"invoice", "inv0ce", nil, 2},
+			{"invoice", "INVOICE", nil, 7},
+			{"invoice", "INVOICE", []string{"i"}, 0},
+			{"invoice", "inovice", nil, 2},
+			{"invoice", "inovice", []string{"transpose"}, 1},
+			{"invoice", "", nil, 7},
+			{"", "invoice", nil, 7},
+			{"façade", "facade", nil, 1},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.matched), FuzzyCost(test.text, test.matched, test.flags...), c.ShouldEqual, test.cost)
+		}
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Literals creates a Matcher for any one of the plain text words given,
+// selecting the longest word which matches at each index
+//
+// Literals builds a trie of the words so that the cost of matching does not
+// grow with the number of words, unlike the equivalent Or of Text matchers
+// which tries each word in turn. Empty words are ignored
+//
+// With the AnyCase (i) flag, Literals compares runes using Unicode simple
+// case folding and with the FullFold (f) flag, Literals uses Unicode full
+// case folding, the same as Text
+func Literals(words []string, flags ...string) Matcher {
+	t := &cLiterals{words: append([]string(nil), words...)}
+	t.exact = newTrie(t.words, 0)
+
+	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
+		scoped = scope
+
+		if scoped&NegatedFlag == NegatedFlag {
+			// the meaning of "proceed" is inverted in a negation context
+
+			if proceed = 0 > index || index >= input.len; proceed {
+				// out-of-bounds is a negative negated making it positive with
+				// zero consumed
+				return
+			}
+
+			// track this index size to increment []byte and string readers correctly
+			_, size, _ := input.Get(index)
+
+			if _, matched := t.match(scoped, input, index); !matched {
+				// not matched is true proceed, consuming the size of this index
+				proceed = true
+				consumed = size
+			}
+
+			return
+		}
+
+		if 0 > index || index >= input.len {
+			// negative index, or index oob
+			return
+		}
+
+		consumed, proceed = t.match(scoped, input, index)
+		return
+	}, flags...)
+}
+
+// ReadLiterals is a convenience wrapper around Literals, reading the words
+// from the reader given, one word per line. Leading and trailing white space
+// is trimmed and empty lines are ignored
+//
+// ReadLiterals returns any error encountered while reading
+func ReadLiterals(reader io.Reader, flags ...string) (m Matcher, err error) {
+	var words []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		if word := strings.TrimSpace(scanner.Text()); word != "" {
+			words = append(words, word)
+		}
+	}
+	if err = scanner.Err(); err == nil {
+		m = Literals(words, flags...)
+	}
+	return
+}
+
+// cLiterals is the content of a Literals Matcher
+type cLiterals struct {
+	words  []string
+	exact  *cTrie
+	folded sync.Map // map[Flags]*cTrie
+}
+
+// match returns the size of the longest word matching at the index given
+func (t *cLiterals) match(scope Flags, input *InputReader, index int) (size int, ok bool) {
+	if mode := trieMode(scope); mode != 0 {
+		folded, present := t.folded.Load(mode)
+		if !present {
+			// case-insensitive tries are only made when needed
+			folded, _ = t.folded.LoadOrStore(mode, newTrie(t.words, mode))
+		}
+		return folded.(*cTrie).match(input, index)
+	}
+	return t.exact.match(input, index)
+}
+
+// trieMode returns the case folding Flags of the scope given which change
+// the keys of a cTrie
+func trieMode(scope Flags) (mode Flags) {
+	if scope.FullFold() {
+		mode = FullFoldFlag
+	} else if scope.AnyCase() {
+		mode = AnyCaseFlag
+	} else {
+		return 0
+	}
+	return mode | scope&TurkicFlag
+}
+
+// cTrieEdge is the key of a cTrie transition from one node with one rune
+type cTrieEdge struct {
+	node int32
+	r    rune
+}
+
+// cTrie is a rune trie, where all the edges are kept in one map and the
+// nodes are indexes into the terminal list, zero is the root node
+//
+// The edges of a cTrie are the case folding keys of the runes, for the case
+// folding mode of the cTrie, see foldCaseKey
+type cTrie struct {
+	mode     Flags
+	edges    map[cTrieEdge]int32
+	terminal []bool
+}
+
+func newTrie(words []string, mode Flags) (t *cTrie) {
+	t = &cTrie{
+		mode:     mode,
+		edges:    make(map[cTrieEdge]int32),
+		terminal: []bool{false},
+	}
+	for _, word := range words {
+		t.insert(word)
+	}
+	return
+}
+
+// insert adds the word given to the trie and returns the node of the word,
+// which is zero (the root node) for empty words
+func (t *cTrie) insert(word string) (node int32) {
+	var buf [4]rune
+	for _, r := range word {
+		for _, kr := range foldCaseKey(buf[:0], r, t.mode) {
+			edge := cTrieEdge{node: node, r: kr}
+			next, present := t.edges[edge]
+			if !present {
+				next = int32(len(t.terminal))
+				t.terminal = append(t.terminal, false)
+				t.edges[edge] = next
+			}
+			node = next
+		}
+	}
+	if node > 0 {
+		t.terminal[node] = true
+	}
+	return
+}
+
+// match walks the trie with the input at the index given and returns the
+// size of the longest word found
+func (t *cTrie) match(input *InputReader, index int) (size int, ok bool) {
+	t.walk(input, index, func(node int32, consumed int) {
+		size, ok = consumed, true
+	})
+	return
+}
+
+// walk walks the trie with the input at the index given and calls the fn
+// given with the node and size of each word found, shortest first
+//
+// Words are only found at the end of an input rune, a word ending part way
+// through the full case folding of an input rune is not found
+func (t *cTrie) walk(input *InputReader, index int, fn func(node int32, size int)) {
+	var buf [4]rune
+	var node int32
+	for consumed := 0; ; {
+		r, rs, present := input.Get(index + consumed)
+		if !present {
+			return
+		}
+		for _, kr := range foldCaseKey(buf[:0], r, t.mode) {
+			next, found := t.edges[cTrieEdge{node: node, r: kr}]
+			if !found {
+				return
+			}
+			node = next
+		}
+		consumed += rs
+		if t.terminal[node] {
+			fn(node, consumed)
+		}
+	}
+}
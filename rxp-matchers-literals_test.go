@@ -0,0 +1,124 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLiterals(t *testing.T) {
+	c.Convey("Literals", t, func() {
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+			{
+				input:   "the cat in the catalog",
+				pattern: Pattern{}.Literals([]string{"cat", "catalog", "the", ""}, "c"),
+				output:  [][]string{{"the", "the"}, {"cat", "cat"}, {"the", "the"}, {"catalog", "catalog"}},
+			},
+			{
+				input:   "The CAT in the Catalog",
+				pattern: Pattern{}.Literals([]string{"cat", "catalog", "the"}, "c"),
+				output:  [][]string{{"the", "the"}},
+			},
+			{
+				input:   "The CAT in the Catalog",
+				pattern: Pattern{}.Literals([]string{"cat", "catalog", "the"}, "i", "c"),
+				output:  [][]string{{"The", "The"}, {"CAT", "CAT"}, {"the", "the"}, {"Catalog", "Catalog"}},
+			},
+			{
+				input:   "Straße STRASSE straße",
+				pattern: Pattern{}.Literals([]string{"STRAẞE"}, "i", "c"),
+				output:  [][]string{{"Straße", "Straße"}, {"straße", "straße"}},
+			},
+			{
+				input:   "catcat dog",
+				pattern: Pattern{}.Literals([]string{"cat", "dog"}, "+", "c"),
+				output:  [][]string{{"catcat", "catcat"}, {"dog", "dog"}},
+			},
+			{
+				input:   "a cat, a dog",
+				pattern: Pattern{}.Literals([]string{"cat", "dog"}, "^", "+", "c"),
+				output:  [][]string{{"a ", "a "}, {"at, a ", "at, a "}, {"og", "og"}},
+			},
+			{
+				input:   "cat=catalog",
+				pattern: Pattern{}.Literals([]string{"cat", "catalog"}, "c").Text("=").BackRef(1),
+				output:  [][]string{{"cat=cat", "cat"}},
+			},
+			{
+				input:   "id: café, id: cafe",
+				pattern: Pattern{}.Text("id: ").Literals([]string{"café", "cafe"}, "c"),
+				output:  [][]string{{"id: café", "café"}, {"id: cafe", "cafe"}},
+			},
+			{
+				input:   "Straße strasse STRASSE",
+				pattern: Pattern{}.Literals([]string{"strasse"}, "f", "c"),
+				output:  [][]string{{"Straße", "Straße"}, {"strasse", "strasse"}, {"STRASSE", "STRASSE"}},
+			},
+			{
+				input:   "Straße strasse STRASSE",
+				pattern: Pattern{}.Literals([]string{"straße"}, "f", "c"),
+				output:  [][]string{{"Straße", "Straße"}, {"strasse", "strasse"}, {"STRASSE", "STRASSE"}},
+			},
+			{ // words do not end part way through the folding of an input rune
+				input:   "ß",
+				pattern: Pattern{}.Literals([]string{"s"}, "f", "c"),
+				output:  [][]string(nil),
+			},
+			{
+				input:   "İSTANBUL istanbul",
+				pattern: Pattern{}.Literals([]string{"istanbul"}, "i", "tr", "c"),
+				output:  [][]string{{"İSTANBUL", "İSTANBUL"}, {"istanbul", "istanbul"}},
+			},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), test.pattern.FindAllStringSubmatch(test.input, -1), c.ShouldEqual, test.output)
+		}
+
+		c.So(Pattern{}.Literals(nil).FindAllString("anything", -1), c.ShouldEqual, []string(nil))
+
+		for _, flags := range [][]string{{}, {"i"}, {"f"}} {
+			// the words are copied and changes made after do not matter
+			words := []string{"cat"}
+			p := Pattern{}.Literals(words, flags...)
+			words[0] = "dog"
+			c.SoMsg(fmt.Sprintf("flags %q", flags), p.FindAllString("cat dog", -1), c.ShouldEqual, []string{"cat"})
+		}
+
+		c.So(
+			Pattern{}.Literals([]string{"strasse"}, "f").FindAllString("Straße", -1),
+			c.ShouldEqual,
+			Pattern{}.Text("strasse", "f").FindAllString("Straße", -1),
+		)
+	})
+
+	c.Convey("ReadLiterals", t, func() {
+		m, err := ReadLiterals(strings.NewReader("  alpha  \n\nbeta\r\ngamma delta\n"), "c")
+		c.So(err, c.ShouldBeNil)
+		c.So(Pattern{m}.FindAllString("alpha, beta and gamma delta", -1), c.ShouldEqual, []string{"alpha", "beta", "gamma delta"})
+
+		m, err = ReadLiterals(iotest.ErrReader(errors.New("read error")))
+		c.So(m, c.ShouldBeNil)
+		c.So(err, c.ShouldNotBeNil)
+	})
+}
@@ -20,8 +20,12 @@ import (
 )
 
 // Text creates a Matcher for the plain text given
+//
+// With the AnyCase (i) flag, Text compares runes using Unicode simple case
+// folding and with the FullFold (f) flag, Text uses full case folding where
+// one rune can match a sequence of runes, for example: ß matches ss
 func Text(text string, flags ...string) Matcher {
-	content := []rune(text)
+	content := newFoldedText([]rune(text))
 
 	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
 		scoped = scope
@@ -33,35 +37,12 @@ func Text(text string, flags ...string) Matcher {
 				// out-of-bounds is a negative negated making it positive with
 				// zero consumed
 				return
-			} else if proceed = len(content) > input.len-index; proceed {
-				// content matching is impossible due to insufficient input, and
-				// negated consumes this index
-				_, consumed, _ = input.Get(index)
-				return
 			}
 
 			// track this index size to increment []byte and string readers correctly
 			_, size, _ := input.Get(index)
 
-			var matched bool
-			for idx := 0; idx < len(content); idx++ {
-				forward := index + idx
-
-				r, _, _ := input.Get(forward)
-
-				if scoped.AnyCase() {
-					matched = unicode.ToLower(content[idx]) == unicode.ToLower(r)
-				} else {
-					matched = content[idx] == r
-				}
-
-				if !matched {
-					break
-				}
-
-			}
-
-			if !matched {
+			if _, matched := content.match(scoped, input, index); !matched {
 				// not matched is true proceed, consuming the size of this index
 				proceed = true
 				consumed = size
@@ -70,46 +51,35 @@ func Text(text string, flags ...string) Matcher {
 			return
 		}
 
-		if 0 > index || index >= input.len || len(content) > input.len-index {
-			// negative index, or index oob, or insufficient input
+		if 0 > index || index >= input.len {
+			// negative index, or index oob
 			return
 		}
 
-		// using the for-loop approach and unicode.ToLower reduces the actual
-		// number of times unicode.ToLower is called compared to
-		// strings.ToLower which has to scan the entire string each time
-		// see the commented benchmarks in rxp_x_test.go
-
-		var size int
-		for idx := 0; idx < len(content); idx++ {
-			forward := index + idx // forward position
-
-			r, rs, _ := input.Get(forward)
-
-			if scoped.AnyCase() {
-				proceed = unicode.ToLower(content[idx]) == unicode.ToLower(r)
-			} else {
-				proceed = content[idx] == r
-			}
-
-			if !proceed {
-				// definitely not a match, early out
-				return
-			}
-
-			size += rs
-		}
-
-		consumed = size
+		consumed, proceed = content.match(scoped, input, index)
 		return
 	}, flags...)
 }
 
 // Dot creates a Matcher equivalent to the regexp dot (.)
+//
+// With the Grapheme (g) flag, Dot matches one extended grapheme cluster, so
+// repetitions such as Dot("{3}", "g") count user-perceived characters instead
+// of runes
 func Dot(flags ...string) Matcher {
 	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
 		scoped = scope
 		if r, rs, ok := input.Get(index); ok {
+			if scoped&GraphemeFlag == GraphemeFlag {
+				// the whole extended grapheme cluster, where CRLF is a newline
+				if !graphemeBoundary(input, index) {
+					return
+				} else if size := graphemeClusterSize(input, index); size > rs && r == '\r' {
+					r, rs = '\n', size
+				} else {
+					rs = size
+				}
+			}
 			proceed = r != '\n' || scoped&DotNewlineFlag == DotNewlineFlag
 			if proceed {
 				consumed = rs
@@ -123,88 +93,179 @@ func Dot(flags ...string) Matcher {
 }
 
 // D creates a Matcher equivalent to the regexp \d
+//
+// With the Unicode (u) flag, D matches any Unicode decimal digit (\p{Nd})
 func D(flags ...string) Matcher {
-	return WrapMatcher(RuneIsDIGIT, flags...)
+	return wrapMatchers(compileAsciiMatcher(RuneIsDIGIT), RuneIsUnicodeDigit, flags...)
 }
 
 // S creates a Matcher equivalent to the regexp \s
+//
+// With the Unicode (u) flag, S matches any Unicode white space character
+// (\p{White_Space}), including NBSP and the ideographic space
 func S(flags ...string) Matcher {
-	return WrapMatcher(RuneIsSpace, flags...)
+	return wrapMatchers(compileAsciiMatcher(RuneIsSpace), RuneIsUnicodeSpace, flags...)
 }
 
 // W creates a Matcher equivalent to the regexp \w
+//
+// With the Unicode (u) flag, W matches any Unicode letter, mark, number or
+// connector punctuation
 func W(flags ...string) Matcher {
-	return WrapMatcher(RuneIsWord, flags...)
+	return wrapMatchers(compileAsciiMatcher(RuneIsWord), RuneIsUnicodeWord, flags...)
+}
+
+// H creates a Matcher equivalent to the regexp \h
+func H(flags ...string) Matcher {
+	return WrapMatcher(RuneIsHorizontalSpace, flags...)
+}
+
+// V creates a Matcher equivalent to the regexp \v
+func V(flags ...string) Matcher {
+	return WrapMatcher(RuneIsVerticalSpace, flags...)
+}
+
+// N creates a Matcher equivalent to the regexp \N, which is any character
+// other than a newline (\n), regardless of the DotNL (s) flag
+func N(flags ...string) Matcher {
+	return WrapMatcher(func(r rune) bool {
+		return r != '\n'
+	}, flags...)
+}
+
+// LineBreak creates a Matcher equivalent to the regexp \R, which is any one
+// of the RuneIsVerticalSpace characters or the \r\n sequence as one unit
+//
+// LineBreak is not named R because R is the character class Matcher
+func LineBreak(flags ...string) Matcher {
+	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
+		scoped = scope
+		if r, size, ok := input.Get(index); ok {
+			if proceed = RuneIsVerticalSpace(r); proceed {
+				consumed = size
+				if r == '\r' {
+					if next, ns, present := input.Get(index + size); present && next == '\n' {
+						consumed += ns
+					}
+				}
+			}
+			if scoped.Negated() {
+				if proceed = !proceed; proceed {
+					consumed = size
+				} else {
+					consumed = 0
+				}
+			}
+		}
+		return
+	}, flags...)
+}
+
+// X creates a Matcher equivalent to the regexp \X, which is one extended
+// grapheme cluster as defined by Unicode Standard Annex #29, for example: a
+// letter and its combining marks, a Hangul syllable, a pair of regional
+// indicators (flag emoji) or an emoji zero-width-joiner sequence
+func X(flags ...string) Matcher {
+	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
+		scoped = scope
+
+		if scoped&NegatedFlag == NegatedFlag {
+			// the meaning of "proceed" is inverted in a negation context
+
+			if proceed = 0 > index || index >= input.len; proceed {
+				// out-of-bounds is a negative negated making it positive with
+				// zero consumed
+				return
+			}
+
+			// track this index size to increment []byte and string readers correctly
+			_, size, _ := input.Get(index)
+
+			if graphemeClusterSize(input, index) == 0 {
+				// not matched is true proceed, consuming the size of this index
+				proceed = true
+				consumed = size
+			}
+
+			return
+		}
+
+		if size := graphemeClusterSize(input, index); size > 0 {
+			proceed = true
+			consumed = size
+		}
+		return
+	}, flags...)
 }
 
 // Alnum creates a Matcher equivalent to [:alnum:]
 func Alnum(flags ...string) Matcher {
-	return WrapMatcher(RuneIsALNUM, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsALNUM), flags...)
 }
 
 // Alpha creates a Matcher equivalent to [:alpha:]
 func Alpha(flags ...string) Matcher {
-	return WrapMatcher(RuneIsALPHA, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsALPHA), flags...)
 }
 
 // Ascii creates a Matcher equivalent to [:ascii:]
 func Ascii(flags ...string) Matcher {
-	return WrapMatcher(RuneIsASCII, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsASCII), flags...)
 }
 
 // Blank creates a Matcher equivalent to [:blank:]
 func Blank(flags ...string) Matcher {
-	return WrapMatcher(RuneIsBLANK, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsBLANK), flags...)
 }
 
 // Cntrl creates a Matcher equivalent to [:cntrl:]
 func Cntrl(flags ...string) Matcher {
-	return WrapMatcher(RuneIsCNTRL, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsCNTRL), flags...)
 }
 
 // Digit creates a Matcher equivalent to [:digit:]
 func Digit(flags ...string) Matcher {
-	return WrapMatcher(RuneIsDIGIT, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsDIGIT), flags...)
 }
 
 // Graph creates a Matcher equivalent to [:graph:]
 func Graph(flags ...string) Matcher {
-	return WrapMatcher(RuneIsGRAPH, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsGRAPH), flags...)
 }
 
 // Lower creates a Matcher equivalent to [:lower:]
 func Lower(flags ...string) Matcher {
-	return WrapMatcher(RuneIsLOWER, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsLOWER), flags...)
 }
 
 // Print creates a Matcher equivalent to [:print:]
 func Print(flags ...string) Matcher {
-	return WrapMatcher(RuneIsPRINT, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsPRINT), flags...)
 }
 
 // Punct creates a Matcher equivalent to [:punct:]
 func Punct(flags ...string) Matcher {
-	return WrapMatcher(RuneIsPUNCT, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsPUNCT), flags...)
 }
 
 // Space creates a Matcher equivalent to [:space:]
 func Space(flags ...string) Matcher {
-	return WrapMatcher(RuneIsSPACE, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsSPACE), flags...)
 }
 
 // Upper creates a Matcher equivalent to [:upper:]
 func Upper(flags ...string) Matcher {
-	return WrapMatcher(RuneIsUPPER, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsUPPER), flags...)
 }
 
 // Word creates a Matcher equivalent to [:word:]
 func Word(flags ...string) Matcher {
-	return WrapMatcher(RuneIsWord, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsWord), flags...)
 }
 
 // Xdigit creates a Matcher equivalent to [:xdigit:]
 func Xdigit(flags ...string) Matcher {
-	return WrapMatcher(RuneIsXDIGIT, flags...)
+	return WrapMatcher(compileAsciiMatcher(RuneIsXDIGIT), flags...)
 }
 
 // NamedClass creates a Matcher equivalent to the regexp [:AsciiNames:],
@@ -214,7 +275,7 @@ func Xdigit(flags ...string) Matcher {
 // NamedClass will panic if given an invalid class name
 func NamedClass(name AsciiNames, flags ...string) Matcher {
 	if matcher, ok := LookupAsciiClass[name]; ok {
-		return WrapMatcher(matcher, flags...)
+		return WrapMatcher(compileAsciiMatcher(matcher), flags...)
 	}
 	panic(fmt.Errorf("invalid ASCII name: %q, valid names are: %q", name, mapKeys(LookupAsciiClass)))
 }
@@ -228,18 +289,8 @@ func NamedClass(name AsciiNames, flags ...string) Matcher {
 //	IsUnicodeRange(unicode.Braille)
 func IsUnicodeRange(table *unicode.RangeTable, flags ...string) Matcher {
 	_ = unicode.Is(table, 'a') // compile-time test for panic cases
-	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
-		scoped = scope
-		if r, rs, ok := input.Get(index); ok {
-			if proceed = unicode.Is(table, r); scoped&NegatedFlag == NegatedFlag {
-				proceed = !proceed
-			}
-			if proceed {
-				consumed += rs
-			}
-		}
-
-		return
+	return WrapMatcher(func(r rune) bool {
+		return unicode.Is(table, r)
 	}, flags...)
 }
 
@@ -247,63 +298,34 @@ func IsUnicodeRange(table *unicode.RangeTable, flags ...string) Matcher {
 // [xyza-f] where x, y and z are individual runes to accept and a-f is the
 // inclusive range of letters from lowercase a to lowercase f to accept
 //
+// The characters spec supports the following syntax:
+//
+//	| Syntax          | Description                                                   |
+//	|-----------------|---------------------------------------------------------------|
+//	| x               | the literal rune x                                            |
+//	| a-f             | the inclusive range of runes from a to f                      |
+//	| ^...            | a leading caret negates the whole class                       |
+//	| -...            | a leading (or trailing) dash is a literal dash                |
+//	| [:alpha:]       | the POSIX class of the name given, see LookupAsciiClass       |
+//	| \d \s \w        | the ASCII Perl classes, see D, S and W                        |
+//	| \D \S \W        | the negated ASCII Perl classes                                |
+//	| \pL \p{Greek}   | the Unicode property given, see LookupUnicodeProperty         |
+//	| \PL \P{Greek}   | the negated Unicode property given                            |
+//	| \n \t \r \f \v  | newline, tab, carriage return, form feed and vertical tab     |
+//	| \a \e \0        | bell, escape and null                                         |
+//	| \xHH \uHHHH     | the rune with the two or four hexadecimal digits given        |
+//	| \x{1F600}       | the rune with the hexadecimal digits given                    |
+//	| \- \] \\ \^ ... | any other escaped punctuation is the literal rune             |
+//
 // Note: do not include the [] brackets unless the intent is to actually accept
 // those characters
+//
+// R will panic if the characters spec is malformed, see ParseR for the error
+// returning version
 func R(characters string, flags ...string) Matcher {
-	var runes []rune
-	var ranges [][]rune
-	chars := []rune(characters)
-	charsLen := len(chars)
-
-	// this is all happening at compile time so no need to use a pushRune or
-	// other append() optimizations
-
-	// parse the character class rune pattern [xyza-f]
-	for idx := 0; idx < charsLen; idx++ {
-		this := chars[idx]
-		if idx == 0 && this == '-' {
-			// first dash is literal dash
-			runes = append(runes, this)
-			continue
-		}
-		if idx+2 < charsLen {
-			// range requires a total of three, the low and high runes and
-			// a dash separator
-			if chars[idx+1] == '-' {
-				// next is a dash
-				if chars[idx] > chars[idx+2] {
-					// the low rune is greater than the high rune
-					// allow these mistakes? hmm...
-					ranges = append(ranges, []rune{chars[idx+2], chars[idx]})
-				} else {
-					ranges = append(ranges, []rune{chars[idx], chars[idx+2]})
-				}
-				idx += 2 // one for the dash and one for the other rune
-				continue
-			}
-		}
-		runes = append(runes, this)
+	m, err := ParseR(characters, flags...)
+	if err != nil {
+		panic(err)
 	}
-
-	hasRunes, hasRanges := len(runes) > 0, len(ranges) > 0
-	return WrapMatcher(func(r rune) bool {
-
-		if hasRanges {
-			for _, check := range ranges {
-				if check[0] <= r && r <= check[1] {
-					return true
-				}
-			}
-		}
-
-		if hasRunes {
-			for _, check := range runes {
-				if check == r {
-					return true
-				}
-			}
-		}
-
-		return false
-	}, flags...)
+	return m
 }
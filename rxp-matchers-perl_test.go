@@ -115,6 +115,498 @@ func TestMatchersClassPerl(t *testing.T) {
 
 	})
 
+	c.Convey("AnyCase", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "xéaé",
+				pattern: Pattern{}.Text("éa", "c"),
+				output:  [][]string{{"éa", "éa"}},
+			},
+
+			{
+				input:   "5 \u212a, 5 k, 5 K",
+				pattern: Pattern{}.Text("k", "i", "c"),
+				output:  [][]string{{"\u212a", "\u212a"}, {"k", "k"}, {"K", "K"}},
+			},
+
+			{
+				input:   "ſtop STOP",
+				pattern: Pattern{}.Text("stop", "i", "c"),
+				output:  [][]string{{"ſtop", "ſtop"}, {"STOP", "STOP"}},
+			},
+
+			{
+				input:   "ΟΔΟΣ οδος οδοσ",
+				pattern: Pattern{}.Text("οδος", "i", "c"),
+				output:  [][]string{{"ΟΔΟΣ", "ΟΔΟΣ"}, {"οδος", "οδος"}, {"οδοσ", "οδοσ"}},
+			},
+
+			{
+				input:   "ǅ ǆ Ǆ dž",
+				pattern: Pattern{}.Text("ǆ", "i", "c"),
+				output:  [][]string{{"ǅ", "ǅ"}, {"ǆ", "ǆ"}, {"Ǆ", "Ǆ"}},
+			},
+
+			{
+				input:   "Straße STRASSE strasse",
+				pattern: Pattern{}.Text("straße", "i", "c"),
+				output:  [][]string{{"Straße", "Straße"}},
+			},
+
+			{
+				input:   "Straße STRASSE strasse",
+				pattern: Pattern{}.Text("straße", "f", "c"),
+				output:  [][]string{{"Straße", "Straße"}, {"STRASSE", "STRASSE"}, {"strasse", "strasse"}},
+			},
+
+			{
+				input:   "Straße STRASSE",
+				pattern: Pattern{}.Text("strasse", "f", "c"),
+				output:  [][]string{{"Straße", "Straße"}, {"STRASSE", "STRASSE"}},
+			},
+
+			{
+				input:   "ß",
+				pattern: Pattern{}.Text("s", "f", "c"),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "ABC xyz \u212a",
+				pattern: Pattern{}.R("a-k", "+", "i", "c"),
+				output:  [][]string{{"ABC", "ABC"}, {"\u212a", "\u212a"}},
+			},
+
+			{
+				input:   "abc DEF",
+				pattern: Pattern{}.P("Lu", "+", "i", "c"),
+				output:  [][]string{{"abc", "abc"}, {"DEF", "DEF"}},
+			},
+
+			{
+				input:   "abc DEF",
+				pattern: Pattern{}.Lower("+", "i", "c"),
+				output:  [][]string{{"abc", "abc"}, {"DEF", "DEF"}},
+			},
+
+			{
+				input:   "Ǆemal ǅemal",
+				pattern: Pattern{}.Text("ǆ", "ic").Text("emal").BackRef(1, "i"),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "Ǆemal ǆemal",
+				pattern: Pattern{}.Text("ǆ", "ic").Text("emal ").BackRef(1, "i"),
+				output:  [][]string{{"Ǆemal ǆ", "Ǆ"}},
+			},
+
+			{
+				input:   "ǅǄ",
+				pattern: Pattern{}.Text("ǆ", "ic").BackRef(1, "i"),
+				output:  [][]string{{"ǅǄ", "ǅ"}},
+			},
+
+			{
+				input:   "\u212a-k",
+				pattern: Pattern{}.W("u", "c").Text("-").BackRef(1, "i"),
+				output:  [][]string{{"\u212a-k", "\u212a"}},
+			},
+
+			{
+				input:   "\u212a-kx",
+				pattern: Pattern{}.W("u", "c").Text("-").BackRef(1, "i"),
+				output:  [][]string{{"\u212a-k", "\u212a"}},
+			},
+
+			{
+				input:   "k-\u212a",
+				pattern: Pattern{}.W("u", "c").Text("-").BackRef(1, "i"),
+				output:  [][]string{{"k-\u212a", "k"}},
+			},
+
+			{
+				input:   "\u212a-",
+				pattern: Pattern{}.W("u", "c").Text("-").BackRef(1, "i"),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "ǅ-ǆ ß-SS",
+				pattern: Pattern{}.Dot("c").Text("-").BackRef(1, "f"),
+				output:  [][]string{{"ǅ-ǆ", "ǅ"}, {"ß-SS", "ß"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
+	c.Convey("Normalize", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "caf\u00e9 cafe\u0301 cafe",
+				pattern: Pattern{}.Text("caf\u00e9", "n", "c"),
+				output:  [][]string{{"caf\u00e9", "caf\u00e9"}, {"cafe\u0301", "cafe\u0301"}},
+			},
+
+			{
+				input:   "caf\u00e9 cafe\u0301 cafe",
+				pattern: Pattern{}.Text("cafe\u0301", "n", "c"),
+				output:  [][]string{{"caf\u00e9", "caf\u00e9"}, {"cafe\u0301", "cafe\u0301"}},
+			},
+
+			{
+				input:   "cafe\u0301",
+				pattern: Pattern{}.Text("cafe", "n", "c"),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "\u1ec7 e\u0323\u0302 e\u0302\u0323 \u00ea\u0323",
+				pattern: Pattern{}.Text("\u1ec7", "n", "c"),
+				output:  [][]string{{"\u1ec7", "\u1ec7"}, {"e\u0323\u0302", "e\u0323\u0302"}, {"e\u0302\u0323", "e\u0302\u0323"}, {"\u00ea\u0323", "\u00ea\u0323"}},
+			},
+
+			{
+				input:   "\uac00 \u1100\u1161",
+				pattern: Pattern{}.Text("\uac00", "n", "c"),
+				output:  [][]string{{"\uac00", "\uac00"}, {"\u1100\u1161", "\u1100\u1161"}},
+			},
+
+			{
+				input:   "CAFE\u0301 caf\u00e9",
+				pattern: Pattern{}.Text("caf\u00e9", "in", "c"),
+				output:  [][]string{{"CAFE\u0301", "CAFE\u0301"}, {"caf\u00e9", "caf\u00e9"}},
+			},
+
+			{
+				input:   "e\u0301 \u00e9 e",
+				pattern: Pattern{}.R("\u00e9", "n", "c"),
+				output:  [][]string{{"e\u0301", "e\u0301"}, {"\u00e9", "\u00e9"}},
+			},
+
+			{
+				input:   "\u00e9=e\u0301 e\u0301=\u00e9 \u00e9=e",
+				pattern: Pattern{}.R("a-z\u00e9", "n", "c").Text("=").BackRef(1, "n"),
+				output:  [][]string{{"\u00e9=e\u0301", "\u00e9"}, {"e\u0301=\u00e9", "e\u0301"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
+	c.Convey("Accents", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "r\u00e9sum\u00e9 R\u00c9SUM\u00c9 resum\u00e9 resume",
+				pattern: Pattern{}.Text("resume", "a", "c"),
+				output:  [][]string{{"r\u00e9sum\u00e9", "r\u00e9sum\u00e9"}, {"resum\u00e9", "resum\u00e9"}, {"resume", "resume"}},
+			},
+
+			{
+				input:   "r\u00e9sum\u00e9 R\u00c9SUM\u00c9 resum\u00e9",
+				pattern: Pattern{}.Text("resume", "ai", "c"),
+				output:  [][]string{{"r\u00e9sum\u00e9", "r\u00e9sum\u00e9"}, {"R\u00c9SUM\u00c9", "R\u00c9SUM\u00c9"}, {"resum\u00e9", "resum\u00e9"}},
+			},
+
+			{
+				input:   "resume\u0301 re\u0301sume",
+				pattern: Pattern{}.Text("r\u00e9sum\u00e9", "a", "c"),
+				output:  [][]string{{"resume\u0301", "resume\u0301"}, {"re\u0301sume", "re\u0301sume"}},
+			},
+
+			{
+				input:   "\u0141\u00f3d\u017a Lodz",
+				pattern: Pattern{}.Text("lodz", "ai", "c"),
+				output:  [][]string{{"\u0141\u00f3d\u017a", "\u0141\u00f3d\u017a"}, {"Lodz", "Lodz"}},
+			},
+
+			{
+				input:   "na\u00efve ne\u0308e",
+				pattern: Pattern{}.R("a-z", "+", "a", "c"),
+				output:  [][]string{{"na\u00efve", "na\u00efve"}, {"ne\u0308e", "ne\u0308e"}},
+			},
+
+			{
+				input:   "\u00e9\u00e8\u00ea",
+				pattern: Pattern{}.W("+", "a", "c"),
+				output:  [][]string{{"\u00e9\u00e8\u00ea", "\u00e9\u00e8\u00ea"}},
+			},
+
+			{
+				input:   "\u00e9-e \u00e9-\u00ea",
+				pattern: Pattern{}.R("a-z\u00e9", "c").Text("-").BackRef(1, "a"),
+				output:  [][]string{{"\u00e9-e", "\u00e9"}, {"\u00e9-\u00ea", "\u00e9"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
+	c.Convey("Width and Kana", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "\uff21\uff22\uff23\uff11\uff12\uff13 ABC123",
+				pattern: Pattern{}.Text("ABC123", "w", "c"),
+				output:  [][]string{{"\uff21\uff22\uff23\uff11\uff12\uff13", "\uff21\uff22\uff23\uff11\uff12\uff13"}, {"ABC123", "ABC123"}},
+			},
+
+			{
+				input:   "\uff21\uff22\uff23 abc",
+				pattern: Pattern{}.Text("\uff41\uff42\uff43", "wi", "c"),
+				output:  [][]string{{"\uff21\uff22\uff23", "\uff21\uff22\uff23"}, {"abc", "abc"}},
+			},
+
+			{
+				input:   "\uff76\uff9e\uff70\uff84\uff9e \u30ac\u30fc\u30c9",
+				pattern: Pattern{}.Text("\u30ac\u30fc\u30c9", "w", "c"),
+				output:  [][]string{{"\uff76\uff9e\uff70\uff84\uff9e", "\uff76\uff9e\uff70\uff84\uff9e"}, {"\u30ac\u30fc\u30c9", "\u30ac\u30fc\u30c9"}},
+			},
+
+			{
+				input:   "\u3072\u3089\u304c\u306a \u30d2\u30e9\u30ac\u30ca",
+				pattern: Pattern{}.Text("\u30d2\u30e9\u30ac\u30ca", "k", "c"),
+				output:  [][]string{{"\u3072\u3089\u304c\u306a", "\u3072\u3089\u304c\u306a"}, {"\u30d2\u30e9\u30ac\u30ca", "\u30d2\u30e9\u30ac\u30ca"}},
+			},
+
+			{
+				input:   "\uff76\uff9e\uff85 \u304c\u306a",
+				pattern: Pattern{}.Text("\u30ac\u30ca", "wk", "c"),
+				output:  [][]string{{"\uff76\uff9e\uff85", "\uff76\uff9e\uff85"}, {"\u304c\u306a", "\u304c\u306a"}},
+			},
+
+			{
+				input:   "\u304c\u306a",
+				pattern: Pattern{}.Text("\u30ac\u30ca", "w", "c"),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "\uff11\uff12\uff13 456",
+				pattern: Pattern{}.D("+", "w", "c"),
+				output:  [][]string{{"\uff11\uff12\uff13", "\uff11\uff12\uff13"}, {"456", "456"}},
+			},
+
+			{
+				input:   "\uff41\uff42\uff3f\uff43 d_e",
+				pattern: Pattern{}.W("+", "w", "c"),
+				output:  [][]string{{"\uff41\uff42\uff3f\uff43", "\uff41\uff42\uff3f\uff43"}, {"d_e", "d_e"}},
+			},
+
+			{
+				input:   "\uff41\uff42 xyz",
+				pattern: Pattern{}.R("a-c", "+", "w", "c"),
+				output:  [][]string{{"\uff41\uff42", "\uff41\uff42"}},
+			},
+
+			{
+				input:   "\uff76\uff9e\uff77 \u304b",
+				pattern: Pattern{}.R("\u30a1-\u30f6", "+", "wk", "c"),
+				output:  [][]string{{"\uff76\uff9e\uff77", "\uff76\uff9e\uff77"}, {"\u304b", "\u304b"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
+	c.Convey("Confusable", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "paypal p\u0430yp\u0430l pay",
+				pattern: Pattern{}.Text("paypal", "confusable", "c"),
+				output:  [][]string{{"paypal", "paypal"}, {"p\u0430yp\u0430l", "p\u0430yp\u0430l"}},
+			},
+
+			{
+				input:   "g\u03bf\u03bfgle g00gle",
+				pattern: Pattern{}.Text("google", "confusable", "c"),
+				output:  [][]string{{"g\u03bf\u03bfgle", "g\u03bf\u03bfgle"}},
+			},
+
+			{
+				input:   "\uff50\uff41\uff59\uff50\uff41\uff4c pay\u200dpal x\u200dpaypal",
+				pattern: Pattern{}.Text("paypal", "confusable", "c"),
+				output:  [][]string{{"\uff50\uff41\uff59\uff50\uff41\uff4c", "\uff50\uff41\uff59\uff50\uff41\uff4c"}, {"pay\u200dpal", "pay\u200dpal"}, {"paypal", "paypal"}},
+			},
+
+			{
+				input:   "\u0420\u0410\u0423\u0420\u0410L PAYPAI",
+				pattern: Pattern{}.Text("paypal", "confusable i", "c"),
+				output:  [][]string{{"\u0420\u0410\u0423\u0420\u0410L", "\u0420\u0410\u0423\u0420\u0410L"}},
+			},
+
+			{
+				input:   "paypa1 paypaI",
+				pattern: Pattern{}.Text("paypal", "confusable", "c"),
+				output:  [][]string{{"paypa1", "paypa1"}, {"paypaI", "paypaI"}},
+			},
+
+			{
+				input:   "\u0261o\u03bfgle",
+				pattern: Pattern{}.Text("google", "confusable", "c"),
+				output:  [][]string{{"\u0261o\u03bfgle", "\u0261o\u03bfgle"}},
+			},
+
+			{
+				input:   "rnodern modern",
+				pattern: Pattern{}.Text("modern", "confusable", "c"),
+				output:  [][]string{{"rnodern", "rnodern"}, {"modern", "modern"}},
+			},
+
+			{
+				input:   "\ufb01le file",
+				pattern: Pattern{}.Text("file", "confusable", "c"),
+				output:  [][]string{{"\ufb01le", "\ufb01le"}, {"file", "file"}},
+			},
+
+			{
+				input:   "b\u0323\u0304 b\u0304\u0323",
+				pattern: Pattern{}.Text("\u0183\u0323", "confusable", "c"),
+				output:  [][]string{{"b\u0323\u0304", "b\u0323\u0304"}, {"b\u0304\u0323", "b\u0304\u0323"}},
+			},
+
+			{
+				input:   "\u200d\u200d",
+				pattern: Pattern{}.Text("\u200d", "confusable", "c"),
+				output:  [][]string(nil),
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
+	c.Convey("Grapheme", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "\U0001f1ef\U0001f1f5\U0001f1fa\U0001f1f8\U0001f1eb\U0001f1f7!",
+				pattern: Pattern{}.Dot("{3}", "g", "c"),
+				output:  [][]string{{"\U0001f1ef\U0001f1f5\U0001f1fa\U0001f1f8\U0001f1eb\U0001f1f7", "\U0001f1ef\U0001f1f5\U0001f1fa\U0001f1f8\U0001f1eb\U0001f1f7"}},
+			},
+
+			{
+				input:   "\U0001f1ef\U0001f1f5\U0001f1fa\U0001f1f8",
+				pattern: Pattern{}.Dot("{3}", "c"),
+				output:  [][]string{{"\U0001f1ef\U0001f1f5\U0001f1fa", "\U0001f1ef\U0001f1f5\U0001f1fa"}},
+			},
+
+			{
+				input:   "\U0001f468\u200d\U0001f469\u200d\U0001f467 cafe\u0301",
+				pattern: Pattern{}.Dot("c", "g"),
+				output:  [][]string{{"\U0001f468\u200d\U0001f469\u200d\U0001f467", "\U0001f468\u200d\U0001f469\u200d\U0001f467"}, {" ", " "}, {"c", "c"}, {"a", "a"}, {"f", "f"}, {"e\u0301", "e\u0301"}},
+			},
+
+			{
+				input:   "cafe\u0301s",
+				pattern: Pattern{}.Caret().Dot("{1,4}", "g", "c").Dollar(),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "cafe\u0301",
+				pattern: Pattern{}.Caret().Dot("{1,4}", "g", "c").Dollar(),
+				output:  [][]string{{"cafe\u0301", "cafe\u0301"}},
+			},
+
+			{
+				input:   "a\r\nb",
+				pattern: Pattern{}.Dot("+", "g", "c"),
+				output:  [][]string{{"a", "a"}, {"b", "b"}},
+			},
+
+			{
+				input:   "a\r\nb",
+				pattern: Pattern{}.Dot("+", "gs", "c"),
+				output:  [][]string{{"a\r\nb", "a\r\nb"}},
+			},
+
+			{
+				input:   "nai\u0308ve!",
+				pattern: Pattern{}.W("+", "g", "c"),
+				output:  [][]string{{"nai\u0308ve", "nai\u0308ve"}},
+			},
+
+			{
+				input:   "e\u0301\u0301x",
+				pattern: Pattern{}.W("^", "g", "c"),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "\U0001f1ef\U0001f1f5\U0001f1fa\U0001f1f8",
+				pattern: Pattern{}.Dot("g", "c"),
+				output:  [][]string{{"\U0001f1ef\U0001f1f5", "\U0001f1ef\U0001f1f5"}, {"\U0001f1fa\U0001f1f8", "\U0001f1fa\U0001f1f8"}},
+			},
+
+			{
+				input:   "\U0001f44d\U0001f3fdx",
+				pattern: Pattern{}.W("^", "g", "c"),
+				output:  [][]string{{"\U0001f44d\U0001f3fd", "\U0001f44d\U0001f3fd"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
 	c.Convey("Dot", t, func() {
 
 		for idx, test := range []struct {
@@ -348,6 +840,160 @@ func TestMatchersClassPerl(t *testing.T) {
 		}
 	})
 
+	c.Convey("Unicode", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "naïve café",
+				pattern: Pattern{}.W("+", "c"),
+				output: [][]string{
+					{"na", "na"},
+					{"ve", "ve"},
+					{"caf", "caf"},
+				},
+			},
+
+			{
+				input:   "naïve café",
+				pattern: Pattern{}.W("+", "u", "c"),
+				output: [][]string{
+					{"naïve", "naïve"},
+					{"café", "café"},
+				},
+			},
+
+			{
+				input:   "a\u00a0b\u3000c",
+				pattern: Pattern{}.S("+", "c"),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "a\u00a0b\u3000c",
+				pattern: Pattern{}.S("+", "uc"),
+				output:  [][]string{{"\u00a0", "\u00a0"}, {"\u3000", "\u3000"}},
+			},
+
+			{
+				input:   "12٣٤",
+				pattern: Pattern{}.D("+", "uc"),
+				output:  [][]string{{"12٣٤", "12٣٤"}},
+			},
+
+			{
+				input:   "12٣٤",
+				pattern: Pattern{}.D("+", "c"),
+				output:  [][]string{{"12", "12"}},
+			},
+
+			{
+				input:   "naïve",
+				pattern: Pattern{}.W("^", "+", "uc"),
+				output:  [][]string(nil),
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+
+		c.So(RuneIsUnicodeWord('_'), c.ShouldBeTrue)
+		c.So(RuneIsUnicodeWord('\u203f'), c.ShouldBeTrue) // connector punctuation
+		c.So(RuneIsUnicodeWord('\u0301'), c.ShouldBeTrue) // combining mark
+		c.So(RuneIsUnicodeWord('-'), c.ShouldBeFalse)
+		c.So(RuneIsUnicodeSpace('\v'), c.ShouldBeTrue)
+		c.So(RuneIsUnicodeSpace('x'), c.ShouldBeFalse)
+		c.So(RuneIsUnicodeDigit('٣'), c.ShouldBeTrue)
+		c.So(RuneIsUnicodeDigit('Ⅳ'), c.ShouldBeFalse)
+	})
+
+	c.Convey("H, V, N, LineBreak and X", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "a \t\u00a0b\nc",
+				pattern: Pattern{}.H("+", "c"),
+				output:  [][]string{{" \t\u00a0", " \t\u00a0"}},
+			},
+
+			{
+				input:   "a\r\n\u2028b \tc",
+				pattern: Pattern{}.V("+", "c"),
+				output:  [][]string{{"\r\n\u2028", "\r\n\u2028"}},
+			},
+
+			{
+				input:   "ab\ncd",
+				pattern: Pattern{}.N("+", "c"),
+				output:  [][]string{{"ab", "ab"}, {"cd", "cd"}},
+			},
+
+			{
+				input:   "ab\ncd",
+				pattern: Pattern{}.N("+", "s", "c"),
+				output:  [][]string{{"ab", "ab"}, {"cd", "cd"}},
+			},
+
+			{
+				input:   "a\r\nb\n\rc",
+				pattern: Pattern{}.LineBreak("c"),
+				output:  [][]string{{"\r\n", "\r\n"}, {"\n", "\n"}, {"\r", "\r"}},
+			},
+
+			{
+				input:   "a\r\nb",
+				pattern: Pattern{}.LineBreak("^", "+", "c"),
+				output:  [][]string{{"a", "a"}, {"b", "b"}},
+			},
+
+			{
+				input:   "e\u0301\U0001F1EF\U0001F1F5\U0001F468\u200d\U0001F469\u200d\U0001F467",
+				pattern: Pattern{}.X("c"),
+				output: [][]string{
+					{"e\u0301", "e\u0301"},
+					{"\U0001F1EF\U0001F1F5", "\U0001F1EF\U0001F1F5"},
+					{"\U0001F468\u200d\U0001F469\u200d\U0001F467", "\U0001F468\u200d\U0001F469\u200d\U0001F467"},
+				},
+			},
+
+			{
+				input:   "\r\n한국어",
+				pattern: Pattern{}.X("{2}", "c"),
+				output:  [][]string{{"\r\n한", "\r\n한"}, {"국어", "국어"}},
+			},
+
+			{
+				input:   "ab",
+				pattern: Pattern{}.Text("a").X("^"),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "ab",
+				pattern: Pattern{}.Text("b").X("^"),
+				output:  [][]string{{"b"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
 	c.Convey("NamedClass", t, func() {
 
 		c.So(func() {
@@ -471,6 +1117,42 @@ func TestMatchersClassPerl(t *testing.T) {
 				pattern: Pattern{}.R("c-a", "c"),
 				output:  []string{"a", "b", "c"},
 			},
+
+			{
+				input:   "a-b\tc\U0001F600d",
+				pattern: Pattern{}.R(`a\-\t\x{1F600}`, "c"),
+				output:  []string{"a", "-", "\t", "\U0001F600"},
+			},
+
+			{
+				input:   "id_42, x-y",
+				pattern: Pattern{}.R(`^\w`, "+", "c"),
+				output:  []string{", ", "-"},
+			},
+
+			{ // negation is applied after case folding
+				input:   "aAb1",
+				pattern: Pattern{}.R("^a-z", "i"),
+				output:  []string{"1"},
+			},
+
+			{
+				input:   "aAb1",
+				pattern: Pattern{}.R("a-z", "^", "i"),
+				output:  []string{"1"},
+			},
+
+			{ // a negated spec with the negated flag is not negated
+				input:   "aAb1",
+				pattern: Pattern{}.R("^a-z", "^i"),
+				output:  []string{"a", "A", "b"},
+			},
+
+			{
+				input:   "id_42, x-y",
+				pattern: Pattern{}.R(`[:digit:][:lower:]`, "+", "c"),
+				output:  []string{"id", "42", "x", "y"},
+			},
 		} {
 
 			c.SoMsg(
@@ -0,0 +1,215 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// gUnicodeCategoryNames maps the long General_Category value aliases to the
+// short names used by the unicode.Categories map
+var gUnicodeCategoryNames = map[string]string{
+	"Letter":                "L",
+	"Cased_Letter":          "LC",
+	"Uppercase_Letter":      "Lu",
+	"Lowercase_Letter":      "Ll",
+	"Titlecase_Letter":      "Lt",
+	"Modifier_Letter":       "Lm",
+	"Other_Letter":          "Lo",
+	"Mark":                  "M",
+	"Nonspacing_Mark":       "Mn",
+	"Spacing_Mark":          "Mc",
+	"Enclosing_Mark":        "Me",
+	"Number":                "N",
+	"Decimal_Number":        "Nd",
+	"Letter_Number":         "Nl",
+	"Other_Number":          "No",
+	"Punctuation":           "P",
+	"Connector_Punctuation": "Pc",
+	"Dash_Punctuation":      "Pd",
+	"Open_Punctuation":      "Ps",
+	"Close_Punctuation":     "Pe",
+	"Initial_Punctuation":   "Pi",
+	"Final_Punctuation":     "Pf",
+	"Other_Punctuation":     "Po",
+	"Symbol":                "S",
+	"Math_Symbol":           "Sm",
+	"Currency_Symbol":       "Sc",
+	"Modifier_Symbol":       "Sk",
+	"Other_Symbol":          "So",
+	"Separator":             "Z",
+	"Space_Separator":       "Zs",
+	"Line_Separator":        "Zl",
+	"Paragraph_Separator":   "Zp",
+	"Other":                 "C",
+	"Control":               "Cc",
+	"Format":                "Cf",
+	"Surrogate":             "Cs",
+	"Private_Use":           "Co",
+}
+
+// cUnicodeProperty is one entry in the loose name lookup of LookupUnicodeProperty
+type cUnicodeProperty struct {
+	name  string
+	table *unicode.RangeTable
+}
+
+// gUnicodeProperties is the loose name lookup of all supported property names,
+// grouped by the namespace prefixes understood by LookupUnicodeProperty,
+// built on first use
+var gUnicodeProperties = sync.OnceValue(func() map[string]map[string]cUnicodeProperty {
+	lookup := map[string]map[string]cUnicodeProperty{
+		"gc": {},
+		"sc": {},
+		"":   {},
+	}
+	for name, table := range unicode.Categories {
+		lookup["gc"][unicodeLooseName(name)] = cUnicodeProperty{name, table}
+	}
+	for long, short := range gUnicodeCategoryNames {
+		if table, ok := unicode.Categories[short]; ok {
+			lookup["gc"][unicodeLooseName(long)] = cUnicodeProperty{long, table}
+		}
+	}
+	for name, table := range unicode.Scripts {
+		lookup["sc"][unicodeLooseName(name)] = cUnicodeProperty{name, table}
+	}
+	for name, table := range unicode.Properties {
+		lookup[""][unicodeLooseName(name)] = cUnicodeProperty{name, table}
+	}
+	return lookup
+})
+
+// unicodeLooseName returns the name with case, spaces, hyphens and
+// underscores removed, as described by the Unicode UAX44-LM3 loose matching
+// rule for property values
+func unicodeLooseName(name string) string {
+	var buf strings.Builder
+	for _, r := range name {
+		switch r {
+		case ' ', '-', '_', '\t':
+			continue
+		}
+		buf.WriteRune(unicode.ToLower(r))
+	}
+	return buf.String()
+}
+
+// LookupUnicodeProperty returns the unicode.RangeTable for the given Unicode
+// property name, which can be any of the following forms:
+//
+//	| Form              | Example                          |
+//	|-------------------|----------------------------------|
+//	| category          | L, Nd, Uppercase_Letter          |
+//	| script            | Greek, Han                       |
+//	| binary property   | White_Space, Dash                |
+//	| gc=category       | gc=Lu, General_Category=Lu       |
+//	| sc=script         | sc=Han, Script=Han               |
+//
+// Names are matched loosely, ignoring case, spaces, hyphens and underscores,
+// and unprefixed names are looked up as categories first, then scripts and
+// then binary properties
+//
+// LookupUnicodeProperty returns an error listing the closest known names when
+// the given name is not found
+func LookupUnicodeProperty(name string) (table *unicode.RangeTable, err error) {
+	lookup := gUnicodeProperties()
+
+	namespaces := []string{"gc", "sc", ""}
+	value := name
+	if before, after, found := strings.Cut(name, "="); found {
+		switch unicodeLooseName(before) {
+		case "gc", "generalcategory", "category":
+			namespaces = []string{"gc"}
+		case "sc", "script":
+			namespaces = []string{"sc"}
+		default:
+			return nil, fmt.Errorf("unknown Unicode property namespace: %q, valid namespaces are: %q", before, []string{"gc", "General_Category", "sc", "Script"})
+		}
+		value = after
+	}
+
+	loose := unicodeLooseName(value)
+	for _, namespace := range namespaces {
+		if property, ok := lookup[namespace][loose]; ok {
+			return property.table, nil
+		}
+	}
+
+	var suggestions []string
+	for _, namespace := range namespaces {
+		suggestions = append(suggestions, unicodePropertySuggestions(lookup[namespace], loose)...)
+	}
+	if len(suggestions) > 0 {
+		return nil, fmt.Errorf("unknown Unicode property: %q, did you mean one of: %q", name, suggestions)
+	}
+	return nil, fmt.Errorf("unknown Unicode property: %q", name)
+}
+
+// unicodePropertySuggestions returns up to five property names which are
+// within an edit distance of two from the loose name given, or which have the
+// loose name as a prefix
+func unicodePropertySuggestions(properties map[string]cUnicodeProperty, loose string) (suggestions []string) {
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	for key, property := range properties {
+		if distance := editDistance(loose, key); distance <= 2 {
+			candidates = append(candidates, candidate{property.name, distance})
+		} else if len(loose) > 1 && strings.HasPrefix(key, loose) {
+			candidates = append(candidates, candidate{property.name, 3})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].distance == candidates[j].distance {
+			return candidates[i].name < candidates[j].name
+		}
+		return candidates[i].distance < candidates[j].distance
+	})
+	for idx := 0; idx < len(candidates) && idx < 5; idx++ {
+		suggestions = append(suggestions, candidates[idx].name)
+	}
+	return
+}
+
+// P creates a Matcher equivalent to the regexp \p{Name} where the name given
+// is resolved with LookupUnicodeProperty, a leading caret (^) negates the
+// property, equivalent to the regexp \P{Name}
+//
+// For example:
+//
+//	P("Greek")      // any Greek script rune
+//	P("Nd")         // any decimal digit
+//	P("Script=Han") // any Han script rune
+//	P("^Lu")        // any rune which is not an uppercase letter
+//
+// P will panic if the name is not a known Unicode property
+func P(name string, flags ...string) Matcher {
+	if rest, negated := strings.CutPrefix(name, "^"); negated {
+		name = rest
+		flags = append(flags[:len(flags):len(flags)], "^")
+	}
+	table, err := LookupUnicodeProperty(name)
+	if err != nil {
+		panic(err)
+	}
+	return IsUnicodeRange(table, flags...)
+}
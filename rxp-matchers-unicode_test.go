@@ -0,0 +1,99 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+	"unicode"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMatchersUnicode(t *testing.T) {
+
+	c.Convey("LookupUnicodeProperty", t, func() {
+
+		for idx, test := range []struct {
+			name   string
+			output *unicode.RangeTable
+			err    string
+		}{
+			{"L", unicode.L, ""},
+			{"Nd", unicode.Nd, ""},
+			{"nd", unicode.Nd, ""},
+			{"Uppercase Letter", unicode.Lu, ""},
+			{"gc=Lu", unicode.Lu, ""},
+			{"General_Category=Lu", unicode.Lu, ""},
+			{"Greek", unicode.Greek, ""},
+			{"Script=Han", unicode.Han, ""},
+			{"sc=han", unicode.Han, ""},
+			{"White_Space", unicode.White_Space, ""},
+			{"whitespace", unicode.White_Space, ""},
+			{"Script=Lu", nil, `unknown Unicode property: "Script=Lu", did you mean one of: ["Lao" "Lisu" "Yi"]`},
+			{"Grek", nil, `unknown Unicode property: "Grek", did you mean one of: ["Greek"]`},
+			{"Nope=Lu", nil, `unknown Unicode property namespace: "Nope", valid namespaces are: ["gc" "General_Category" "sc" "Script"]`},
+			{"zzzzzzzzzz", nil, `unknown Unicode property: "zzzzzzzzzz"`},
+		} {
+			table, err := LookupUnicodeProperty(test.name)
+			c.SoMsg(fmt.Sprintf("test #%d - %q (table)", idx, test.name), table, c.ShouldEqual, test.output)
+			if test.err == "" {
+				c.SoMsg(fmt.Sprintf("test #%d - %q (error)", idx, test.name), err, c.ShouldBeNil)
+			} else {
+				c.SoMsg(fmt.Sprintf("test #%d - %q (error)", idx, test.name), err, c.ShouldNotBeNil)
+				c.SoMsg(fmt.Sprintf("test #%d - %q (error)", idx, test.name), err.Error(), c.ShouldEqual, test.err)
+			}
+		}
+
+	})
+
+	c.Convey("P", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+			{
+				input:   "abc αβγ 漢字",
+				pattern: Pattern{}.P("Greek", "+", "c"),
+				output:  [][]string{{"αβγ", "αβγ"}},
+			},
+			{
+				input:   "abc αβγ 漢字",
+				pattern: Pattern{}.P("Script=Han", "+", "c"),
+				output:  [][]string{{"漢字", "漢字"}},
+			},
+			{
+				input:   "aBcD",
+				pattern: Pattern{}.P("^Lu", "c"),
+				output:  [][]string{{"a", "a"}, {"c", "c"}},
+			},
+			{
+				input:   "a1٢b",
+				pattern: Pattern{}.P("Nd", "+", "c"),
+				output:  [][]string{{"1٢", "1٢"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+
+		c.So(func() { P("Nope") }, c.ShouldPanic)
+	})
+}
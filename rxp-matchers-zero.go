@@ -14,10 +14,6 @@
 
 package rxp
 
-import (
-	"unicode"
-)
-
 // Caret creates a Matcher equivalent to the regexp caret [^]
 func Caret(flags ...string) Matcher {
 	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
@@ -81,38 +77,46 @@ func A(flags ...string) Matcher {
 }
 
 // B creates a Matcher equivalent to the regexp [\b]
+//
+// With the Unicode (u) flag, B uses the same word definition as W does with
+// the Unicode flag
 func B(flags ...string) Matcher {
 	_, cfg := ParseFlags(flags...)
 	return func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
 		scoped = scope | cfg
 
-		this, _, _ := input.Get(index)
-		next, _, _ := input.Get(index + 1)
-		prev, _, _ := input.Get(index - 1)
+		isWord := RuneIsWord
+		if scoped.Unicode() {
+			isWord = RuneIsUnicodeWord
+		}
+
+		this, size, _ := input.Get(index)
+		next, _, _ := input.Get(index + max(size, 1))
+		prev, _, _ := input.Prev(index)
 
 		if index == 0 {
 
 			// at start of input, boundary is to the left if this is a word
-			proceed = RuneIsWord(this)
+			proceed = isWord(this)
 
 		} else if index >= input.Len() {
 
 			// at the end of input, boundary is to the right if this is a word
-			proceed = RuneIsWord(prev)
+			proceed = isWord(prev)
 
 		} else {
 
 			// somewhere in the middle of the string
 
-			if RuneIsWord(this) {
+			if isWord(this) {
 				// this is a word, boundary is to the left
-				proceed = !RuneIsWord(prev)
+				proceed = !isWord(prev)
 			} else if next > 0 {
 				// next is not null, boundary is to the right
-				proceed = RuneIsWord(next)
+				proceed = isWord(next)
 			} else if prev > 0 {
 				// prev is not null, boundary is to the left
-				proceed = RuneIsWord(prev)
+				proceed = isWord(prev)
 			}
 
 		}
@@ -129,6 +133,44 @@ func B(flags ...string) Matcher {
 	}
 }
 
+// WordBoundaryUAX creates a zero-width Matcher for the word boundaries of the
+// Unicode Standard Annex #29 text segmentation rules
+//
+// Unlike B, WordBoundaryUAX keeps contractions and numbers with separators as
+// one word (can't, 3.14 and 1,000), keeps runs of katakana together, breaks
+// between each ideograph and keeps emoji sequences whole. Scripts which need
+// a dictionary to find words, such as Thai, are broken between each letter
+//
+// See SplitWordsString for breaking text into words
+func WordBoundaryUAX(flags ...string) Matcher {
+	return segmentBoundary(wordBoundary, flags...)
+}
+
+// SentenceBoundary creates a zero-width Matcher for the sentence boundaries of
+// the Unicode Standard Annex #29 text segmentation rules
+//
+// See SplitSentencesString for breaking text into sentences
+func SentenceBoundary(flags ...string) Matcher {
+	return segmentBoundary(sentenceBoundary, flags...)
+}
+
+// segmentBoundary is the WordBoundaryUAX and SentenceBoundary implementation
+func segmentBoundary(boundary func(input *InputReader, index int) bool, flags ...string) Matcher {
+	_, cfg := ParseFlags(flags...)
+	return func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
+		scoped = scope | cfg
+		if proceed = 0 <= index && index <= input.len && boundary(input, index); scoped.Negated() {
+			proceed = !proceed
+		}
+
+		if proceed {
+			scoped |= MatchedFlag
+		}
+
+		return
+	}
+}
+
 // Z is a Matcher equivalent to the regexp [\z]
 func Z(flags ...string) Matcher {
 	_, cfg := ParseFlags(flags...)
@@ -149,6 +191,9 @@ func Z(flags ...string) Matcher {
 // BackRef is a Matcher equivalent to Perl backreferences where the gid
 // argument is the match group to use
 //
+// With the AnyCase (i) or FullFold (f) flags, BackRef compares the runes the
+// same way as the Text Matcher does
+//
 // BackRef will panic if the gid argument is less than one
 func BackRef(gid int, flags ...string) Matcher {
 	if gid < 1 {
@@ -164,38 +209,47 @@ func BackRef(gid int, flags ...string) Matcher {
 			return
 		}
 
-		//groupStart, groupEnd, _ := sm.Get(gid)
 		groupStart, groupEnd := sm[gid][0], sm[gid][1]
-		groupLen := groupEnd - groupStart
-		runes, _ := input.Slice(groupStart, groupLen)
 
-		if proceed = input.Len() >= index+groupLen; !proceed {
-			// forward is past EOF, OOB is not negated
-			proceed = scoped.Negated()
+		if mode := foldingMode(scoped); mode&^AnyCaseFlag != 0 && !scoped.Negated() {
+			// folding modes where one rune can match more than one rune
+			var key []rune
+			for idx, size := groupStart, 0; idx < groupEnd; idx += size {
+				var ok bool
+				if key, size, ok = foldUnit(key, mode, input, idx); !ok {
+					break
+				}
+			}
+			if consumed, proceed = matchFoldKey(key, mode, input, index); proceed {
+				scoped |= MatchedFlag
+			}
 			return
 		}
 
+		// the group and the input are compared rune by rune because case
+		// folding equivalents can differ in size, such as k and the Kelvin
+		// sign, so the size of the group is not the size needed
 		var size int
-		for idx := 0; idx < groupLen; idx++ {
-			forward := index + idx // forward position
-
-			r, rs, _ := input.Get(forward)
-
-			if scoped.AnyCase() {
-				proceed = unicode.ToLower(runes[idx]) == unicode.ToLower(r)
-			} else {
-				proceed = runes[idx] == r
+		proceed = true // an empty group always matches
+		for idx := groupStart; idx < groupEnd; {
+			gr, gs, _ := input.Get(idx)
+			r, rs, ok := input.Get(index + size)
+			if !ok {
+				// forward is past EOF, OOB is not negated
+				proceed = scoped.Negated()
+				return
 			}
 
-			if scoped.Negated() {
+			if proceed = foldEqual(gr, r, scoped); scoped.Negated() {
 				proceed = !proceed
 			}
 
-			if !proceed {
+			if !proceed || gs == 0 {
 				// early out
 				return
 			}
 
+			idx += gs
 			size += rs
 		}
 
@@ -198,6 +198,70 @@ func TestMatchersClassZero(t *testing.T) {
 				pattern: Pattern{}.B().Text("a", "c").B(),
 				output:  [][]string{{"a", "a"}},
 			},
+
+			{
+				input:   "über naïve",
+				pattern: Pattern{}.B().W("+", "c").B(),
+				output:  [][]string{{"ber", "ber"}, {"na", "na"}, {"ve", "ve"}},
+			},
+
+			{
+				input:   "über naïve",
+				pattern: Pattern{}.B("u").W("+", "uc").B("u"),
+				output:  [][]string{{"über", "über"}, {"naïve", "naïve"}},
+			},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringSubmatch(test.input, -1),
+				c.ShouldEqual,
+				test.output)
+		}
+	})
+
+	c.Convey("WordBoundaryUAX and SentenceBoundary", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]string
+		}{
+
+			{
+				input:   "can't stop",
+				pattern: Pattern{}.WordBoundaryUAX().W("+", "c").WordBoundaryUAX(),
+				output:  [][]string{{"stop", "stop"}},
+			},
+
+			{
+				input:   "1,000.50 1,0",
+				pattern: Pattern{}.WordBoundaryUAX().D("+", "c").WordBoundaryUAX(),
+				output:  [][]string(nil),
+			},
+
+			{
+				input:   "1,000.50 x",
+				pattern: Pattern{}.WordBoundaryUAX().Text("1,000.50", "c").WordBoundaryUAX(),
+				output:  [][]string{{"1,000.50", "1,000.50"}},
+			},
+
+			{
+				input:   "ab",
+				pattern: Pattern{}.WordBoundaryUAX("^").Dot("c"),
+				output:  [][]string{{"b", "b"}},
+			},
+
+			{
+				input:   "Hi there. Bye!",
+				pattern: Pattern{}.SentenceBoundary().W("+", "c"),
+				output:  [][]string{{"Hi", "Hi"}, {"Bye", "Bye"}},
+			},
+
+			{
+				input:   "Hi there. Bye!",
+				pattern: Pattern{}.Text("there.", "c").SentenceBoundary(),
+				output:  [][]string(nil),
+			},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
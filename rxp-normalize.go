@@ -0,0 +1,178 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"sort"
+	"unicode"
+)
+
+// cCombiningClass is a range of runes sharing the same non-zero
+// Canonical_Combining_Class property value
+type cCombiningClass struct {
+	lo, hi rune
+	class  uint8
+}
+
+const (
+	hangulSBase  = 0xac00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11a7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// combiningClass returns the Canonical_Combining_Class of r, zero denotes a
+// starter
+func combiningClass(r rune) uint8 {
+	if r < 0x0300 {
+		return 0
+	}
+	idx := sort.Search(len(gCombiningClass), func(i int) bool {
+		return gCombiningClass[i].hi >= r
+	})
+	if idx < len(gCombiningClass) && gCombiningClass[idx].lo <= r {
+		return gCombiningClass[idx].class
+	}
+	return 0
+}
+
+// appendDecomposed appends the full canonical decomposition of r to dst
+func appendDecomposed(dst []rune, r rune) []rune {
+	if r < 0xc0 {
+		return append(dst, r)
+	} else if s := r - hangulSBase; 0 <= s && s < hangulSCount {
+		dst = append(dst, hangulLBase+s/hangulNCount, hangulVBase+(s%hangulNCount)/hangulTCount)
+		if t := s % hangulTCount; t > 0 {
+			dst = append(dst, hangulTBase+t)
+		}
+		return dst
+	} else if decomposed, ok := gCanonicalDecomposition[r]; ok {
+		return append(dst, decomposed...)
+	}
+	return append(dst, r)
+}
+
+// canonicalOrder sorts each sequence of non-starters in the decomposed runes
+// given by their combining class, keeping runes with the same combining class
+// in the order given
+func canonicalOrder(runes []rune) {
+	for idx := 1; idx < len(runes); idx++ {
+		class := combiningClass(runes[idx])
+		if class == 0 {
+			continue
+		}
+		for jdx := idx; jdx > 0; jdx-- {
+			if prev := combiningClass(runes[jdx-1]); prev == 0 || prev <= class {
+				break
+			}
+			runes[jdx-1], runes[jdx] = runes[jdx], runes[jdx-1]
+		}
+	}
+}
+
+// canonicalCompose returns the canonical composition of the decomposed and
+// canonically ordered runes given, reusing the runes slice
+func canonicalCompose(runes []rune) []rune {
+	if len(runes) < 2 {
+		return runes
+	}
+	starter := 0
+	last := combiningClass(runes[0])
+	if last != 0 {
+		last = 0xff // not a starter, nothing composes with it
+	}
+	out := 1
+	for idx := 1; idx < len(runes); idx++ {
+		r := runes[idx]
+		class := combiningClass(r)
+		if composed, ok := composePair(runes[starter], r); ok && (last < class || (last == 0 && out-1 == starter)) {
+			runes[starter] = composed
+			continue
+		}
+		if class == 0 {
+			starter = out
+		}
+		last = class
+		runes[out] = r
+		out += 1
+	}
+	return runes[:out]
+}
+
+// composePair returns the primary composite of the two runes given
+func composePair(a, b rune) (composed rune, ok bool) {
+	if l := a - hangulLBase; 0 <= l && l < hangulLCount {
+		if v := b - hangulVBase; 0 <= v && v < hangulVCount {
+			return hangulSBase + (l*hangulVCount+v)*hangulTCount, true
+		}
+	} else if s := a - hangulSBase; 0 <= s && s < hangulSCount && s%hangulTCount == 0 {
+		if t := b - hangulTBase; 0 < t && t < hangulTCount {
+			return a + t, true
+		}
+	}
+	composed, ok = gCanonicalComposition[[2]rune{a, b}]
+	return
+}
+
+// normalizedUnit appends the canonically ordered decomposition of the input
+// unit at the given index to dst, a unit is the rune at the index along with
+// all the non-starters following it
+//
+// When the scope has AccentFlag set, the unit also includes any nonspacing
+// marks following the rune and the decomposition has the accents removed, see
+// stripAccents
+func normalizedUnit(dst []rune, scope Flags, input *InputReader, index int) (nfd []rune, size int, ok bool) {
+	var r rune
+	if r, size, ok = input.Get(index); !ok {
+		return dst, 0, false
+	}
+	accents := scope&AccentFlag == AccentFlag
+	start := len(dst)
+	nfd = appendDecomposed(dst, r)
+	for {
+		next, ns, present := input.Get(index + size)
+		if !present || (combiningClass(next) == 0 && !(accents && unicode.Is(unicode.Mn, next))) {
+			break
+		}
+		nfd = appendDecomposed(nfd, next)
+		size += ns
+	}
+	canonicalOrder(nfd[start:])
+	if accents {
+		nfd = append(nfd[:start], stripAccents(nfd[start:])...)
+	}
+	return nfd, size, true
+}
+
+// normalizedRune returns the canonical composition of the input unit at the
+// given index, if the unit composes into exactly one rune
+func normalizedRune(scope Flags, input *InputReader, index int) (r rune, size int, ok bool) {
+	var buf [16]rune
+	var nfd []rune
+	if nfd, size, ok = normalizedUnit(buf[:0], scope, input, index); ok {
+		if scope&AccentFlag == 0 {
+			nfd = canonicalCompose(nfd)
+		}
+		if len(nfd) == 1 {
+			return nfd[0], size, true
+		}
+	}
+	return 0, 0, false
+}
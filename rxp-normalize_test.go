@@ -0,0 +1,83 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestNormalize(t *testing.T) {
+	c.Convey("combiningClass", t, func() {
+		c.So(combiningClass('e'), c.ShouldEqual, 0)
+		c.So(combiningClass('\u0301'), c.ShouldEqual, 230)
+		c.So(combiningClass('\u0323'), c.ShouldEqual, 220)
+		c.So(combiningClass('\u05b0'), c.ShouldEqual, 10)
+		c.So(combiningClass('\U0001e94a'), c.ShouldEqual, 7)
+		c.So(combiningClass('\uac00'), c.ShouldEqual, 0)
+	})
+
+	c.Convey("appendDecomposed", t, func() {
+		for idx, test := range []struct {
+			input  rune
+			output string
+		}{
+			{'a', "a"},
+			{'\u00e9', "e\u0301"},
+			{'\u1ec7', "e\u0323\u0302"},
+			{'\u2126', "\u03a9"},
+			{'\u0344', "\u0308\u0301"},
+			{'\uac00', "\u1100\u1161"},
+			{'\ud4db', "\u1111\u1171\u11b6"},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), string(appendDecomposed(nil, test.input)), c.ShouldEqual, test.output)
+		}
+	})
+
+	c.Convey("canonicalOrder and canonicalCompose", t, func() {
+		for idx, test := range []struct {
+			input string
+			nfd   string
+			nfc   string
+		}{
+			{"e\u0301", "e\u0301", "\u00e9"},
+			{"e\u0302\u0323", "e\u0323\u0302", "\u1ec7"},
+			{"a\u0301\u0301", "a\u0301\u0301", "\u00e1\u0301"},
+			{"\u1100\u1161\u11a8", "\u1100\u1161\u11a8", "\uac01"},
+			{"\u0301e", "\u0301e", "\u0301e"},
+			{"\u03a9", "\u03a9", "\u03a9"},
+		} {
+			runes := []rune(test.input)
+			canonicalOrder(runes)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), string(runes), c.ShouldEqual, test.nfd)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), string(canonicalCompose(runes)), c.ShouldEqual, test.nfc)
+		}
+	})
+
+	c.Convey("normalizedRune", t, func() {
+		r, size, ok := normalizedRune(NormalizeFlag, NewInputReader("e\u0302\u0323x"), 0)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(r, c.ShouldEqual, '\u1ec7')
+		c.So(size, c.ShouldEqual, 5)
+		r, size, ok = normalizedRune(NormalizeFlag, NewInputReader([]rune("\u2126")), 0)
+		c.So(ok, c.ShouldBeTrue)
+		c.So(r, c.ShouldEqual, '\u03a9')
+		c.So(size, c.ShouldEqual, 1)
+		_, _, ok = normalizedRune(NormalizeFlag, NewInputReader("q\u0301"), 0)
+		c.So(ok, c.ShouldBeFalse)
+	})
+}
@@ -34,6 +34,10 @@ func (p Pattern) Group(options ...interface{}) Pattern {
 	return append(p, Group(options...))
 }
 
+func (p Pattern) Named(name string, options ...interface{}) Pattern {
+	return append(p, Named(name, options...))
+}
+
 func (p Pattern) Dot(flags ...string) Pattern {
 	return append(p, Dot(flags...))
 }
@@ -42,6 +46,14 @@ func (p Pattern) Text(text string, flags ...string) Pattern {
 	return append(p, Text(text, flags...))
 }
 
+func (p Pattern) Fuzzy(text string, budget int, flags ...string) Pattern {
+	return append(p, Fuzzy(text, budget, flags...))
+}
+
+func (p Pattern) Literals(words []string, flags ...string) Pattern {
+	return append(p, Literals(words, flags...))
+}
+
 func (p Pattern) Caret(flags ...string) Pattern {
 	return append(p, Caret(flags...))
 }
@@ -58,6 +70,14 @@ func (p Pattern) B(flags ...string) Pattern {
 	return append(p, B(flags...))
 }
 
+func (p Pattern) WordBoundaryUAX(flags ...string) Pattern {
+	return append(p, WordBoundaryUAX(flags...))
+}
+
+func (p Pattern) SentenceBoundary(flags ...string) Pattern {
+	return append(p, SentenceBoundary(flags...))
+}
+
 func (p Pattern) Z(flags ...string) Pattern {
 	return append(p, Z(flags...))
 }
@@ -74,6 +94,38 @@ func (p Pattern) W(flags ...string) Pattern {
 	return append(p, W(flags...))
 }
 
+func (p Pattern) H(flags ...string) Pattern {
+	return append(p, H(flags...))
+}
+
+func (p Pattern) V(flags ...string) Pattern {
+	return append(p, V(flags...))
+}
+
+func (p Pattern) N(flags ...string) Pattern {
+	return append(p, N(flags...))
+}
+
+func (p Pattern) LineBreak(flags ...string) Pattern {
+	return append(p, LineBreak(flags...))
+}
+
+func (p Pattern) X(flags ...string) Pattern {
+	return append(p, X(flags...))
+}
+
+func (p Pattern) Emoji(flags ...string) Pattern {
+	return append(p, Emoji(flags...))
+}
+
+func (p Pattern) EmojiPresentation(flags ...string) Pattern {
+	return append(p, EmojiPresentation(flags...))
+}
+
+func (p Pattern) RegionalFlag(flags ...string) Pattern {
+	return append(p, RegionalFlag(flags...))
+}
+
 func (p Pattern) Alnum(flags ...string) Pattern {
 	return append(p, Alnum(flags...))
 }
@@ -138,10 +190,18 @@ func (p Pattern) RangeTable(table *unicode.RangeTable, flags ...string) Pattern
 	return append(p, IsUnicodeRange(table, flags...))
 }
 
+func (p Pattern) P(name string, flags ...string) Pattern {
+	return append(p, P(name, flags...))
+}
+
 func (p Pattern) R(characters string, flags ...string) Pattern {
 	return append(p, R(characters, flags...))
 }
 
+func (p Pattern) Class(class RuneClass, flags ...string) Pattern {
+	return append(p, class.Matcher(flags...))
+}
+
 func (p Pattern) BackRef(idx int, flags ...string) Pattern {
 	return append(p, BackRef(idx, flags...))
 }
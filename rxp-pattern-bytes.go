@@ -16,16 +16,21 @@ package rxp
 
 func (p Pattern) findBytes(s *cPatternState, count int) (matched [][][]byte) {
 	if p.match(s, count) {
-		for _, match := range s.matches {
-			if len(match) > 0 {
-				var groups [][]byte
-				for _, submatch := range match {
-					groups = pushByte(groups, s.input.Bytes(submatch[0], submatch[1]-submatch[0]))
-					//groups = append(groups, s.input.Bytes(submatch[0], submatch[1]-submatch[0]))
-				}
-				matched = pushBytes(matched, groups)
-				//matched = append(matched, groups)
+		matched = s.bytesMatches()
+	}
+	return
+}
+
+func (s *cPatternState) bytesMatches() (matched [][][]byte) {
+	for _, match := range s.matches {
+		if len(match) > 0 {
+			var groups [][]byte
+			for _, submatch := range match {
+				groups = pushByte(groups, s.input.Bytes(submatch[0], submatch[1]-submatch[0]))
+				//groups = append(groups, s.input.Bytes(submatch[0], submatch[1]-submatch[0]))
 			}
+			matched = pushBytes(matched, groups)
+			//matched = append(matched, groups)
 		}
 	}
 	return
@@ -40,6 +45,32 @@ func (p Pattern) MatchBytes(input []byte) (ok bool) {
 	return
 }
 
+func (p Pattern) FullMatchBytes(input []byte) (ok bool) {
+	return matchAnchored(p, input, true, true)
+}
+
+func (p Pattern) HasPrefixMatchBytes(input []byte) (ok bool) {
+	return matchAnchored(p, input, true, false)
+}
+
+func (p Pattern) HasSuffixMatchBytes(input []byte) (ok bool) {
+	return matchAnchored(p, input, false, true)
+}
+
+func (p Pattern) CountBytes(input []byte, limit int) (count int) {
+	return countMatches(p, input, limit)
+}
+
+func (p Pattern) EachBytesMatch(input []byte, fn func(m Match) bool) {
+	eachMatch(p, input, fn)
+}
+
+func (p Pattern) BytesMatchSeq(input []byte) func(yield func(m Match) bool) {
+	return func(yield func(m Match) bool) {
+		eachMatch(p, input, yield)
+	}
+}
+
 func (p Pattern) FindBytes(input []byte) []byte {
 	if mm := p.FindBytesSubmatch(input); len(mm) > 0 {
 		return mm[0]
@@ -76,6 +107,17 @@ func (p Pattern) FindBytesSubmatchIndex(input []byte) (found [][2]int) {
 	return
 }
 
+func (p Pattern) FindBytesMatch(input []byte) (m Match) {
+	if mm := findMatches(p, input, 1); len(mm) > 0 {
+		m = mm[0]
+	}
+	return
+}
+
+func (p Pattern) FindAllBytesMatches(input []byte, count int) (found []Match) {
+	return findMatches(p, input, count)
+}
+
 func (p Pattern) FindAllBytes(input []byte, count int) (found [][]byte) {
 	if len(p) > 0 {
 		s := newPatternState(p, input)
@@ -121,6 +163,124 @@ func (p Pattern) FindAllBytesSubmatchIndex(input []byte, count int) (found [][][
 	return
 }
 
+func (p Pattern) FindAllBytesOverlapping(input []byte, count int) (found [][]byte) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			for _, m := range s.bytesMatches() {
+				found = append(found, m[0]) // always at least one present
+			}
+		}
+		s.matches = nil
+	}
+	return
+}
+
+func (p Pattern) FindAllBytesOverlappingIndex(input []byte, count int) (found [][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			for _, groups := range s.matches {
+				found = append(found, groups[0])
+			}
+		}
+		s.matches = nil
+	}
+	return
+}
+
+func (p Pattern) FindAllBytesOverlappingSubmatch(input []byte, count int) (found [][][]byte) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			found = s.bytesMatches()
+		}
+		s.matches = nil
+	}
+	return
+}
+
+func (p Pattern) FindAllBytesOverlappingSubmatchIndex(input []byte, count int) (found [][][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			found = s.matches
+		}
+		s.matches = nil
+	}
+	return
+}
+
+func (p Pattern) FindLastBytes(input []byte) []byte {
+	if mm := p.FindLastBytesSubmatch(input); len(mm) > 0 {
+		return mm[0]
+	}
+	return nil
+}
+
+func (p Pattern) FindLastBytesIndex(input []byte) (found [2]int) {
+	if mm := p.FindLastBytesSubmatchIndex(input); len(mm) > 0 {
+		return mm[0]
+	}
+	return
+}
+
+func (p Pattern) FindLastBytesSubmatch(input []byte) (found [][]byte) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchLast(s) {
+			found = s.bytesMatches()[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+func (p Pattern) FindLastBytesSubmatchIndex(input []byte) (found [][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchLast(s) {
+			found = s.matches[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+func (p Pattern) MatchBytesAt(input []byte, offset int) (ok bool) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index, s.anchor = offset, true
+		ok = p.match(s, 1)
+		s.matches = nil
+	}
+	return
+}
+
+func (p Pattern) FindBytesFrom(input []byte, offset int) (found []byte) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index = offset
+		if mm := p.findBytes(s, 1); len(mm) > 0 {
+			found = mm[0][0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+func (p Pattern) FindBytesSubmatchIndexFrom(input []byte, offset int) (found [][2]int) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index = offset
+		if p.match(s, 1) && len(s.matches) > 0 {
+			found = s.matches[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
 func (p Pattern) ReplaceAllBytes(input []byte, replacements Replace[[]byte]) []byte {
 	if len(p) > 0 {
 		s := newPatternState(p, input)
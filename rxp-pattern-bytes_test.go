@@ -114,6 +114,7 @@ func TestPattern_FindAllIndex(t *testing.T) {
 			{input: "", pattern: nil, count: -1, output: [][2]int{{0, 0}}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: 1, output: [][2]int{{0, 1}}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: -1, output: [][2]int{{0, 1}, {1, 2}}},
+			{input: "aaa", pattern: Pattern{}.Dot("{1}", "c"), count: 2, output: [][2]int{{0, 1}, {1, 2}}},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
@@ -167,6 +168,7 @@ func TestPattern_FindAll(t *testing.T) {
 			{input: "", pattern: nil, count: -1, output: [][]byte(nil)},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: 1, output: [][]byte{[]byte("a")}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: -1, output: [][]byte{[]byte("a"), []byte("a")}},
+			{input: "aaa", pattern: Pattern{}.Dot("{1}", "c"), count: 2, output: [][]byte{[]byte("a"), []byte("a")}},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
@@ -194,6 +196,7 @@ func TestPattern_FindAllSubmatch(t *testing.T) {
 			{input: "", pattern: nil, count: -1, output: [][][]byte(nil)},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: 1, output: [][][]byte{{{97}, {97}}}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: -1, output: [][][]byte{{{97}, {97}}, {{97}, {97}}}},
+			{input: "abab", pattern: Pattern{}.Text("ab", "c"), count: -1, output: [][][]byte{{[]byte("ab"), []byte("ab")}, {[]byte("ab"), []byte("ab")}}},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
@@ -237,6 +240,371 @@ func TestPattern_FindAllSubmatchIndex(t *testing.T) {
 
 }
 
+func TestPattern_FindAllBytesOverlapping(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []byte
+			pattern Pattern
+			count   int
+			output  [][]byte
+			index   [][2]int
+		}{
+
+			{input: []byte(""), pattern: nil, count: -1, output: [][]byte(nil), index: [][2]int(nil)},
+			{input: []byte("aaaa"), pattern: Pattern{}.Text("aa"), count: -1, output: [][]byte{[]byte("aa"), []byte("aa"), []byte("aa")}, index: [][2]int{{0, 2}, {1, 3}, {2, 4}}},
+			{input: []byte("aaaa"), pattern: Pattern{}.Text("aa"), count: 2, output: [][]byte{[]byte("aa"), []byte("aa")}, index: [][2]int{{0, 2}, {1, 3}}},
+			{input: []byte("aaaa"), pattern: Pattern{}.Text("b"), count: -1, output: [][]byte(nil), index: [][2]int(nil)},
+			{input: []byte("gattaca"), pattern: Pattern{}.Dot("{3}"), count: -1, output: [][]byte{[]byte("gat"), []byte("att"), []byte("tta"), []byte("tac"), []byte("aca")}, index: [][2]int{{0, 3}, {1, 4}, {2, 5}, {3, 6}, {4, 7}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllBytesOverlapping(test.input, test.count),
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllBytesOverlappingIndex(test.input, test.count),
+				c.ShouldEqual,
+				test.index,
+			)
+		}
+
+		input := []byte("abab")
+		pattern := Pattern{}.Dot("c").Dot("c").Dot("c")
+		c.So(pattern.FindAllBytesOverlappingSubmatch(input, -1), c.ShouldEqual, [][][]byte{{[]byte("aba"), []byte("a"), []byte("b"), []byte("a")}, {[]byte("bab"), []byte("b"), []byte("a"), []byte("b")}})
+		c.So(pattern.FindAllBytesOverlappingSubmatchIndex(input, 1), c.ShouldEqual, [][][2]int{{{0, 3}, {0, 1}, {1, 2}, {2, 3}}})
+		c.So(Pattern(nil).FindAllBytesOverlappingSubmatch(input, -1), c.ShouldBeNil)
+		c.So(Pattern(nil).FindAllBytesOverlappingSubmatchIndex(input, -1), c.ShouldBeNil)
+
+	})
+
+}
+
+func TestPattern_FindLastBytes(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]byte
+			index   [][2]int
+		}{
+
+			{input: "", pattern: nil, output: [][]byte(nil), index: [][2]int(nil)},
+			{input: "abc", pattern: Pattern{}.D("+", "c"), output: [][]byte(nil), index: [][2]int(nil)},
+			{input: "12:00 a 12:30 b 13:45 c", pattern: Pattern{}.D("{2}", "c").Text(":").D("{2}", "c"), output: [][]byte{[]byte("13:45"), []byte("13"), []byte("45")}, index: [][2]int{{16, 21}, {16, 18}, {19, 21}}},
+			{input: "/usr/local/bin", pattern: Pattern{}.Text("/", "c"), output: [][]byte{[]byte("/"), []byte("/")}, index: [][2]int{{10, 11}, {10, 11}}},
+			{input: "abc 123", pattern: Pattern{}.D("+", "c"), output: [][]byte{[]byte("123"), []byte("123")}, index: [][2]int{{4, 7}, {4, 7}}},
+			{input: "aaa", pattern: Pattern{}.Text("aa", "c"), output: [][]byte{[]byte("aa"), []byte("aa")}, index: [][2]int{{0, 2}, {0, 2}}},
+			{input: "aba", pattern: Pattern{}.Text("a").Dot("*"), output: [][]byte{[]byte("aba")}, index: [][2]int{{0, 3}}},
+			{input: "é1 ñ22 ü333", pattern: Pattern{}.D("+", "c"), output: [][]byte{[]byte("333"), []byte("333")}, index: [][2]int{{11, 14}, {11, 14}}},
+			{input: "abc abc", pattern: Pattern{}.A().Text("abc", "c"), output: [][]byte{[]byte("abc"), []byte("abc")}, index: [][2]int{{0, 3}, {0, 3}}},
+			{input: "ab 12", pattern: Pattern{}.D("*", "c").Z(), output: [][]byte{[]byte("12"), []byte("12")}, index: [][2]int{{3, 5}, {3, 5}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastBytesSubmatch([]byte(test.input)),
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastBytesSubmatchIndex([]byte(test.input)),
+				c.ShouldEqual,
+				test.index,
+			)
+			var last [2]int
+			if len(test.index) > 0 {
+				last = test.index[0]
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastBytesIndex([]byte(test.input)),
+				c.ShouldEqual,
+				last,
+			)
+			if all := test.pattern.FindAllBytesIndex([]byte(test.input), -1); len(all) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					last,
+					c.ShouldEqual,
+					all[len(all)-1],
+				)
+			}
+			if len(test.output) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					test.pattern.FindLastBytes([]byte(test.input)),
+					c.ShouldEqual,
+					test.output[0],
+				)
+			} else {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					test.pattern.FindLastBytes([]byte(test.input)),
+					c.ShouldBeEmpty,
+				)
+			}
+		}
+
+	})
+
+}
+
+func TestPattern_MatchBytesAt(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			offset  int
+			ok      bool
+			from    []byte
+			index   [][2]int
+		}{
+
+			{input: "abc", pattern: nil, offset: 0, ok: false, from: []byte(nil), index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: -1, ok: false, from: []byte(nil), index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 8, ok: false, from: []byte(nil), index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 0, ok: true, from: []byte("abc"), index: [][2]int{{0, 3}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 1, ok: false, from: []byte("abc"), index: [][2]int{{4, 7}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 4, ok: true, from: []byte("abc"), index: [][2]int{{4, 7}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 5, ok: false, from: []byte(nil), index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 7, ok: false, from: []byte(nil), index: [][2]int(nil)},
+			{input: "ab\ncd", pattern: Pattern{}.Caret().W("+", "c"), offset: 3, ok: false, from: []byte(nil), index: [][2]int(nil)},
+			{input: "ab\ncd", pattern: Pattern{}.Caret("m").W("+", "c"), offset: 3, ok: true, from: []byte("cd"), index: [][2]int{{3, 5}, {3, 5}}},
+			{input: "ab\ncd", pattern: Pattern{}.Caret("m").W("+", "c"), offset: 1, ok: false, from: []byte("cd"), index: [][2]int{{3, 5}, {3, 5}}},
+			{input: "xy zy", pattern: Pattern{}.B().Text("y"), offset: 1, ok: false, from: []byte(nil), index: [][2]int(nil)},
+			{input: "abab", pattern: Pattern{}.Text("ab").Z(), offset: 1, ok: false, from: []byte("ab"), index: [][2]int{{2, 4}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.MatchBytesAt([]byte(test.input), test.offset),
+				c.ShouldEqual,
+				test.ok,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindBytesFrom([]byte(test.input), test.offset),
+				c.ShouldEqual,
+				test.from,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindBytesSubmatchIndexFrom([]byte(test.input), test.offset),
+				c.ShouldEqual,
+				test.index,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_FullMatchBytes(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []byte
+			pattern Pattern
+			full    bool
+			prefix  bool
+			suffix  bool
+		}{
+
+			{input: []byte(""), pattern: nil, full: false, prefix: false, suffix: false},
+			{input: []byte(""), pattern: Pattern{}.D("*"), full: true, prefix: true, suffix: true},
+			{input: []byte("123"), pattern: Pattern{}.D("+"), full: true, prefix: true, suffix: true},
+			{input: []byte("123abc"), pattern: Pattern{}.D("+"), full: false, prefix: true, suffix: false},
+			{input: []byte("abc123"), pattern: Pattern{}.D("+"), full: false, prefix: false, suffix: true},
+			{input: []byte("abc123abc"), pattern: Pattern{}.D("+"), full: false, prefix: false, suffix: false},
+			{input: []byte("abc abc"), pattern: Pattern{}.Text("abc"), full: false, prefix: true, suffix: true},
+			{input: []byte("ABC"), pattern: Pattern{}.Text("abc", "i"), full: true, prefix: true, suffix: true},
+			{input: []byte("ab\ncd"), pattern: Pattern{}.W("+", "c"), full: false, prefix: true, suffix: true},
+			{input: []byte("ab\ncd"), pattern: Pattern{}.Dot("+", "s"), full: true, prefix: true, suffix: true},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.FullMatchBytes(test.input),
+				c.ShouldEqual,
+				test.full,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.HasPrefixMatchBytes(test.input),
+				c.ShouldEqual,
+				test.prefix,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.HasSuffixMatchBytes(test.input),
+				c.ShouldEqual,
+				test.suffix,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_CountBytes(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []byte
+			pattern Pattern
+			limit   int
+			count   int
+		}{
+
+			{input: []byte(""), pattern: nil, limit: -1, count: 0},
+			{input: []byte(""), pattern: Pattern{}.D("+"), limit: -1, count: 0},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), limit: -1, count: 3},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), limit: 0, count: 3},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), limit: 2, count: 2},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), limit: 5, count: 3},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D(), limit: -1, count: 6},
+			{input: []byte("aaaa"), pattern: Pattern{}.Text("aa"), limit: -1, count: 2},
+			{input: []byte("one two three"), pattern: Pattern{}.W("+", "c"), limit: -1, count: 3},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.CountBytes(test.input, test.limit),
+				c.ShouldEqual,
+				test.count,
+			)
+			if len(test.pattern) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+					test.pattern.CountBytes(test.input, test.limit),
+					c.ShouldEqual,
+					len(test.pattern.FindAllBytesIndex(test.input, test.limit)),
+				)
+			}
+		}
+
+	})
+
+}
+
+func TestPattern_EachBytesMatch(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []byte
+			pattern Pattern
+			stop    int
+			output  []string
+			index   [][2]int
+		}{
+
+			{input: []byte(""), pattern: nil, stop: -1, output: []string(nil), index: [][2]int(nil)},
+			{input: []byte(""), pattern: Pattern{}.D("+"), stop: -1, output: []string(nil), index: [][2]int(nil)},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), stop: -1, output: []string{"1", "22", "333"}, index: [][2]int{{1, 2}, {4, 6}, {8, 11}}},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), stop: 1, output: []string{"1"}, index: [][2]int{{1, 2}}},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), stop: 2, output: []string{"1", "22"}, index: [][2]int{{1, 2}, {4, 6}}},
+			{input: []byte("one two"), pattern: Pattern{}.W("+", "c"), stop: 5, output: []string{"one", "two"}, index: [][2]int{{0, 3}, {4, 7}}},
+		} {
+			var output []string
+			var index [][2]int
+			collect := func(m Match) bool {
+				output = append(output, m.Text())
+				index = append(index, [2]int{m.Start(), m.End()})
+				return len(output) != test.stop
+			}
+
+			test.pattern.EachBytesMatch(test.input, collect)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				index,
+				c.ShouldEqual,
+				test.index,
+			)
+
+			output, index = nil, nil
+			test.pattern.BytesMatchSeq(test.input)(collect)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_FindAllBytesMatches(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []byte
+			pattern Pattern
+			count   int
+			output  []string
+		}{
+
+			{input: []byte(""), pattern: nil, count: -1, output: []string(nil)},
+			{input: []byte(""), pattern: Pattern{}.D("+"), count: -1, output: []string(nil)},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), count: -1, output: []string{"1", "22", "333"}},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.D("+"), count: 2, output: []string{"1", "22"}},
+			{input: []byte("a1 b22 c333"), pattern: Pattern{}.Alpha("+", "c").D("+", "c"), count: -1, output: []string{"a1", "b22", "c333"}},
+		} {
+			var output []string
+			var index [][][2]int
+			for _, m := range test.pattern.FindAllBytesMatches(test.input, test.count) {
+				output = append(output, m.Text())
+				var groups [][2]int
+				for n := 0; n < m.Len(); n++ {
+					group, _ := m.Index(n)
+					groups = append(groups, group)
+				}
+				index = append(index, groups)
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				index,
+				c.ShouldEqual,
+				test.pattern.FindAllBytesSubmatchIndex(test.input, test.count),
+			)
+			var first string
+			if len(test.output) > 0 {
+				first = test.output[0]
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.FindBytesMatch(test.input).Text(),
+				c.ShouldEqual,
+				first,
+			)
+		}
+
+	})
+
+}
+
 func TestPattern_ReplaceAll(t *testing.T) {
 
 	c.Convey("batch", t, func() {
@@ -16,17 +16,23 @@ package rxp
 
 func (p Pattern) findRunes(s *cPatternState, count int) (matched [][][]rune) {
 	if p.match(s, count) {
-		for _, match := range s.matches {
-			if len(match) > 0 {
-				var groups [][]rune
-				for _, submatch := range match {
-					slice, _ := s.input.Slice(submatch[0], submatch[1]-submatch[0])
-					groups = pushRunes(groups, slice)
-					//groups = append(groups, slice)
-				}
-				matched = pushRuneSlices(matched, groups)
-				//matched = append(matched, groups)
+		matched = s.runesMatches()
+	}
+	return
+}
+
+// runesMatches returns the matches of this state, with all of their sub-matches
+func (s *cPatternState) runesMatches() (matched [][][]rune) {
+	for _, match := range s.matches {
+		if len(match) > 0 {
+			var groups [][]rune
+			for _, submatch := range match {
+				slice, _ := s.input.Slice(submatch[0], submatch[1]-submatch[0])
+				groups = pushRunes(groups, slice)
+				//groups = append(groups, slice)
 			}
+			matched = pushRuneSlices(matched, groups)
+			//matched = append(matched, groups)
 		}
 	}
 	return
@@ -43,6 +49,46 @@ func (p Pattern) MatchRunes(input []rune) (ok bool) {
 	return
 }
 
+// FullMatchRunes returns true if this Pattern matches all of the input given,
+// as if the Pattern started with A and ended with Z
+func (p Pattern) FullMatchRunes(input []rune) (ok bool) {
+	return matchAnchored(p, input, true, true)
+}
+
+// HasPrefixMatchRunes returns true if this Pattern matches at the start of the
+// input given, as if the Pattern started with A
+func (p Pattern) HasPrefixMatchRunes(input []rune) (ok bool) {
+	return matchAnchored(p, input, true, false)
+}
+
+// HasSuffixMatchRunes returns true if this Pattern matches at the end of the
+// input given, as if the Pattern ended with Z
+func (p Pattern) HasSuffixMatchRunes(input []rune) (ok bool) {
+	return matchAnchored(p, input, false, true)
+}
+
+// CountRunes returns the number of non-overlapping matches of this Pattern in
+// the input given, without keeping any of the matches found. When limit is
+// greater than zero, counting stops once limit matches are found
+func (p Pattern) CountRunes(input []rune, limit int) (count int) {
+	return countMatches(p, input, limit)
+}
+
+// EachRunesMatch calls fn with each of the non-overlapping matches of this
+// Pattern in the input given, in the order the matches are found, stopping
+// the scan when fn returns false. Matches are not kept once fn returns
+func (p Pattern) EachRunesMatch(input []rune, fn func(m Match) bool) {
+	eachMatch(p, input, fn)
+}
+
+// RunesMatchSeq returns an iterator over the matches of this Pattern in the
+// input given, suitable for use with range-over-func, see EachRunesMatch
+func (p Pattern) RunesMatchSeq(input []rune) func(yield func(m Match) bool) {
+	return func(yield func(m Match) bool) {
+		eachMatch(p, input, yield)
+	}
+}
+
 // FindRunes returns the leftmost Pattern match within the input given
 func (p Pattern) FindRunes(input []rune) []rune {
 	if mm := p.FindRunesSubmatch(input); len(mm) > 0 {
@@ -87,6 +133,21 @@ func (p Pattern) FindRunesSubmatchIndex(input []rune) (found [][2]int) {
 	return
 }
 
+// FindRunesMatch returns the leftmost Pattern match within the input given,
+// or the zero Match if there was no match of this Pattern
+func (p Pattern) FindRunesMatch(input []rune) (m Match) {
+	if mm := findMatches(p, input, 1); len(mm) > 0 {
+		m = mm[0]
+	}
+	return
+}
+
+// FindAllRunesMatches returns up to count of the Pattern matches present in the
+// input given. When count is less than one, all the matches are returned
+func (p Pattern) FindAllRunesMatches(input []rune, count int) (found []Match) {
+	return findMatches(p, input, count)
+}
+
 // FindAllRunes returns a slice of strings containing all of the Pattern
 // matches present in the input given, in the order the matches are found
 func (p Pattern) FindAllRunes(input []rune, count int) (found [][]rune) {
@@ -140,6 +201,158 @@ func (p Pattern) FindAllRunesSubmatchIndex(input []rune, count int) (found [][][
 	return
 }
 
+// FindAllRunesOverlapping is like FindAllRunes, except that the search for each
+// following match restarts one rune after the start of the previous match
+// instead of at the end of the previous match, so that Text("aa") finds three
+// matches within "aaaa"
+func (p Pattern) FindAllRunesOverlapping(input []rune, count int) (found [][]rune) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			for _, m := range s.runesMatches() {
+				found = append(found, m[0]) // always at least one present
+			}
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindAllRunesOverlappingIndex returns a slice of starting and ending indices
+// denoting each of the overlapping Pattern matches present in the input given,
+// see FindAllRunesOverlapping
+func (p Pattern) FindAllRunesOverlappingIndex(input []rune, count int) (found [][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			for _, groups := range s.matches {
+				found = append(found, groups[0])
+			}
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindAllRunesOverlappingSubmatch returns a slice of all the overlapping Pattern
+// matches (and any sub-matches) present in the input given, see
+// FindAllRunesOverlapping
+func (p Pattern) FindAllRunesOverlappingSubmatch(input []rune, count int) (found [][][]rune) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			found = s.runesMatches()
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindAllRunesOverlappingSubmatchIndex returns a slice of starting and ending
+// points for all the overlapping Pattern matches (and any sub-matches)
+// present in the input given, see FindAllRunesOverlapping
+func (p Pattern) FindAllRunesOverlappingSubmatchIndex(input []rune, count int) (found [][][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			found = s.matches
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindLastRunes returns the last Pattern match within the input given
+//
+// FindLastRunes finds the same match as the last of the matches returned by
+// FindAllRunes with a count of -1, without keeping all the matches before it
+func (p Pattern) FindLastRunes(input []rune) []rune {
+	if mm := p.FindLastRunesSubmatch(input); len(mm) > 0 {
+		return mm[0]
+	}
+	return nil
+}
+
+// FindLastRunesIndex returns the last Pattern match starting and ending indexes
+// within the input given
+func (p Pattern) FindLastRunesIndex(input []rune) (found [2]int) {
+	if mm := p.FindLastRunesSubmatchIndex(input); len(mm) > 0 {
+		return mm[0]
+	}
+	return
+}
+
+// FindLastRunesSubmatch returns the last match of this Pattern and any of its
+// sub-matches, or nil if there was no match within the input given
+func (p Pattern) FindLastRunesSubmatch(input []rune) (found [][]rune) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchLast(s) {
+			found = s.runesMatches()[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindLastRunesSubmatchIndex returns a slice of starting and ending indices
+// denoting the last match of this Pattern and any of its sub-matches
+func (p Pattern) FindLastRunesSubmatchIndex(input []rune) (found [][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchLast(s) {
+			found = s.matches[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// MatchRunesAt returns true if this Pattern matches the input exactly at the
+// rune offset given, without searching for a match any further into the input
+//
+// Matchers still see all of the input before the offset, for example:
+// Caret("m") matches at an offset following a newline
+func (p Pattern) MatchRunesAt(input []rune, offset int) (ok bool) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index, s.anchor = offset, true
+		ok = p.match(s, 1)
+		s.matches = nil
+	}
+	return
+}
+
+// FindRunesFrom returns the leftmost Pattern match starting at or after the
+// rune offset given
+func (p Pattern) FindRunesFrom(input []rune, offset int) (found []rune) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index = offset
+		if mm := p.findRunes(s, 1); len(mm) > 0 {
+			found = mm[0][0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindRunesSubmatchIndexFrom returns a slice of starting and ending indices
+// denoting the leftmost match of this Pattern, and any of its sub-matches,
+// starting at or after the rune offset given. The indices are relative to
+// the start of the input, not the offset
+func (p Pattern) FindRunesSubmatchIndexFrom(input []rune, offset int) (found [][2]int) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index = offset
+		if p.match(s, 1) && len(s.matches) > 0 {
+			found = s.matches[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
 // ReplaceAllRunes returns a copy of the input []rune with all Pattern matches
 // replaced with text returned by the given Replace process
 func (p Pattern) ReplaceAllRunes(input []rune, replacements Replace[[]rune]) (replaced []rune) {
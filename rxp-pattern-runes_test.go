@@ -114,6 +114,7 @@ func TestPattern_FindAllRunesIndex(t *testing.T) {
 			{input: "", pattern: nil, count: -1, output: [][2]int{{0, 0}}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: 1, output: [][2]int{{0, 1}}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: -1, output: [][2]int{{0, 1}, {1, 2}}},
+			{input: "aaa", pattern: Pattern{}.Dot("{1}", "c"), count: 2, output: [][2]int{{0, 1}, {1, 2}}},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
@@ -167,6 +168,7 @@ func TestPattern_FindAllRunes(t *testing.T) {
 			{input: "", pattern: nil, count: -1, output: [][]rune(nil)},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: 1, output: [][]rune{[]rune("a")}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: -1, output: [][]rune{[]rune("a"), []rune("a")}},
+			{input: "aaa", pattern: Pattern{}.Dot("{1}", "c"), count: 2, output: [][]rune{[]rune("a"), []rune("a")}},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
@@ -194,6 +196,7 @@ func TestPattern_FindAllRunesSubmatch(t *testing.T) {
 			{input: "", pattern: nil, count: -1, output: [][][]rune(nil)},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: 1, output: [][][]rune{{{97}, {97}}}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: -1, output: [][][]rune{{{97}, {97}}, {{97}, {97}}}},
+			{input: "abab", pattern: Pattern{}.Text("ab", "c"), count: -1, output: [][][]rune{{[]rune("ab"), []rune("ab")}, {[]rune("ab"), []rune("ab")}}},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
@@ -237,6 +240,371 @@ func TestPattern_FindAllRunesSubmatchIndex(t *testing.T) {
 
 }
 
+func TestPattern_FindAllRunesOverlapping(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []rune
+			pattern Pattern
+			count   int
+			output  [][]rune
+			index   [][2]int
+		}{
+
+			{input: []rune(""), pattern: nil, count: -1, output: [][]rune(nil), index: [][2]int(nil)},
+			{input: []rune("aaaa"), pattern: Pattern{}.Text("aa"), count: -1, output: [][]rune{[]rune("aa"), []rune("aa"), []rune("aa")}, index: [][2]int{{0, 2}, {1, 3}, {2, 4}}},
+			{input: []rune("aaaa"), pattern: Pattern{}.Text("aa"), count: 2, output: [][]rune{[]rune("aa"), []rune("aa")}, index: [][2]int{{0, 2}, {1, 3}}},
+			{input: []rune("aaaa"), pattern: Pattern{}.Text("b"), count: -1, output: [][]rune(nil), index: [][2]int(nil)},
+			{input: []rune("gattaca"), pattern: Pattern{}.Dot("{3}"), count: -1, output: [][]rune{[]rune("gat"), []rune("att"), []rune("tta"), []rune("tac"), []rune("aca")}, index: [][2]int{{0, 3}, {1, 4}, {2, 5}, {3, 6}, {4, 7}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllRunesOverlapping(test.input, test.count),
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllRunesOverlappingIndex(test.input, test.count),
+				c.ShouldEqual,
+				test.index,
+			)
+		}
+
+		input := []rune("abab")
+		pattern := Pattern{}.Dot("c").Dot("c").Dot("c")
+		c.So(pattern.FindAllRunesOverlappingSubmatch(input, -1), c.ShouldEqual, [][][]rune{{[]rune("aba"), []rune("a"), []rune("b"), []rune("a")}, {[]rune("bab"), []rune("b"), []rune("a"), []rune("b")}})
+		c.So(pattern.FindAllRunesOverlappingSubmatchIndex(input, 1), c.ShouldEqual, [][][2]int{{{0, 3}, {0, 1}, {1, 2}, {2, 3}}})
+		c.So(Pattern(nil).FindAllRunesOverlappingSubmatch(input, -1), c.ShouldBeNil)
+		c.So(Pattern(nil).FindAllRunesOverlappingSubmatchIndex(input, -1), c.ShouldBeNil)
+
+	})
+
+}
+
+func TestPattern_FindLastRunes(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  [][]rune
+			index   [][2]int
+		}{
+
+			{input: "", pattern: nil, output: [][]rune(nil), index: [][2]int(nil)},
+			{input: "abc", pattern: Pattern{}.D("+", "c"), output: [][]rune(nil), index: [][2]int(nil)},
+			{input: "12:00 a 12:30 b 13:45 c", pattern: Pattern{}.D("{2}", "c").Text(":").D("{2}", "c"), output: [][]rune{[]rune("13:45"), []rune("13"), []rune("45")}, index: [][2]int{{16, 21}, {16, 18}, {19, 21}}},
+			{input: "/usr/local/bin", pattern: Pattern{}.Text("/", "c"), output: [][]rune{[]rune("/"), []rune("/")}, index: [][2]int{{10, 11}, {10, 11}}},
+			{input: "abc 123", pattern: Pattern{}.D("+", "c"), output: [][]rune{[]rune("123"), []rune("123")}, index: [][2]int{{4, 7}, {4, 7}}},
+			{input: "aaa", pattern: Pattern{}.Text("aa", "c"), output: [][]rune{[]rune("aa"), []rune("aa")}, index: [][2]int{{0, 2}, {0, 2}}},
+			{input: "aba", pattern: Pattern{}.Text("a").Dot("*"), output: [][]rune{[]rune("aba")}, index: [][2]int{{0, 3}}},
+			{input: "é1 ñ22 ü333", pattern: Pattern{}.D("+", "c"), output: [][]rune{[]rune("333"), []rune("333")}, index: [][2]int{{8, 11}, {8, 11}}},
+			{input: "abc abc", pattern: Pattern{}.A().Text("abc", "c"), output: [][]rune{[]rune("abc"), []rune("abc")}, index: [][2]int{{0, 3}, {0, 3}}},
+			{input: "ab 12", pattern: Pattern{}.D("*", "c").Z(), output: [][]rune{[]rune("12"), []rune("12")}, index: [][2]int{{3, 5}, {3, 5}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastRunesSubmatch([]rune(test.input)),
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastRunesSubmatchIndex([]rune(test.input)),
+				c.ShouldEqual,
+				test.index,
+			)
+			var last [2]int
+			if len(test.index) > 0 {
+				last = test.index[0]
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastRunesIndex([]rune(test.input)),
+				c.ShouldEqual,
+				last,
+			)
+			if all := test.pattern.FindAllRunesIndex([]rune(test.input), -1); len(all) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					last,
+					c.ShouldEqual,
+					all[len(all)-1],
+				)
+			}
+			if len(test.output) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					test.pattern.FindLastRunes([]rune(test.input)),
+					c.ShouldEqual,
+					test.output[0],
+				)
+			} else {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					test.pattern.FindLastRunes([]rune(test.input)),
+					c.ShouldBeEmpty,
+				)
+			}
+		}
+
+	})
+
+}
+
+func TestPattern_MatchRunesAt(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			offset  int
+			ok      bool
+			from    []rune
+			index   [][2]int
+		}{
+
+			{input: "abc", pattern: nil, offset: 0, ok: false, from: []rune(nil), index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: -1, ok: false, from: []rune(nil), index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 8, ok: false, from: []rune(nil), index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 0, ok: true, from: []rune("abc"), index: [][2]int{{0, 3}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 1, ok: false, from: []rune("abc"), index: [][2]int{{4, 7}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 4, ok: true, from: []rune("abc"), index: [][2]int{{4, 7}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 5, ok: false, from: []rune(nil), index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 7, ok: false, from: []rune(nil), index: [][2]int(nil)},
+			{input: "ab\ncd", pattern: Pattern{}.Caret().W("+", "c"), offset: 3, ok: false, from: []rune(nil), index: [][2]int(nil)},
+			{input: "ab\ncd", pattern: Pattern{}.Caret("m").W("+", "c"), offset: 3, ok: true, from: []rune("cd"), index: [][2]int{{3, 5}, {3, 5}}},
+			{input: "ab\ncd", pattern: Pattern{}.Caret("m").W("+", "c"), offset: 1, ok: false, from: []rune("cd"), index: [][2]int{{3, 5}, {3, 5}}},
+			{input: "xy zy", pattern: Pattern{}.B().Text("y"), offset: 1, ok: false, from: []rune(nil), index: [][2]int(nil)},
+			{input: "abab", pattern: Pattern{}.Text("ab").Z(), offset: 1, ok: false, from: []rune("ab"), index: [][2]int{{2, 4}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.MatchRunesAt([]rune(test.input), test.offset),
+				c.ShouldEqual,
+				test.ok,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindRunesFrom([]rune(test.input), test.offset),
+				c.ShouldEqual,
+				test.from,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindRunesSubmatchIndexFrom([]rune(test.input), test.offset),
+				c.ShouldEqual,
+				test.index,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_FullMatchRunes(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []rune
+			pattern Pattern
+			full    bool
+			prefix  bool
+			suffix  bool
+		}{
+
+			{input: []rune(""), pattern: nil, full: false, prefix: false, suffix: false},
+			{input: []rune(""), pattern: Pattern{}.D("*"), full: true, prefix: true, suffix: true},
+			{input: []rune("123"), pattern: Pattern{}.D("+"), full: true, prefix: true, suffix: true},
+			{input: []rune("123abc"), pattern: Pattern{}.D("+"), full: false, prefix: true, suffix: false},
+			{input: []rune("abc123"), pattern: Pattern{}.D("+"), full: false, prefix: false, suffix: true},
+			{input: []rune("abc123abc"), pattern: Pattern{}.D("+"), full: false, prefix: false, suffix: false},
+			{input: []rune("abc abc"), pattern: Pattern{}.Text("abc"), full: false, prefix: true, suffix: true},
+			{input: []rune("ABC"), pattern: Pattern{}.Text("abc", "i"), full: true, prefix: true, suffix: true},
+			{input: []rune("ab\ncd"), pattern: Pattern{}.W("+", "c"), full: false, prefix: true, suffix: true},
+			{input: []rune("ab\ncd"), pattern: Pattern{}.Dot("+", "s"), full: true, prefix: true, suffix: true},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.FullMatchRunes(test.input),
+				c.ShouldEqual,
+				test.full,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.HasPrefixMatchRunes(test.input),
+				c.ShouldEqual,
+				test.prefix,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.HasSuffixMatchRunes(test.input),
+				c.ShouldEqual,
+				test.suffix,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_CountRunes(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []rune
+			pattern Pattern
+			limit   int
+			count   int
+		}{
+
+			{input: []rune(""), pattern: nil, limit: -1, count: 0},
+			{input: []rune(""), pattern: Pattern{}.D("+"), limit: -1, count: 0},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), limit: -1, count: 3},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), limit: 0, count: 3},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), limit: 2, count: 2},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), limit: 5, count: 3},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D(), limit: -1, count: 6},
+			{input: []rune("aaaa"), pattern: Pattern{}.Text("aa"), limit: -1, count: 2},
+			{input: []rune("one two three"), pattern: Pattern{}.W("+", "c"), limit: -1, count: 3},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.CountRunes(test.input, test.limit),
+				c.ShouldEqual,
+				test.count,
+			)
+			if len(test.pattern) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+					test.pattern.CountRunes(test.input, test.limit),
+					c.ShouldEqual,
+					len(test.pattern.FindAllRunesIndex(test.input, test.limit)),
+				)
+			}
+		}
+
+	})
+
+}
+
+func TestPattern_EachRunesMatch(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []rune
+			pattern Pattern
+			stop    int
+			output  []string
+			index   [][2]int
+		}{
+
+			{input: []rune(""), pattern: nil, stop: -1, output: []string(nil), index: [][2]int(nil)},
+			{input: []rune(""), pattern: Pattern{}.D("+"), stop: -1, output: []string(nil), index: [][2]int(nil)},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), stop: -1, output: []string{"1", "22", "333"}, index: [][2]int{{1, 2}, {4, 6}, {8, 11}}},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), stop: 1, output: []string{"1"}, index: [][2]int{{1, 2}}},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), stop: 2, output: []string{"1", "22"}, index: [][2]int{{1, 2}, {4, 6}}},
+			{input: []rune("one two"), pattern: Pattern{}.W("+", "c"), stop: 5, output: []string{"one", "two"}, index: [][2]int{{0, 3}, {4, 7}}},
+		} {
+			var output []string
+			var index [][2]int
+			collect := func(m Match) bool {
+				output = append(output, m.Text())
+				index = append(index, [2]int{m.Start(), m.End()})
+				return len(output) != test.stop
+			}
+
+			test.pattern.EachRunesMatch(test.input, collect)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				index,
+				c.ShouldEqual,
+				test.index,
+			)
+
+			output, index = nil, nil
+			test.pattern.RunesMatchSeq(test.input)(collect)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_FindAllRunesMatches(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   []rune
+			pattern Pattern
+			count   int
+			output  []string
+		}{
+
+			{input: []rune(""), pattern: nil, count: -1, output: []string(nil)},
+			{input: []rune(""), pattern: Pattern{}.D("+"), count: -1, output: []string(nil)},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), count: -1, output: []string{"1", "22", "333"}},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.D("+"), count: 2, output: []string{"1", "22"}},
+			{input: []rune("a1 b22 c333"), pattern: Pattern{}.Alpha("+", "c").D("+", "c"), count: -1, output: []string{"a1", "b22", "c333"}},
+		} {
+			var output []string
+			var index [][][2]int
+			for _, m := range test.pattern.FindAllRunesMatches(test.input, test.count) {
+				output = append(output, m.Text())
+				var groups [][2]int
+				for n := 0; n < m.Len(); n++ {
+					group, _ := m.Index(n)
+					groups = append(groups, group)
+				}
+				index = append(index, groups)
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				index,
+				c.ShouldEqual,
+				test.pattern.FindAllRunesSubmatchIndex(test.input, test.count),
+			)
+			var first string
+			if len(test.output) > 0 {
+				first = test.output[0]
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, string(test.input)),
+				test.pattern.FindRunesMatch(test.input).Text(),
+				c.ShouldEqual,
+				first,
+			)
+		}
+
+	})
+
+}
+
 func TestPattern_ReplaceAllRunes(t *testing.T) {
 
 	c.Convey("batch", t, func() {
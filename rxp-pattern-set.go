@@ -0,0 +1,200 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+// PatternSet is a list of Patterns which are all matched against the same
+// input, reporting which of the Patterns matched, similar to the Set type
+// of the RE2 library
+//
+// PatternSet matches all of the Patterns in a single pass over the input,
+// stopping once all of the Patterns have matched
+//
+// Patterns can be added with a list of required literals, one of which must
+// be present in the input for the Pattern to possibly match. The required
+// literals of all the Patterns are combined into a single case-insensitive
+// prefilter which is scanned once for each input, before the pass over the
+// input, skipping the Patterns which cannot match. Patterns added without
+// required literals are always matched against the input
+//
+// PatternSet instances are safe for concurrent matching, though not for
+// adding Patterns concurrently with matching
+type PatternSet struct {
+	patterns []Pattern
+	required []bool
+	literals *cTrie
+	nodes    map[int32][]int
+}
+
+// NewPatternSet creates a new PatternSet with the Patterns given, none of
+// which have required literals
+func NewPatternSet(patterns ...Pattern) (ps *PatternSet) {
+	ps = &PatternSet{
+		literals: newTrie(nil, FullFoldFlag),
+		nodes:    make(map[int32][]int),
+	}
+	for _, pattern := range patterns {
+		ps.Add(pattern)
+	}
+	return
+}
+
+// Add appends the Pattern given to this PatternSet and returns the index of
+// the Pattern within this PatternSet
+//
+// When literals are given, the Pattern is only matched against input which
+// contains at least one of the literals given, ignoring case using full case
+// folding. Empty literals are ignored and if all the literals are empty, the
+// Pattern is always matched
+func (ps *PatternSet) Add(pattern Pattern, literals ...string) (index int) {
+	index = len(ps.patterns)
+	ps.patterns = append(ps.patterns, pattern)
+	ps.required = append(ps.required, false)
+	for _, literal := range literals {
+		if node := ps.literals.insert(literal); node > 0 {
+			ps.nodes[node] = append(ps.nodes[node], index)
+			ps.required[index] = true
+		}
+	}
+	return
+}
+
+// Len returns the number of Patterns in this PatternSet
+func (ps *PatternSet) Len() int {
+	return len(ps.patterns)
+}
+
+// MatchString returns the indexes of the Patterns matching the input given,
+// in the order the Patterns were added
+func (ps *PatternSet) MatchString(input string) (matched []int) {
+	matched, _ = ps.match(NewInputReader(input))
+	return
+}
+
+// MatchBytes returns the indexes of the Patterns matching the input given,
+// in the order the Patterns were added
+func (ps *PatternSet) MatchBytes(input []byte) (matched []int) {
+	matched, _ = ps.match(NewInputReader(input))
+	return
+}
+
+// MatchRunes returns the indexes of the Patterns matching the input given,
+// in the order the Patterns were added
+func (ps *PatternSet) MatchRunes(input []rune) (matched []int) {
+	matched, _ = ps.match(NewInputReader(input))
+	return
+}
+
+// FindStringIndex is like MatchString and also returns the starting and
+// ending indexes of the leftmost match of each of the Patterns matched
+func (ps *PatternSet) FindStringIndex(input string) (matched []int, found [][2]int) {
+	return ps.match(NewInputReader(input))
+}
+
+// FindBytesIndex is like MatchBytes and also returns the starting and ending
+// indexes of the leftmost match of each of the Patterns matched
+func (ps *PatternSet) FindBytesIndex(input []byte) (matched []int, found [][2]int) {
+	return ps.match(NewInputReader(input))
+}
+
+// FindRunesIndex is like MatchRunes and also returns the starting and ending
+// indexes of the leftmost match of each of the Patterns matched
+func (ps *PatternSet) FindRunesIndex(input []rune) (matched []int, found [][2]int) {
+	return ps.match(NewInputReader(input))
+}
+
+// candidates returns which of the Patterns can possibly match the input
+func (ps *PatternSet) candidates(input *InputReader) (possible []bool) {
+	possible = make([]bool, len(ps.patterns))
+	var remaining int
+	for idx, required := range ps.required {
+		if possible[idx] = !required; required {
+			remaining += 1
+		}
+	}
+
+	for index := 0; remaining > 0 && index < input.len; {
+		ps.literals.walk(input, index, func(node int32, _ int) {
+			for _, pdx := range ps.nodes[node] {
+				if !possible[pdx] {
+					possible[pdx] = true
+					remaining -= 1
+				}
+			}
+		})
+		if _, size, ok := input.Get(index); ok && size > 0 {
+			index += size // move the needle correctly
+		} else {
+			index += 1 // must move the needle to progress
+		}
+	}
+
+	return
+}
+
+// match is the PatternSet implementation for all input types
+//
+// Each of the candidate Patterns has its own state, tracking where the next
+// attempt of the Pattern starts, which is the same as the scanning done by
+// Pattern.match. The input is passed over once, making the next attempt of
+// each of the Patterns waiting at each index
+func (ps *PatternSet) match(input *InputReader) (matched []int, found [][2]int) {
+	states := make([]*cPatternState, len(ps.patterns))
+	var remaining int
+	for idx, possible := range ps.candidates(input) {
+		if p := ps.patterns[idx]; possible && len(p) > 0 {
+			states[idx] = &cPatternState{
+				input:   input,
+				pattern: p,
+				anchor:  true,
+				matches: [][][2]int{},
+			}
+			remaining += 1
+		}
+	}
+
+	spans := make([][2]int, len(ps.patterns))
+	done := make([]bool, len(ps.patterns))
+	for index := 0; remaining > 0 && index <= input.len; {
+
+		next := index + 1 // must move the needle to progress
+		if _, size, ok := input.Get(index); ok && size > 0 {
+			next = index + size // move the needle correctly
+		}
+
+		for idx, s := range states {
+			if s == nil || done[idx] || s.index != index {
+				// not a candidate, already matched or waiting for a later index
+				continue
+			}
+			if s.pattern.match(s, 1) {
+				done[idx], spans[idx] = true, s.matches[0][0]
+				remaining -= 1
+			} else if s.index == index {
+				// the attempt did not advance the index
+				s.index = next
+			}
+		}
+
+		index = next
+	}
+
+	for idx := range ps.patterns {
+		if done[idx] {
+			matched = append(matched, idx)
+			found = append(found, spans[idx])
+		}
+	}
+	return
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestPatternSet(t *testing.T) {
+	c.Convey("PatternSet", t, func() {
+		ps := NewPatternSet(
+			Pattern{}.Text("ERROR"),
+			Pattern{}.Text("WARN"),
+			Pattern{}.D("+").Text("ms"),
+			nil,
+		)
+		c.So(ps.Add(Pattern{}.Text("timeout", "i"), "timeout"), c.ShouldEqual, 4)
+		c.So(ps.Add(Pattern{}.Text("disk").S().Text("full"), "disk", "volume"), c.ShouldEqual, 5)
+		c.So(ps.Add(Pattern{}.Text("panic"), ""), c.ShouldEqual, 6)
+		c.So(ps.Len(), c.ShouldEqual, 7)
+
+		for idx, test := range []struct {
+			input   string
+			matched []int
+			found   [][2]int
+		}{
+			{"", nil, nil},
+			{"INFO all good", nil, nil},
+			{"ERROR request TIMEOUT after 30ms", []int{0, 2, 4}, [][2]int{{0, 5}, {28, 32}, {14, 21}}},
+			{"WARN disk full", []int{1, 5}, [][2]int{{0, 4}, {5, 14}}},
+			{"WARN disk is full", []int{1}, [][2]int{{0, 4}}},
+			{"panic: Disk full", []int{6}, [][2]int{{0, 5}}},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), ps.MatchString(test.input), c.ShouldEqual, test.matched)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), ps.MatchBytes([]byte(test.input)), c.ShouldEqual, test.matched)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), ps.MatchRunes([]rune(test.input)), c.ShouldEqual, test.matched)
+			matched, found := ps.FindStringIndex(test.input)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), matched, c.ShouldEqual, test.matched)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), found, c.ShouldEqual, test.found)
+			matched, found = ps.FindBytesIndex([]byte(test.input))
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), matched, c.ShouldEqual, test.matched)
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), found, c.ShouldEqual, test.found)
+			matched, _ = ps.FindRunesIndex([]rune(test.input))
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), matched, c.ShouldEqual, test.matched)
+		}
+
+		matched, found := ps.FindRunesIndex([]rune("é ERROR"))
+		c.So(matched, c.ShouldEqual, []int{0})
+		c.So(found, c.ShouldEqual, [][2]int{{2, 7}})
+		matched, found = ps.FindStringIndex("é ERROR")
+		c.So(found, c.ShouldEqual, [][2]int{{3, 8}})
+	})
+
+	c.Convey("candidates", t, func() {
+		ps := NewPatternSet()
+		ps.Add(Pattern{}.Text("alpha"), "alpha")
+		ps.Add(Pattern{}.Text("beta"), "beta", "b")
+		ps.Add(Pattern{}.Text("gamma"))
+		ps.Add(Pattern{}.D("+"))
+		c.So(ps.candidates(NewInputReader("ALPHA")), c.ShouldEqual, []bool{true, false, true, true})
+		c.So(ps.candidates(NewInputReader("xyz b")), c.ShouldEqual, []bool{false, true, true, true})
+		c.So(ps.candidates(NewInputReader("")), c.ShouldEqual, []bool{false, false, true, true})
+	})
+
+	c.Convey("folding", t, func() {
+		ps := NewPatternSet()
+		ps.Add(Pattern{}.Text("strasse", "f"), "strasse")
+		ps.Add(Pattern{}.Text("straße", "f"), "STRASSE")
+		ps.Add(Pattern{}.Text("strasse"), "strasse")
+		c.So(ps.MatchString("Straße"), c.ShouldEqual, []int{0, 1})
+		c.So(ps.MatchString("STRASSE"), c.ShouldEqual, []int{0, 1})
+		c.So(ps.MatchString("strasse"), c.ShouldEqual, []int{0, 1, 2})
+	})
+
+	c.Convey("custom matchers", t, func() {
+		// an optional Text used within a custom Matcher is not a required
+		// literal of the Pattern
+		optA := func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (Flags, int, bool) {
+			if scoped, consumed, proceed := Text("a")(scope, reps, input, index, sm); proceed {
+				return scoped, consumed, true
+			}
+			return scope, 0, true
+		}
+		p := Pattern{optA, D()}
+		c.So(p.MatchString("1"), c.ShouldBeTrue)
+		c.So(NewPatternSet(p).MatchString("1"), c.ShouldEqual, []int{0})
+		c.So(NewPatternSet(p).MatchString("a1"), c.ShouldEqual, []int{0})
+	})
+
+	c.Convey("same as each Pattern", t, func() {
+		patterns := []Pattern{
+			Pattern{}.Text("aa").Text("b"),
+			Pattern{}.D("+").Text("ms"),
+			Pattern{}.Text("ab").Text("c"),
+			Pattern{}.W("+", "c").Text("="),
+			Pattern{}.Caret("m").Text("x"),
+			Pattern{}.Text("b").Z(),
+		}
+		ps := NewPatternSet(patterns...)
+		for _, input := range []string{"aaab", "1 23ms", "ababc", "key=value", "a\nx", "ab", "", "é ab"} {
+			var expected []int
+			var spans [][2]int
+			for idx, p := range patterns {
+				if p.MatchString(input) {
+					expected = append(expected, idx)
+					spans = append(spans, p.FindStringIndex(input))
+				}
+			}
+			matched, found := ps.FindStringIndex(input)
+			c.SoMsg(fmt.Sprintf("%q", input), matched, c.ShouldEqual, expected)
+			c.SoMsg(fmt.Sprintf("%q", input), found, c.ShouldEqual, spans)
+		}
+	})
+}
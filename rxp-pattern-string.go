@@ -16,16 +16,22 @@ package rxp
 
 func (p Pattern) findString(s *cPatternState, count int) (matched [][]string) {
 	if p.match(s, count) {
-		for _, match := range s.matches {
-			if len(match) > 0 {
-				var groups []string
-				for _, submatch := range match {
-					groups = pushString(groups, s.input.String(submatch[0], submatch[1]-submatch[0]))
-					//groups = append(groups, s.input.String(submatch[0], submatch[1]-submatch[0]))
-				}
-				matched = pushStrings(matched, groups)
-				//matched = append(matched, groups)
+		matched = s.stringMatches()
+	}
+	return
+}
+
+// stringMatches returns the matches of this state, with all of their sub-matches
+func (s *cPatternState) stringMatches() (matched [][]string) {
+	for _, match := range s.matches {
+		if len(match) > 0 {
+			var groups []string
+			for _, submatch := range match {
+				groups = pushString(groups, s.input.String(submatch[0], submatch[1]-submatch[0]))
+				//groups = append(groups, s.input.String(submatch[0], submatch[1]-submatch[0]))
 			}
+			matched = pushStrings(matched, groups)
+			//matched = append(matched, groups)
 		}
 	}
 	return
@@ -42,6 +48,46 @@ func (p Pattern) MatchString(input string) (ok bool) {
 	return
 }
 
+// FullMatchString returns true if this Pattern matches all of the input given,
+// as if the Pattern started with A and ended with Z
+func (p Pattern) FullMatchString(input string) (ok bool) {
+	return matchAnchored(p, input, true, true)
+}
+
+// HasPrefixMatchString returns true if this Pattern matches at the start of the
+// input given, as if the Pattern started with A
+func (p Pattern) HasPrefixMatchString(input string) (ok bool) {
+	return matchAnchored(p, input, true, false)
+}
+
+// HasSuffixMatchString returns true if this Pattern matches at the end of the
+// input given, as if the Pattern ended with Z
+func (p Pattern) HasSuffixMatchString(input string) (ok bool) {
+	return matchAnchored(p, input, false, true)
+}
+
+// CountString returns the number of non-overlapping matches of this Pattern in
+// the input given, without keeping any of the matches found. When limit is
+// greater than zero, counting stops once limit matches are found
+func (p Pattern) CountString(input string, limit int) (count int) {
+	return countMatches(p, input, limit)
+}
+
+// EachStringMatch calls fn with each of the non-overlapping matches of this
+// Pattern in the input given, in the order the matches are found, stopping
+// the scan when fn returns false. Matches are not kept once fn returns
+func (p Pattern) EachStringMatch(input string, fn func(m Match) bool) {
+	eachMatch(p, input, fn)
+}
+
+// StringMatchSeq returns an iterator over the matches of this Pattern in the
+// input given, suitable for use with range-over-func, see EachStringMatch
+func (p Pattern) StringMatchSeq(input string) func(yield func(m Match) bool) {
+	return func(yield func(m Match) bool) {
+		eachMatch(p, input, yield)
+	}
+}
+
 // FindString returns the leftmost Pattern match within the input given
 func (p Pattern) FindString(input string) string {
 	if mm := p.FindStringSubmatch(input); len(mm) > 0 {
@@ -86,6 +132,21 @@ func (p Pattern) FindStringSubmatchIndex(input string) (found [][2]int) {
 	return
 }
 
+// FindStringMatch returns the leftmost Pattern match within the input given,
+// or the zero Match if there was no match of this Pattern
+func (p Pattern) FindStringMatch(input string) (m Match) {
+	if mm := findMatches(p, input, 1); len(mm) > 0 {
+		m = mm[0]
+	}
+	return
+}
+
+// FindAllStringMatches returns up to count of the Pattern matches present in the
+// input given. When count is less than one, all the matches are returned
+func (p Pattern) FindAllStringMatches(input string, count int) (found []Match) {
+	return findMatches(p, input, count)
+}
+
 // FindAllString returns a slice of strings containing all of the Pattern
 // matches present in the input given, in the order the matches are found
 func (p Pattern) FindAllString(input string, count int) (found []string) {
@@ -139,6 +200,158 @@ func (p Pattern) FindAllStringSubmatchIndex(input string, count int) (found [][]
 	return
 }
 
+// FindAllStringOverlapping is like FindAllString, except that the search for each
+// following match restarts one rune after the start of the previous match
+// instead of at the end of the previous match, so that Text("aa") finds three
+// matches within "aaaa"
+func (p Pattern) FindAllStringOverlapping(input string, count int) (found []string) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			for _, m := range s.stringMatches() {
+				found = append(found, m[0]) // always at least one present
+			}
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindAllStringOverlappingIndex returns a slice of starting and ending indices
+// denoting each of the overlapping Pattern matches present in the input given,
+// see FindAllStringOverlapping
+func (p Pattern) FindAllStringOverlappingIndex(input string, count int) (found [][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			for _, groups := range s.matches {
+				found = append(found, groups[0])
+			}
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindAllStringOverlappingSubmatch returns a slice of all the overlapping Pattern
+// matches (and any sub-matches) present in the input given, see
+// FindAllStringOverlapping
+func (p Pattern) FindAllStringOverlappingSubmatch(input string, count int) (found [][]string) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			found = s.stringMatches()
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindAllStringOverlappingSubmatchIndex returns a slice of starting and ending
+// points for all the overlapping Pattern matches (and any sub-matches)
+// present in the input given, see FindAllStringOverlapping
+func (p Pattern) FindAllStringOverlappingSubmatchIndex(input string, count int) (found [][][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchOverlapping(s, count) {
+			found = s.matches
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindLastString returns the last Pattern match within the input given
+//
+// FindLastString finds the same match as the last of the matches returned by
+// FindAllString with a count of -1, without keeping all the matches before it
+func (p Pattern) FindLastString(input string) string {
+	if mm := p.FindLastStringSubmatch(input); len(mm) > 0 {
+		return mm[0]
+	}
+	return ""
+}
+
+// FindLastStringIndex returns the last Pattern match starting and ending indexes
+// within the input given
+func (p Pattern) FindLastStringIndex(input string) (found [2]int) {
+	if mm := p.FindLastStringSubmatchIndex(input); len(mm) > 0 {
+		return mm[0]
+	}
+	return
+}
+
+// FindLastStringSubmatch returns the last match of this Pattern and any of its
+// sub-matches, or nil if there was no match within the input given
+func (p Pattern) FindLastStringSubmatch(input string) (found []string) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchLast(s) {
+			found = s.stringMatches()[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindLastStringSubmatchIndex returns a slice of starting and ending indices
+// denoting the last match of this Pattern and any of its sub-matches
+func (p Pattern) FindLastStringSubmatchIndex(input string) (found [][2]int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.matchLast(s) {
+			found = s.matches[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// MatchStringAt returns true if this Pattern matches the input exactly at the
+// byte offset given, without searching for a match any further into the input
+//
+// Matchers still see all of the input before the offset, for example:
+// Caret("m") matches at an offset following a newline
+func (p Pattern) MatchStringAt(input string, offset int) (ok bool) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index, s.anchor = offset, true
+		ok = p.match(s, 1)
+		s.matches = nil
+	}
+	return
+}
+
+// FindStringFrom returns the leftmost Pattern match starting at or after the
+// byte offset given
+func (p Pattern) FindStringFrom(input string, offset int) (found string) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index = offset
+		if mm := p.findString(s, 1); len(mm) > 0 {
+			found = mm[0][0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
+// FindStringSubmatchIndexFrom returns a slice of starting and ending indices
+// denoting the leftmost match of this Pattern, and any of its sub-matches,
+// starting at or after the byte offset given. The indices are relative to
+// the start of the input, not the offset
+func (p Pattern) FindStringSubmatchIndexFrom(input string, offset int) (found [][2]int) {
+	if len(p) > 0 && validOffset(input, offset) {
+		s := newPatternState(p, input)
+		s.index = offset
+		if p.match(s, 1) && len(s.matches) > 0 {
+			found = s.matches[0]
+		}
+		s.matches = nil
+	}
+	return
+}
+
 // ReplaceAllString returns a copy of the input string with all Pattern matches
 // replaced with text returned by the given Replace process
 func (p Pattern) ReplaceAllString(input string, replacements Replace[string]) string {
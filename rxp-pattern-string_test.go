@@ -114,6 +114,7 @@ func TestPattern_FindAllStringIndex(t *testing.T) {
 			{input: "", pattern: nil, count: -1, output: [][2]int{{0, 0}}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: 1, output: [][2]int{{0, 1}}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: -1, output: [][2]int{{0, 1}, {1, 2}}},
+			{input: "aaa", pattern: Pattern{}.Dot("{1}", "c"), count: 2, output: [][2]int{{0, 1}, {1, 2}}},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
@@ -167,6 +168,9 @@ func TestPattern_FindAllString(t *testing.T) {
 			{input: "", pattern: nil, count: -1, output: []string(nil)},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: 1, output: []string{"a"}},
 			{input: "aa", pattern: Pattern{}.Dot("{1}", "c"), count: -1, output: []string{"a", "a"}},
+			{input: "aaa", pattern: Pattern{}.Dot("{1}", "c"), count: 2, output: []string{"a", "a"}},
+			{input: "aaaaaa", pattern: Pattern{}.Text("a").Text("a"), count: 2, output: []string{"aa", "aa"}},
+			{input: "aaaaaaaaa", pattern: Pattern{}.Text("a").Text("a").Text("a"), count: 2, output: []string{"aaa", "aaa"}},
 		} {
 			c.SoMsg(
 				fmt.Sprintf("test #%d - %q", idx, test.input),
@@ -210,6 +214,378 @@ func TestPattern_FindAllStringSubmatchIndex(t *testing.T) {
 
 }
 
+func TestPattern_FindAllStringOverlapping(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			count   int
+			output  []string
+			index   [][2]int
+		}{
+
+			{input: "", pattern: nil, count: -1, output: []string(nil), index: [][2]int(nil)},
+			{input: "aaaa", pattern: Pattern{}.Text("aa"), count: -1, output: []string{"aa", "aa", "aa"}, index: [][2]int{{0, 2}, {1, 3}, {2, 4}}},
+			{input: "aaaa", pattern: Pattern{}.Text("aa"), count: 2, output: []string{"aa", "aa"}, index: [][2]int{{0, 2}, {1, 3}}},
+			{input: "aaaa", pattern: Pattern{}.Text("b"), count: -1, output: []string(nil), index: [][2]int(nil)},
+			{input: "gattaca", pattern: Pattern{}.Dot("{3}"), count: -1, output: []string{"gat", "att", "tta", "tac", "aca"}, index: [][2]int{{0, 3}, {1, 4}, {2, 5}, {3, 6}, {4, 7}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringOverlapping(test.input, test.count),
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindAllStringOverlappingIndex(test.input, test.count),
+				c.ShouldEqual,
+				test.index,
+			)
+		}
+
+		input := "abab"
+		pattern := Pattern{}.Dot("c").Dot("c").Dot("c")
+		c.So(pattern.FindAllStringOverlappingSubmatch(input, -1), c.ShouldEqual, [][]string{{"aba", "a", "b", "a"}, {"bab", "b", "a", "b"}})
+		c.So(pattern.FindAllStringOverlappingSubmatchIndex(input, 1), c.ShouldEqual, [][][2]int{{{0, 3}, {0, 1}, {1, 2}, {2, 3}}})
+		c.So(Pattern(nil).FindAllStringOverlappingSubmatch(input, -1), c.ShouldBeNil)
+		c.So(Pattern(nil).FindAllStringOverlappingSubmatchIndex(input, -1), c.ShouldBeNil)
+
+	})
+
+}
+
+func TestPattern_FindLastString(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			output  []string
+			index   [][2]int
+		}{
+
+			{input: "", pattern: nil, output: []string(nil), index: [][2]int(nil)},
+			{input: "abc", pattern: Pattern{}.D("+", "c"), output: []string(nil), index: [][2]int(nil)},
+			{input: "12:00 a 12:30 b 13:45 c", pattern: Pattern{}.D("{2}", "c").Text(":").D("{2}", "c"), output: []string{"13:45", "13", "45"}, index: [][2]int{{16, 21}, {16, 18}, {19, 21}}},
+			{input: "/usr/local/bin", pattern: Pattern{}.Text("/", "c"), output: []string{"/", "/"}, index: [][2]int{{10, 11}, {10, 11}}},
+			{input: "abc 123", pattern: Pattern{}.D("+", "c"), output: []string{"123", "123"}, index: [][2]int{{4, 7}, {4, 7}}},
+			{input: "aaa", pattern: Pattern{}.Text("aa", "c"), output: []string{"aa", "aa"}, index: [][2]int{{0, 2}, {0, 2}}},
+			{input: "aba", pattern: Pattern{}.Text("a").Dot("*"), output: []string{"aba"}, index: [][2]int{{0, 3}}},
+			{input: "é1 ñ22 ü333", pattern: Pattern{}.D("+", "c"), output: []string{"333", "333"}, index: [][2]int{{11, 14}, {11, 14}}},
+			{input: "abc abc", pattern: Pattern{}.A().Text("abc", "c"), output: []string{"abc", "abc"}, index: [][2]int{{0, 3}, {0, 3}}},
+			{input: "ab 12", pattern: Pattern{}.D("*", "c").Z(), output: []string{"12", "12"}, index: [][2]int{{3, 5}, {3, 5}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastStringSubmatch(test.input),
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastStringSubmatchIndex(test.input),
+				c.ShouldEqual,
+				test.index,
+			)
+			var last [2]int
+			if len(test.index) > 0 {
+				last = test.index[0]
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindLastStringIndex(test.input),
+				c.ShouldEqual,
+				last,
+			)
+			if all := test.pattern.FindAllStringIndex(test.input, -1); len(all) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					last,
+					c.ShouldEqual,
+					all[len(all)-1],
+				)
+			}
+			if len(test.output) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					test.pattern.FindLastString(test.input),
+					c.ShouldEqual,
+					test.output[0],
+				)
+			} else {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					test.pattern.FindLastString(test.input),
+					c.ShouldBeEmpty,
+				)
+			}
+		}
+
+	})
+
+}
+
+func TestPattern_MatchStringAt(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			offset  int
+			ok      bool
+			from    string
+			index   [][2]int
+		}{
+
+			{input: "abc", pattern: nil, offset: 0, ok: false, from: "", index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: -1, ok: false, from: "", index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 8, ok: false, from: "", index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 0, ok: true, from: "abc", index: [][2]int{{0, 3}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 1, ok: false, from: "abc", index: [][2]int{{4, 7}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 4, ok: true, from: "abc", index: [][2]int{{4, 7}}},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 5, ok: false, from: "", index: [][2]int(nil)},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), offset: 7, ok: false, from: "", index: [][2]int(nil)},
+			{input: "ab\ncd", pattern: Pattern{}.Caret().W("+", "c"), offset: 3, ok: false, from: "", index: [][2]int(nil)},
+			{input: "ab\ncd", pattern: Pattern{}.Caret("m").W("+", "c"), offset: 3, ok: true, from: "cd", index: [][2]int{{3, 5}, {3, 5}}},
+			{input: "ab\ncd", pattern: Pattern{}.Caret("m").W("+", "c"), offset: 1, ok: false, from: "cd", index: [][2]int{{3, 5}, {3, 5}}},
+			{input: "xy zy", pattern: Pattern{}.B().Text("y"), offset: 1, ok: false, from: "", index: [][2]int(nil)},
+			{input: "abab", pattern: Pattern{}.Text("ab").Z(), offset: 1, ok: false, from: "ab", index: [][2]int{{2, 4}}},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.MatchStringAt(test.input, test.offset),
+				c.ShouldEqual,
+				test.ok,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindStringFrom(test.input, test.offset),
+				c.ShouldEqual,
+				test.from,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindStringSubmatchIndexFrom(test.input, test.offset),
+				c.ShouldEqual,
+				test.index,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_FullMatchString(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			full    bool
+			prefix  bool
+			suffix  bool
+		}{
+
+			{input: "", pattern: nil, full: false, prefix: false, suffix: false},
+			{input: "", pattern: Pattern{}.D("*"), full: true, prefix: true, suffix: true},
+			{input: "123", pattern: Pattern{}.D("+"), full: true, prefix: true, suffix: true},
+			{input: "123abc", pattern: Pattern{}.D("+"), full: false, prefix: true, suffix: false},
+			{input: "abc123", pattern: Pattern{}.D("+"), full: false, prefix: false, suffix: true},
+			{input: "abc123abc", pattern: Pattern{}.D("+"), full: false, prefix: false, suffix: false},
+			{input: "abc abc", pattern: Pattern{}.Text("abc"), full: false, prefix: true, suffix: true},
+			{input: "ABC", pattern: Pattern{}.Text("abc", "i"), full: true, prefix: true, suffix: true},
+			{input: "ab\ncd", pattern: Pattern{}.W("+", "c"), full: false, prefix: true, suffix: true},
+			{input: "ab\ncd", pattern: Pattern{}.Dot("+", "s"), full: true, prefix: true, suffix: true},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FullMatchString(test.input),
+				c.ShouldEqual,
+				test.full,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.HasPrefixMatchString(test.input),
+				c.ShouldEqual,
+				test.prefix,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.HasSuffixMatchString(test.input),
+				c.ShouldEqual,
+				test.suffix,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_CountString(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			limit   int
+			count   int
+		}{
+
+			{input: "", pattern: nil, limit: -1, count: 0},
+			{input: "", pattern: Pattern{}.D("+"), limit: -1, count: 0},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), limit: -1, count: 3},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), limit: 0, count: 3},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), limit: 2, count: 2},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), limit: 5, count: 3},
+			{input: "a1 b22 c333", pattern: Pattern{}.D(), limit: -1, count: 6},
+			{input: "aaaa", pattern: Pattern{}.Text("aa"), limit: -1, count: 2},
+			{input: "one two three", pattern: Pattern{}.W("+", "c"), limit: -1, count: 3},
+		} {
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.CountString(test.input, test.limit),
+				c.ShouldEqual,
+				test.count,
+			)
+			if len(test.pattern) > 0 {
+				c.SoMsg(
+					fmt.Sprintf("test #%d - %q", idx, test.input),
+					test.pattern.CountString(test.input, test.limit),
+					c.ShouldEqual,
+					len(test.pattern.FindAllStringIndex(test.input, test.limit)),
+				)
+			}
+		}
+
+	})
+
+	c.Convey("allocations do not grow with the matches", t, func() {
+		input := strings.Repeat("ab 12 ", 1000)
+		p := Pattern{}.D("+")
+		c.So(p.CountString(input, -1), c.ShouldEqual, 1000)
+		c.So(testing.AllocsPerRun(10, func() { p.CountString(input, -1) }), c.ShouldBeLessThan, 10)
+	})
+
+}
+
+func TestPattern_EachStringMatch(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			stop    int
+			output  []string
+			index   [][2]int
+		}{
+
+			{input: "", pattern: nil, stop: -1, output: []string(nil), index: [][2]int(nil)},
+			{input: "", pattern: Pattern{}.D("+"), stop: -1, output: []string(nil), index: [][2]int(nil)},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), stop: -1, output: []string{"1", "22", "333"}, index: [][2]int{{1, 2}, {4, 6}, {8, 11}}},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), stop: 1, output: []string{"1"}, index: [][2]int{{1, 2}}},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), stop: 2, output: []string{"1", "22"}, index: [][2]int{{1, 2}, {4, 6}}},
+			{input: "one two", pattern: Pattern{}.W("+", "c"), stop: 5, output: []string{"one", "two"}, index: [][2]int{{0, 3}, {4, 7}}},
+		} {
+			var output []string
+			var index [][2]int
+			collect := func(m Match) bool {
+				output = append(output, m.Text())
+				index = append(index, [2]int{m.Start(), m.End()})
+				return len(output) != test.stop
+			}
+
+			test.pattern.EachStringMatch(test.input, collect)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				index,
+				c.ShouldEqual,
+				test.index,
+			)
+
+			output, index = nil, nil
+			test.pattern.StringMatchSeq(test.input)(collect)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+		}
+
+	})
+
+}
+
+func TestPattern_FindAllStringMatches(t *testing.T) {
+
+	c.Convey("batch", t, func() {
+
+		for idx, test := range []struct {
+			input   string
+			pattern Pattern
+			count   int
+			output  []string
+		}{
+
+			{input: "", pattern: nil, count: -1, output: []string(nil)},
+			{input: "", pattern: Pattern{}.D("+"), count: -1, output: []string(nil)},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), count: -1, output: []string{"1", "22", "333"}},
+			{input: "a1 b22 c333", pattern: Pattern{}.D("+"), count: 2, output: []string{"1", "22"}},
+			{input: "a1 b22 c333", pattern: Pattern{}.Alpha("+", "c").D("+", "c"), count: -1, output: []string{"a1", "b22", "c333"}},
+		} {
+			var output []string
+			var index [][][2]int
+			for _, m := range test.pattern.FindAllStringMatches(test.input, test.count) {
+				output = append(output, m.Text())
+				var groups [][2]int
+				for n := 0; n < m.Len(); n++ {
+					group, _ := m.Index(n)
+					groups = append(groups, group)
+				}
+				index = append(index, groups)
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				output,
+				c.ShouldEqual,
+				test.output,
+			)
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				index,
+				c.ShouldEqual,
+				test.pattern.FindAllStringSubmatchIndex(test.input, test.count),
+			)
+			var first string
+			if len(test.output) > 0 {
+				first = test.output[0]
+			}
+			c.SoMsg(
+				fmt.Sprintf("test #%d - %q", idx, test.input),
+				test.pattern.FindStringMatch(test.input).Text(),
+				c.ShouldEqual,
+				first,
+			)
+		}
+
+	})
+
+}
+
 func TestPattern_ReplaceAllString(t *testing.T) {
 
 	c.Convey("batch", t, func() {
@@ -473,3 +849,18 @@ func TestPattern_SplitString(t *testing.T) {
 	})
 
 }
+
+func TestPattern_MatchStringAt_Offsets(t *testing.T) {
+
+	c.Convey("offsets are bytes for strings and runes for []rune", t, func() {
+		pattern := Pattern{}.Text("b")
+		c.So(pattern.MatchStringAt("éb", 2), c.ShouldBeTrue)
+		c.So(pattern.MatchStringAt("éb", 1), c.ShouldBeFalse)
+		c.So(pattern.FindStringSubmatchIndexFrom("éb", 1), c.ShouldBeNil)
+		c.So(pattern.MatchBytesAt([]byte("éb"), 2), c.ShouldBeTrue)
+		c.So(pattern.MatchBytesAt([]byte("éb"), 1), c.ShouldBeFalse)
+		c.So(pattern.MatchRunesAt([]rune("éb"), 1), c.ShouldBeTrue)
+		c.So(pattern.FindRunesSubmatchIndexFrom([]rune("éb"), 0), c.ShouldEqual, [][2]int{{1, 2}})
+	})
+
+}
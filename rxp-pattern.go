@@ -14,16 +14,41 @@
 
 package rxp
 
+import (
+	"unicode/utf8"
+)
+
 // Pattern is a list of Matcher functions, all of which must match, in the
 // order present, in order to consider the Pattern to match
 type Pattern []Matcher
 
 type cPatternState struct {
-	input   *InputReader // input rune buffer
-	index   int          // current match position (total runes consumed)
-	pattern Pattern      // list of fragments to satisfy as a match
-	capture []bool       // denotes corresponding matches are capture groups or not
-	matches [][][2]int   // list of matches (with matched capture groups)
+	input   *InputReader            // input rune buffer
+	index   int                     // current match position (total runes consumed)
+	pattern Pattern                 // list of fragments to satisfy as a match
+	capture []bool                  // denotes corresponding matches are capture groups or not
+	matches [][][2]int              // list of matches (with matched capture groups)
+	total   int                     // number of matches found
+	anchor  bool                    // only match at the starting index
+	discard bool                    // only count the matches found
+	yield   func(set [][2]int) bool // receives each match instead of keeping them
+	stop    bool                    // yield requested the end of the scan
+	names   []string                // names of the capture groups, see Named
+}
+
+// validOffset returns true if the offset given is within the input and, for
+// string and []byte inputs, is the start of a rune
+func validOffset[V []rune | []byte | string](input V, offset int) bool {
+	if offset < 0 || offset > len(input) {
+		return false
+	}
+	switch t := interface{}(input).(type) {
+	case string:
+		return offset == len(t) || utf8.RuneStart(t[offset])
+	case []byte:
+		return offset == len(t) || utf8.RuneStart(t[offset])
+	}
+	return true
 }
 
 func newPatternState[V []rune | []byte | string](p Pattern, input V) *cPatternState {
@@ -35,12 +60,49 @@ func newPatternState[V []rune | []byte | string](p Pattern, input V) *cPatternSt
 	}
 }
 
+// push adds the match given to the list of matches, ignoring the match when
+// only counting or passing the match along to the yield function
+func (s *cPatternState) push(set [][2]int) {
+	s.total += 1
+	if s.yield != nil {
+		s.stop = !s.yield(set)
+		return
+	} else if s.discard {
+		return
+	}
+	s.matches = pushMatch(s.matches, set)
+}
+
+// name records the capture name of the scoping Flags given as the name of
+// the capture group n, the capture groups are always in the same order so
+// the names found during any one match attempt are the names of all matches
+func (s *cPatternState) name(n int, scoping Flags) {
+	if n <= len(s.names) && s.names[n-1] != "" {
+		// already named
+		return
+	}
+	for len(s.names) < n {
+		s.names = append(s.names, "")
+	}
+	s.names[n-1] = captureName(scoping)
+}
+
+// reset returns an empty set of sub-matches for the next match attempt,
+// reusing the set given when the matches are only counted
+func (s *cPatternState) reset(set [][2]int) [][2]int {
+	if s.discard {
+		set[0] = [2]int{}
+		return set[:1]
+	}
+	return [][2]int{{}}
+}
+
 // match returns true if the state can process the Pattern at least count times
 //
 //gocyclo:ignore
 func (p Pattern) match(s *cPatternState, count int) (matched bool) {
 
-	var lastInputIndex int     // track Matcher progress
+	lastInputIndex := s.index  // track Matcher progress
 	var totalCompleted int     // track completed Matcher count
 	required := len(s.pattern) // completed requirement
 
@@ -62,6 +124,9 @@ func (p Pattern) match(s *cPatternState, count int) (matched bool) {
 			if scoping, keep, proceed := matcher(DefaultFlags, gDefaultReps, s.input, s.index, set); proceed {
 				if scoping.Capture() {
 					set = pushSubMatch(set, [2]int{s.index, clamp(s.index+keep, s.input.len)})
+					if scoping&gCaptureNameMask != 0 {
+						s.name(len(set)-1, scoping)
+					}
 				}
 				if keep > 0 {
 					consumed += keep
@@ -82,7 +147,7 @@ func (p Pattern) match(s *cPatternState, count int) (matched bool) {
 			// pattern did not match correctly
 			totalCompleted = 0
 			if len(set) > 1 {
-				set = [][2]int{{}}
+				set = s.reset(set)
 			}
 			break
 		}
@@ -90,23 +155,32 @@ func (p Pattern) match(s *cPatternState, count int) (matched bool) {
 		if totalCompleted >= required {
 			if start < s.index {
 				set[0][0], set[0][1] = start, clamp(s.index, s.input.len)
-				s.matches = pushMatch(s.matches, set)
-				set = [][2]int{{}}
+				s.push(set)
+				set = s.reset(set)
 			} else if atLeastZero > 0 && 0 <= s.index && s.index <= s.input.len {
 				set[0][0], set[0][1] = start, start
-				s.matches = pushMatch(s.matches, set)
-				set = [][2]int{{}}
+				s.push(set)
+				set = s.reset(set)
+			}
+			if s.stop {
+				// early out, yield does not want any more matches
+				return true
 			}
 			if count > 0 {
-				if count >= totalCompleted {
-					// early out, count is the requested total number of subMatches
+				if s.total >= count {
+					// early out, count is the requested total number of matches
 					return true
 				}
 			} else if 0 > s.index || s.index >= s.input.len {
-				return len(s.matches) > 0
+				return s.total > 0
 			}
 		}
 
+		if s.anchor {
+			// only the one attempt at the starting index
+			return s.total > 0
+		}
+
 		if lastInputIndex == s.index {
 			// pattern did not advance the index
 			if _, size, ok := s.input.Get(s.index); ok && size > 0 {
@@ -119,5 +193,104 @@ func (p Pattern) match(s *cPatternState, count int) (matched bool) {
 
 	}
 
+	return s.total > 0
+}
+
+// matchOverlapping is like match, except that the search for each following
+// match restarts one rune after the start of the previous match instead of
+// at the end of the previous match
+func (p Pattern) matchOverlapping(s *cPatternState, count int) (matched bool) {
+	var matches [][][2]int
+	for 0 <= s.index && s.index <= s.input.len {
+		if count > 0 && len(matches) >= count {
+			break
+		}
+
+		s.matches, s.total = [][][2]int{}, 0
+		if !p.match(s, 1) || len(s.matches) == 0 {
+			break
+		}
+		matches = append(matches, s.matches[0])
+
+		start := s.matches[0][0][0]
+		if _, size, ok := s.input.Get(start); ok && size > 0 {
+			s.index = start + size // move the needle correctly
+		} else {
+			s.index = start + 1 // must move the needle to progress
+		}
+	}
+	s.matches, s.total = matches, len(matches)
 	return len(s.matches) > 0
 }
+
+// matchLast scans the input forwards the same as match, keeping only a copy
+// of the last match found so that the cost does not grow with the number of
+// matches
+func (p Pattern) matchLast(s *cPatternState) (matched bool) {
+	var last [][2]int
+	s.discard = true
+	s.yield = func(set [][2]int) bool {
+		last = append(last[:0], set...)
+		return true
+	}
+	if matched = p.match(s, -1); matched {
+		s.matches = [][][2]int{last}
+	}
+	return
+}
+
+// matchAnchored returns true if the Pattern matches the input given, where
+// start anchors the match to the start of the input, making only the one
+// attempt, and end requires the match to end at the end of the input
+func matchAnchored[V []rune | []byte | string](p Pattern, input V, start, end bool) (ok bool) {
+	if len(p) > 0 {
+		if end {
+			p = append(p[:len(p):len(p)], Z())
+		}
+		s := newPatternState(p, input)
+		s.anchor = start
+		ok = p.match(s, 1)
+		s.matches = nil
+	}
+	return
+}
+
+// countMatches returns the number of matches of the Pattern in the input
+// given, stopping once the limit is reached when the limit is greater than
+// zero
+func countMatches[V []rune | []byte | string](p Pattern, input V, limit int) (count int) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		s.discard = true
+		p.match(s, limit)
+		count = s.total
+	}
+	return
+}
+
+// eachMatch calls fn with each of the matches of the Pattern in the input
+// given, as the matches are found, stopping when fn returns false
+func eachMatch[V []rune | []byte | string](p Pattern, input V, fn func(m Match) bool) {
+	if len(p) > 0 && fn != nil {
+		s := newPatternState(p, input)
+		s.yield = func(set [][2]int) bool {
+			return fn(Match{input: s.input, groups: set, names: s.names})
+		}
+		p.match(s, -1)
+	}
+}
+
+// findMatches returns up to count of the matches of the Pattern in the input
+// given
+func findMatches[V []rune | []byte | string](p Pattern, input V, count int) (found []Match) {
+	if len(p) > 0 {
+		s := newPatternState(p, input)
+		if p.match(s, count) {
+			for _, groups := range s.matches {
+				found = append(found, Match{input: s.input, groups: groups, names: s.names})
+			}
+		}
+		s.matches = nil
+	}
+	return
+}
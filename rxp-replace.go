@@ -122,3 +122,77 @@ func (r Replace[V]) ToUpper() Replace[V] {
 		}
 	})
 }
+
+// ToLowerLocale is a convenience method which is the same as ToLower except
+// that the case mappings are tailored for the language tag given, using one
+// of the following methods:
+//
+//	| Type   | Function                    |
+//	|--------|-----------------------------|
+//	| []rune | unicode.SpecialCase.ToLower |
+//	| []byte | bytes.ToLowerSpecial        |
+//	| string | strings.ToLowerSpecial      |
+//
+// Languages without tailored case mappings are the same as ToLower, see
+// LookupSpecialCase for the supported languages
+func (r Replace[V]) ToLowerLocale(tag string) Replace[V] {
+	special, ok := LookupSpecialCase(tag)
+	if !ok {
+		return r.ToLower()
+	}
+	return append(r, func(input *InputReader, captured [][2]int, data V) (replaced V) {
+		switch t := interface{}(&data).(type) {
+		case *[]rune:
+			slice := make([]rune, len(*t))
+			for idx, ch := range *t {
+				slice[idx] = special.ToLower(ch)
+			}
+			return interface{}(slice).(V)
+		case *[]byte:
+			slice := bytes.ToLowerSpecial(special, *t)
+			return interface{}(slice).(V)
+		case *string:
+			slice := strings.ToLowerSpecial(special, *t)
+			return interface{}(slice).(V)
+		default:
+			panic("the universe is broken")
+		}
+	})
+}
+
+// ToUpperLocale is a convenience method which is the same as ToUpper except
+// that the case mappings are tailored for the language tag given, using one
+// of the following methods:
+//
+//	| Type   | Function                    |
+//	|--------|-----------------------------|
+//	| []rune | unicode.SpecialCase.ToUpper |
+//	| []byte | bytes.ToUpperSpecial        |
+//	| string | strings.ToUpperSpecial      |
+//
+// Languages without tailored case mappings are the same as ToUpper, see
+// LookupSpecialCase for the supported languages
+func (r Replace[V]) ToUpperLocale(tag string) Replace[V] {
+	special, ok := LookupSpecialCase(tag)
+	if !ok {
+		return r.ToUpper()
+	}
+	return append(r, func(input *InputReader, captured [][2]int, data V) (replaced V) {
+		switch t := interface{}(&data).(type) {
+		case *[]rune:
+			slice := make([]rune, len(*t))
+			for idx, ch := range *t {
+				slice[idx] = special.ToUpper(ch)
+			}
+			return interface{}(slice).(V)
+		case *[]byte:
+			slice := bytes.ToUpperSpecial(special, *t)
+			return interface{}(slice).(V)
+		case *string:
+			slice := strings.ToUpperSpecial(special, *t)
+			return interface{}(slice).(V)
+		default:
+			panic("the universe is broken")
+		}
+	})
+}
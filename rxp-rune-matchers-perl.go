@@ -14,6 +14,11 @@
 
 package rxp
 
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
 // RuneIsWord returns true for word characters [_a-zA-Z0-9]
 func RuneIsWord(r rune) bool {
 	return r == '_' ||
@@ -26,3 +31,45 @@ func RuneIsWord(r rune) bool {
 func RuneIsSpace(r rune) bool {
 	return r == '\t' || r == '\n' || r == '\f' || r == '\r' || r == ' '
 }
+
+// RuneIsUnicodeWord returns true for Unicode word characters, which are the
+// letters, marks, numbers and connector punctuation [\pL\pM\pN\p{Pc}]
+func RuneIsUnicodeWord(r rune) bool {
+	if r < utf8.RuneSelf {
+		return RuneIsWord(r)
+	}
+	return unicode.In(r, unicode.L, unicode.M, unicode.N, unicode.Pc)
+}
+
+// RuneIsUnicodeSpace returns true for Unicode white space characters
+// [\p{White_Space}]
+func RuneIsUnicodeSpace(r rune) bool {
+	if r < utf8.RuneSelf {
+		return RuneIsSpace(r) || r == '\v'
+	}
+	return unicode.Is(unicode.White_Space, r)
+}
+
+// RuneIsUnicodeDigit returns true for Unicode decimal digits [\p{Nd}]
+func RuneIsUnicodeDigit(r rune) bool {
+	if r < utf8.RuneSelf {
+		return RuneIsDIGIT(r)
+	}
+	return unicode.Is(unicode.Nd, r)
+}
+
+// RuneIsHorizontalSpace returns true for horizontal white space characters
+// [\t\x{A0}\x{1680}\x{180E}\x{2000}-\x{200A}\x{202F}\x{205F}\x{3000} ]
+func RuneIsHorizontalSpace(r rune) bool {
+	switch r {
+	case '\t', ' ', 0xa0, 0x1680, 0x180e, 0x202f, 0x205f, 0x3000:
+		return true
+	}
+	return 0x2000 <= r && r <= 0x200a
+}
+
+// RuneIsVerticalSpace returns true for vertical white space characters
+// [\n\v\f\r\x{85}\x{2028}\x{2029}]
+func RuneIsVerticalSpace(r rune) bool {
+	return ('\n' <= r && r <= '\r') || r == 0x85 || r == 0x2028 || r == 0x2029
+}
@@ -0,0 +1,286 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"unicode"
+)
+
+// cGraphemeBreak is the UAX #29 Grapheme_Cluster_Break property value
+type cGraphemeBreak uint8
+
+const (
+	gbOther cGraphemeBreak = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+)
+
+// gExtendedPictographic is the Extended_Pictographic property from the
+// Unicode emoji-data.txt file, which the unicode package does not provide
+var gExtendedPictographic = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x00a9, 0x00a9, 1}, {0x00ae, 0x00ae, 1}, {0x203c, 0x203c, 1},
+		{0x2049, 0x2049, 1}, {0x2122, 0x2122, 1}, {0x2139, 0x2139, 1},
+		{0x2194, 0x2199, 1}, {0x21a9, 0x21aa, 1}, {0x231a, 0x231b, 1},
+		{0x2328, 0x2328, 1}, {0x2388, 0x2388, 1}, {0x23cf, 0x23cf, 1},
+		{0x23e9, 0x23f3, 1}, {0x23f8, 0x23fa, 1}, {0x24c2, 0x24c2, 1},
+		{0x25aa, 0x25ab, 1}, {0x25b6, 0x25b6, 1}, {0x25c0, 0x25c0, 1},
+		{0x25fb, 0x25fe, 1}, {0x2600, 0x2605, 1}, {0x2607, 0x2612, 1},
+		{0x2614, 0x2685, 1}, {0x2690, 0x2705, 1}, {0x2708, 0x2712, 1},
+		{0x2714, 0x2714, 1}, {0x2716, 0x2716, 1}, {0x271d, 0x271d, 1},
+		{0x2721, 0x2721, 1}, {0x2728, 0x2728, 1}, {0x2733, 0x2734, 1},
+		{0x2744, 0x2744, 1}, {0x2747, 0x2747, 1}, {0x274c, 0x274c, 1},
+		{0x274e, 0x274e, 1}, {0x2753, 0x2755, 1}, {0x2757, 0x2757, 1},
+		{0x2763, 0x2767, 1}, {0x2795, 0x2797, 1}, {0x27a1, 0x27a1, 1},
+		{0x27b0, 0x27b0, 1}, {0x27bf, 0x27bf, 1}, {0x2934, 0x2935, 1},
+		{0x2b05, 0x2b07, 1}, {0x2b1b, 0x2b1c, 1}, {0x2b50, 0x2b50, 1},
+		{0x2b55, 0x2b55, 1}, {0x3030, 0x3030, 1}, {0x303d, 0x303d, 1},
+		{0x3297, 0x3297, 1}, {0x3299, 0x3299, 1},
+	},
+	R32: []unicode.Range32{
+		{0x1f000, 0x1f0ff, 1}, {0x1f10d, 0x1f10f, 1}, {0x1f12f, 0x1f12f, 1},
+		{0x1f16c, 0x1f171, 1}, {0x1f17e, 0x1f17f, 1}, {0x1f18e, 0x1f18e, 1},
+		{0x1f191, 0x1f19a, 1}, {0x1f1ad, 0x1f1e5, 1}, {0x1f201, 0x1f20f, 1},
+		{0x1f21a, 0x1f21a, 1}, {0x1f22f, 0x1f22f, 1}, {0x1f232, 0x1f23a, 1},
+		{0x1f23c, 0x1f23f, 1}, {0x1f249, 0x1f3fa, 1}, {0x1f400, 0x1f53d, 1},
+		{0x1f546, 0x1f64f, 1}, {0x1f680, 0x1f6ff, 1}, {0x1f774, 0x1f77f, 1},
+		{0x1f7d5, 0x1f7ff, 1}, {0x1f80c, 0x1f80f, 1}, {0x1f848, 0x1f84f, 1},
+		{0x1f85a, 0x1f85f, 1}, {0x1f888, 0x1f88f, 1}, {0x1f8ae, 0x1f8ff, 1},
+		{0x1f90c, 0x1f93a, 1}, {0x1f93c, 0x1f945, 1}, {0x1f947, 0x1faff, 1},
+		{0x1fc00, 0x1fffd, 1},
+	},
+}
+
+// gGraphemePrepend is the Grapheme_Cluster_Break=Prepend property
+var gGraphemePrepend = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x0600, 0x0605, 1}, {0x06dd, 0x06dd, 1}, {0x070f, 0x070f, 1},
+		{0x0890, 0x0891, 1}, {0x08e2, 0x08e2, 1}, {0x0d4e, 0x0d4e, 1},
+	},
+	R32: []unicode.Range32{
+		{0x110bd, 0x110bd, 1}, {0x110cd, 0x110cd, 1}, {0x111c2, 0x111c3, 1},
+		{0x1193f, 0x1193f, 1}, {0x11941, 0x11941, 1}, {0x11a3a, 0x11a3a, 1},
+		{0x11a84, 0x11a89, 1}, {0x11d46, 0x11d46, 1}, {0x11f02, 0x11f02, 1},
+	},
+}
+
+// gGraphemeNotSpacingMark lists the general category Mc runes which UAX #29
+// excludes from the Grapheme_Cluster_Break=SpacingMark property
+var gGraphemeNotSpacingMark = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x102b, 0x102c, 1}, {0x1038, 0x1038, 1}, {0x1062, 0x1064, 1},
+		{0x1067, 0x106d, 1}, {0x1083, 0x1083, 1}, {0x1087, 0x108c, 1},
+		{0x108f, 0x108f, 1}, {0x109a, 0x109c, 1}, {0x1a61, 0x1a61, 1},
+		{0x1a63, 0x1a64, 1}, {0xaa7b, 0xaa7b, 1}, {0xaa7d, 0xaa7d, 1},
+	},
+	R32: []unicode.Range32{
+		{0x11720, 0x11721, 1},
+	},
+}
+
+// RuneIsExtendedPictographic returns true for runes with the Unicode
+// Extended_Pictographic property
+func RuneIsExtendedPictographic(r rune) bool {
+	return r >= 0xa9 && unicode.Is(gExtendedPictographic, r)
+}
+
+// graphemeBreakProperty returns the Grapheme_Cluster_Break property of r
+func graphemeBreakProperty(r rune) cGraphemeBreak {
+	switch {
+	case r == '\r':
+		return gbCR
+	case r == '\n':
+		return gbLF
+	case r < 0x20 || r == 0x7f:
+		return gbControl
+	case r < 0xa0:
+		// the remaining ASCII, and the C1 controls
+		if r >= 0x80 {
+			return gbControl
+		}
+		return gbOther
+	case r == 0x200d:
+		return gbZWJ
+	case 0x1100 <= r && r <= 0x115f, 0xa960 <= r && r <= 0xa97c:
+		return gbL
+	case 0x1160 <= r && r <= 0x11a7, 0xd7b0 <= r && r <= 0xd7c6:
+		return gbV
+	case 0x11a8 <= r && r <= 0x11ff, 0xd7cb <= r && r <= 0xd7fb:
+		return gbT
+	case 0xac00 <= r && r <= 0xd7a3:
+		if (r-0xac00)%28 == 0 {
+			return gbLV
+		}
+		return gbLVT
+	case 0x1f1e6 <= r && r <= 0x1f1ff:
+		return gbRegionalIndicator
+	case 0x1f3fb <= r && r <= 0x1f3ff:
+		// emoji modifiers are Extend
+		return gbExtend
+	case unicode.In(r, unicode.Mn, unicode.Me, unicode.Other_Grapheme_Extend):
+		return gbExtend
+	case unicode.Is(gGraphemePrepend, r):
+		return gbPrepend
+	case r == 0x0e33 || r == 0x0eb3:
+		return gbSpacingMark
+	case unicode.Is(unicode.Mc, r):
+		if unicode.Is(gGraphemeNotSpacingMark, r) {
+			return gbOther
+		}
+		return gbSpacingMark
+	case unicode.In(r, unicode.Zl, unicode.Zp, unicode.Cf):
+		return gbControl
+	}
+	return gbOther
+}
+
+// graphemeBreakBetween returns true if the UAX #29 grapheme cluster rules
+// allow a break between the prev and next property values, the pictographic
+// argument is true when the runes before next are an Extended_Pictographic
+// followed by any Extend and one ZWJ (GB11) and the regional argument is the
+// count of consecutive Regional_Indicator runes before next (GB12 and GB13)
+func graphemeBreakBetween(prev, next cGraphemeBreak, pictographic bool, regional int) bool {
+	switch {
+	case prev == gbCR && next == gbLF: // GB3
+		return false
+	case prev == gbControl || prev == gbCR || prev == gbLF: // GB4
+		return true
+	case next == gbControl || next == gbCR || next == gbLF: // GB5
+		return true
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && next == gbT: // GB8
+		return false
+	case next == gbExtend || next == gbZWJ: // GB9
+		return false
+	case next == gbSpacingMark: // GB9a
+		return false
+	case prev == gbPrepend: // GB9b
+		return false
+	case pictographic: // GB11
+		return false
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator: // GB12, GB13
+		return regional%2 == 0
+	}
+	return true // GB999
+}
+
+// graphemeClusterSize returns the number of input positions (bytes or runes,
+// depending on the InputReader source) of the extended grapheme cluster
+// starting at the given index, or zero if the index is not Ready
+func graphemeClusterSize(input *InputReader, index int) (size int) {
+	r, rs, ok := input.Get(index)
+	if !ok {
+		return 0
+	}
+
+	size = rs
+	prev := graphemeBreakProperty(r)
+	extPict := RuneIsExtendedPictographic(r) // inside ExtPict Extend*
+	var zwjPict bool                         // ExtPict Extend* ZWJ
+	var regional int
+	if prev == gbRegionalIndicator {
+		regional = 1
+	}
+
+	for {
+		nr, ns, nok := input.Get(index + size)
+		if !nok {
+			return
+		}
+		next := graphemeBreakProperty(nr)
+		nextPict := RuneIsExtendedPictographic(nr)
+		if graphemeBreakBetween(prev, next, zwjPict && nextPict, regional) {
+			return
+		}
+
+		size += ns
+
+		switch {
+		case nextPict:
+			extPict, zwjPict = true, false
+		case next == gbExtend && extPict:
+			zwjPict = false
+		case next == gbZWJ && extPict:
+			extPict, zwjPict = false, true
+		default:
+			extPict, zwjPict = false, false
+		}
+		if next == gbRegionalIndicator {
+			regional += 1
+		} else {
+			regional = 0
+		}
+		prev = next
+	}
+}
+
+// graphemeBoundary returns true if the index given is at the start or the
+// end of the input, or between two extended grapheme clusters
+func graphemeBoundary(input *InputReader, index int) bool {
+	nr, _, ok := input.Get(index)
+	if !ok {
+		return true
+	}
+	pr, ps, ok := input.Prev(index)
+	if !ok {
+		return true
+	}
+	prev, next := graphemeBreakProperty(pr), graphemeBreakProperty(nr)
+
+	var pictographic bool
+	if prev == gbZWJ && RuneIsExtendedPictographic(nr) {
+		// GB11 looks back past the ZWJ and any Extend for an ExtPict
+		for idx := index - ps; idx > 0; {
+			r, rs, present := input.Prev(idx)
+			if !present {
+				break
+			} else if RuneIsExtendedPictographic(r) {
+				pictographic = true
+				break
+			} else if graphemeBreakProperty(r) != gbExtend {
+				break
+			}
+			idx -= rs
+		}
+	}
+
+	var regional int
+	if prev == gbRegionalIndicator && next == gbRegionalIndicator {
+		// GB12 and GB13 count the Regional_Indicator runes before the index
+		for idx := index; idx > 0; regional++ {
+			r, rs, present := input.Prev(idx)
+			if !present || graphemeBreakProperty(r) != gbRegionalIndicator {
+				break
+			}
+			idx -= rs
+		}
+	}
+
+	return graphemeBreakBetween(prev, next, pictographic, regional)
+}
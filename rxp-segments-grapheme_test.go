@@ -0,0 +1,92 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSegmentsGrapheme(t *testing.T) {
+	c.Convey("graphemeClusterSize", t, func() {
+
+		for idx, test := range []struct {
+			input  string
+			output []string
+		}{
+			{"", nil},
+			{"abc", []string{"a", "b", "c"}},
+			{"\r\n\n\r", []string{"\r\n", "\n", "\r"}},
+			{"e\u0301\u0302x", []string{"e\u0301\u0302", "x"}},
+			// hangul L V T and precomposed LV T
+			{"각각", []string{"각", "각"}},
+			// three regional indicators pair up from the left
+			{"\U0001F1EF\U0001F1F5\U0001F1FA", []string{"\U0001F1EF\U0001F1F5", "\U0001F1FA"}},
+			// emoji modifier and zwj sequences
+			{"\U0001F44D\U0001F3FD!", []string{"\U0001F44D\U0001F3FD", "!"}},
+			{"\U0001F3F3\ufe0f\u200d\U0001F308", []string{"\U0001F3F3\ufe0f\u200d\U0001F308"}},
+			// zwj without a preceding pictograph does not join
+			{"a\u200d\U0001F308", []string{"a\u200d", "\U0001F308"}},
+			// prepend and spacing marks
+			{"\u0600١", []string{"\u0600١"}},
+			{"कि", []string{"कि"}},
+		} {
+			input := NewInputReader(test.input)
+			var output []string
+			for index := 0; index < input.Len(); {
+				size := graphemeClusterSize(input, index)
+				output = append(output, test.input[index:index+size])
+				index += size
+			}
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), output, c.ShouldEqual, test.output)
+		}
+
+		c.So(graphemeClusterSize(NewInputReader("abc"), 3), c.ShouldEqual, 0)
+		c.So(graphemeClusterSize(NewInputReader([]rune("e\u0301")), 0), c.ShouldEqual, 2)
+		c.So(RuneIsExtendedPictographic('a'), c.ShouldBeFalse)
+		c.So(RuneIsExtendedPictographic(0x1F600), c.ShouldBeTrue)
+	})
+	c.Convey("graphemeBoundary", t, func() {
+
+		for idx, test := range []struct {
+			input  string
+			output []int
+		}{
+			{"", []int{0}},
+			{"ab", []int{0, 1, 2}},
+			{"\r\na", []int{0, 2, 3}},
+			{"e\u0301x", []int{0, 3, 4}},
+			{"\U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8", []int{0, 8, 16}},
+			{"\U0001F3F3\ufe0f\u200d\U0001F308", []int{0, 14}},
+			{"a\u200d\U0001F308", []int{0, 4, 8}},
+		} {
+			input := NewInputReader(test.input)
+			var output []int
+			for index := 0; ; {
+				if graphemeBoundary(input, index) {
+					output = append(output, index)
+				}
+				_, size, ok := input.Get(index)
+				if !ok {
+					break
+				}
+				index += size
+			}
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), output, c.ShouldEqual, test.output)
+		}
+	})
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"unicode"
+)
+
+// cSentenceBreak is the UAX #29 Sentence_Break property value
+type cSentenceBreak uint8
+
+const (
+	sbOther cSentenceBreak = iota
+	sbCR
+	sbLF
+	sbSep
+	sbExtend
+	sbFormat
+	sbSp
+	sbLower
+	sbUpper
+	sbOLetter
+	sbNumeric
+	sbATerm
+	sbSTerm
+	sbSContinue
+	sbClose
+)
+
+// sentenceBreakProperty returns the Sentence_Break property of r
+func sentenceBreakProperty(r rune) cSentenceBreak {
+	if r < 0x80 {
+		switch {
+		case r == '\r':
+			return sbCR
+		case r == '\n':
+			return sbLF
+		case r == ' ' || r == '\t' || r == '\v' || r == '\f':
+			return sbSp
+		case r == '.':
+			return sbATerm
+		case r == '!' || r == '?':
+			return sbSTerm
+		case r == ',' || r == '-' || r == ':' || r == ';':
+			return sbSContinue
+		case r == '"' || r == '\'' || r == '(' || r == ')' || r == '[' || r == ']' || r == '{' || r == '}':
+			return sbClose
+		case '0' <= r && r <= '9':
+			return sbNumeric
+		case 'a' <= r && r <= 'z':
+			return sbLower
+		case 'A' <= r && r <= 'Z':
+			return sbUpper
+		}
+		return sbOther
+	}
+
+	switch r {
+	case 0x0085, 0x2028, 0x2029:
+		return sbSep
+	case 0x2024, 0xfe52, 0xff0e:
+		return sbATerm
+	case 0x037e, 0x055d, 0x060c, 0x060d, 0x07f8, 0x1802, 0x1808, 0x2013, 0x2014, 0x3001,
+		0xfe10, 0xfe11, 0xfe13, 0xfe31, 0xfe32, 0xfe50, 0xfe51, 0xfe55, 0xfe58, 0xfe63,
+		0xff0c, 0xff0d, 0xff1a, 0xff1b, 0xff64:
+		return sbSContinue
+	}
+
+	switch graphemeBreakProperty(r) {
+	case gbExtend, gbSpacingMark, gbZWJ:
+		return sbExtend
+	}
+
+	switch {
+	case unicode.Is(unicode.Cf, r):
+		if r == 0x200b || unicode.Is(unicode.Prepended_Concatenation_Mark, r) {
+			return sbOther
+		}
+		return sbFormat
+	case unicode.Is(unicode.White_Space, r):
+		return sbSp
+	case unicode.Is(unicode.Sentence_Terminal, r):
+		return sbSTerm
+	case unicode.IsLower(r) || unicode.Is(unicode.Other_Lowercase, r):
+		return sbLower
+	case unicode.IsUpper(r) || unicode.IsTitle(r) || unicode.Is(unicode.Other_Uppercase, r):
+		return sbUpper
+	case unicode.In(r, unicode.L, unicode.Nl, unicode.Other_Alphabetic):
+		return sbOLetter
+	case unicode.Is(unicode.Nd, r):
+		return sbNumeric
+	case unicode.In(r, unicode.Ps, unicode.Pe, unicode.Pi, unicode.Pf, unicode.Quotation_Mark):
+		return sbClose
+	}
+	return sbOther
+}
+
+// sentenceParaSep returns true for the Sep, CR and LF values
+func sentenceParaSep(sb cSentenceBreak) bool {
+	return sb == sbSep || sb == sbCR || sb == sbLF
+}
+
+// sentencePrev returns the Sentence_Break property of the rune before the
+// index given, ignoring the Extend and Format runes as described by SB5,
+// along with the index of the rune found
+func sentencePrev(input *InputReader, index int) (sb cSentenceBreak, start int, ok bool) {
+	for start = index; ; {
+		var r rune
+		var size int
+		if r, size, ok = input.Prev(start); !ok {
+			return sbOther, start, false
+		}
+		start -= size
+		if sb = sentenceBreakProperty(r); sb != sbExtend && sb != sbFormat {
+			return
+		}
+		// SB5 does not apply after sot, Sep, CR or LF
+		if pr, _, present := input.Prev(start); !present || sentenceParaSep(sentenceBreakProperty(pr)) {
+			return
+		}
+	}
+}
+
+// sentenceLowerAhead returns true if the first rune from the index given which
+// is an OLetter, Upper, Lower, ParaSep or SATerm is a Lower, as used by SB8
+func sentenceLowerAhead(input *InputReader, index int) bool {
+	for {
+		r, size, ok := input.Get(index)
+		if !ok {
+			return false
+		}
+		switch sb := sentenceBreakProperty(r); {
+		case sb == sbLower:
+			return true
+		case sb == sbOLetter || sb == sbUpper || sentenceParaSep(sb) || sb == sbATerm || sb == sbSTerm:
+			return false
+		}
+		index += size
+	}
+}
+
+// sentenceBoundary returns true if the UAX #29 sentence boundary rules allow
+// a break at the index given
+func sentenceBoundary(input *InputReader, index int) bool {
+	nr, _, ok := input.Get(index)
+	if !ok {
+		return true // SB2
+	}
+	pr, _, ok := input.Prev(index)
+	if !ok {
+		return true // SB1
+	}
+
+	prev, next := sentenceBreakProperty(pr), sentenceBreakProperty(nr)
+	switch {
+	case prev == sbCR && next == sbLF: // SB3
+		return false
+	case sentenceParaSep(prev): // SB4
+		return true
+	case next == sbExtend || next == sbFormat: // SB5
+		return false
+	}
+
+	prev, start, _ := sentencePrev(input, index)
+	if prev == sbATerm {
+		if next == sbNumeric { // SB6
+			return false
+		} else if before, _, _ := sentencePrev(input, start); (before == sbUpper || before == sbLower) && next == sbUpper { // SB7
+			return false
+		}
+	}
+
+	// look for the SATerm Close* Sp* context of SB8 through SB11
+	term, idx := prev, start
+	var spaces bool
+	for ; term == sbSp; term, idx, _ = sentencePrev(input, idx) {
+		spaces = true
+	}
+	for ; term == sbClose; term, idx, _ = sentencePrev(input, idx) {
+	}
+	if term != sbATerm && term != sbSTerm {
+		return false // SB998
+	}
+
+	if term == sbATerm && sentenceLowerAhead(input, index) { // SB8
+		return false
+	}
+
+	switch {
+	case next == sbSContinue || next == sbATerm || next == sbSTerm: // SB8a
+		return false
+	case !spaces && (next == sbClose || next == sbSp || sentenceParaSep(next)): // SB9
+		return false
+	case next == sbSp || sentenceParaSep(next): // SB10
+		return false
+	}
+
+	return true // SB11
+}
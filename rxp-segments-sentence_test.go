@@ -0,0 +1,54 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSegmentsSentence(t *testing.T) {
+	c.Convey("SplitSentencesString", t, func() {
+
+		for idx, test := range []struct {
+			input  string
+			output []string
+		}{
+			{"", nil},
+			{"One. Two? Three!", []string{"One. ", "Two? ", "Three!"}},
+			// numbers, lowercase continuation and abbreviations
+			{"It is 3.5 wide. ok", []string{"It is 3.5 wide. ok"}},
+			{"e.g. this one. That one.", []string{"e.g. this one. ", "That one."}},
+			{"U.S. Army", []string{"U.S. ", "Army"}},
+			// closing punctuation and spaces stay with the sentence
+			{"He said \"Hi!\" Then left.", []string{"He said \"Hi!\" ", "Then left."}},
+			{"(Done.)  Next.", []string{"(Done.)  ", "Next."}},
+			// continuation punctuation
+			{"Wait... what?! No, really.", []string{"Wait... what?! ", "No, really."}},
+			// paragraph separators
+			{"First\nSecond\r\nThird", []string{"First\n", "Second\r\n", "Third"}},
+			// full stops of other scripts
+			{"你好。再见。", []string{"你好。", "再见。"}},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), SplitSentencesString(test.input, -1), c.ShouldEqual, test.output)
+		}
+
+		c.So(SplitSentencesString("One. Two. Three.", 2), c.ShouldEqual, []string{"One. ", "Two. Three."})
+		c.So(SplitSentencesBytes([]byte("One. Two."), -1), c.ShouldEqual, [][]byte{[]byte("One. "), []byte("Two.")})
+		c.So(SplitSentencesRunes([]rune("One. Two."), -1), c.ShouldEqual, [][]rune{[]rune("One. "), []rune("Two.")})
+	})
+}
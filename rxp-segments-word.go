@@ -0,0 +1,272 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"unicode"
+)
+
+// cWordBreak is the UAX #29 Word_Break property value
+type cWordBreak uint8
+
+const (
+	wbOther cWordBreak = iota
+	wbCR
+	wbLF
+	wbNewline
+	wbExtend
+	wbZWJ
+	wbRegionalIndicator
+	wbFormat
+	wbKatakana
+	wbHebrewLetter
+	wbALetter
+	wbSingleQuote
+	wbDoubleQuote
+	wbMidNumLet
+	wbMidLetter
+	wbMidNum
+	wbNumeric
+	wbExtendNumLet
+	wbWSegSpace
+)
+
+// gWordComplexContext are the scripts with the Line_Break=Complex_Context
+// property, which are written without spaces and need a dictionary to find
+// the word boundaries, UAX #29 excludes these from ALetter
+var gWordComplexContext = []*unicode.RangeTable{
+	unicode.Thai, unicode.Lao, unicode.Myanmar, unicode.Khmer, unicode.Tai_Le,
+	unicode.New_Tai_Lue, unicode.Tai_Tham, unicode.Tai_Viet, unicode.Ahom,
+}
+
+// gWordALetterExtra are the runes which are ALetter without being Alphabetic
+var gWordALetterExtra = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{0x02c2, 0x02c5, 1}, {0x02d2, 0x02d7, 1}, {0x02de, 0x02df, 1},
+		{0x02ed, 0x02ed, 1}, {0x02ef, 0x02ff, 1}, {0x05f3, 0x05f3, 1},
+		{0xa720, 0xa721, 1}, {0xa789, 0xa78a, 1}, {0xab5b, 0xab5b, 1},
+	},
+}
+
+// wordBreakProperty returns the Word_Break property of r
+func wordBreakProperty(r rune) cWordBreak {
+	if r < 0x80 {
+		switch {
+		case r == '\r':
+			return wbCR
+		case r == '\n':
+			return wbLF
+		case r == '\v' || r == '\f':
+			return wbNewline
+		case r == ' ':
+			return wbWSegSpace
+		case r == '"':
+			return wbDoubleQuote
+		case r == '\'':
+			return wbSingleQuote
+		case r == '.':
+			return wbMidNumLet
+		case r == ':':
+			return wbMidLetter
+		case r == ',' || r == ';':
+			return wbMidNum
+		case r == '_':
+			return wbExtendNumLet
+		case '0' <= r && r <= '9':
+			return wbNumeric
+		case ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z'):
+			return wbALetter
+		}
+		return wbOther
+	}
+
+	switch r {
+	case 0x0085, 0x2028, 0x2029:
+		return wbNewline
+	case 0x200d:
+		return wbZWJ
+	case 0x2018, 0x2019, 0x2024, 0xfe52, 0xff07, 0xff0e:
+		return wbMidNumLet
+	case 0x00b7, 0x0387, 0x055f, 0x05f4, 0x2027, 0xfe13, 0xfe55, 0xff1a:
+		return wbMidLetter
+	case 0x037e, 0x0589, 0x060c, 0x060d, 0x066c, 0x07f8, 0x2044, 0xfe10, 0xfe14, 0xfe50, 0xfe54, 0xff0c, 0xff1b:
+		return wbMidNum
+	case 0x202f:
+		return wbExtendNumLet
+	case 0x3031, 0x3032, 0x3033, 0x3034, 0x3035, 0x309b, 0x309c, 0x30a0, 0x30fc, 0xff70:
+		return wbKatakana
+	}
+
+	switch {
+	case 0x1f1e6 <= r && r <= 0x1f1ff:
+		return wbRegionalIndicator
+	}
+
+	switch graphemeBreakProperty(r) {
+	case gbExtend, gbSpacingMark:
+		return wbExtend
+	}
+
+	switch {
+	case unicode.Is(unicode.Cf, r):
+		if r == 0x200b || unicode.Is(unicode.Prepended_Concatenation_Mark, r) {
+			return wbOther
+		}
+		return wbFormat
+	case unicode.Is(unicode.Katakana, r):
+		return wbKatakana
+	case unicode.Is(unicode.Hebrew, r) && unicode.IsLetter(r):
+		return wbHebrewLetter
+	case unicode.Is(unicode.Nd, r):
+		return wbNumeric
+	case unicode.Is(unicode.Pc, r):
+		return wbExtendNumLet
+	case unicode.Is(unicode.Zs, r):
+		if r == 0x00a0 || r == 0x2007 {
+			return wbOther
+		}
+		return wbWSegSpace
+	case unicode.Is(gWordALetterExtra, r):
+		return wbALetter
+	case unicode.In(r, unicode.L, unicode.Nl, unicode.Other_Alphabetic):
+		if unicode.In(r, unicode.Ideographic, unicode.Hiragana) || unicode.In(r, gWordComplexContext...) {
+			return wbOther
+		}
+		return wbALetter
+	}
+	return wbOther
+}
+
+// wordIgnored returns true for the Word_Break values which WB4 ignores
+func wordIgnored(wb cWordBreak) bool {
+	return wb == wbExtend || wb == wbFormat || wb == wbZWJ
+}
+
+// wordPrev returns the Word_Break property of the rune before the index
+// given, ignoring the Extend, Format and ZWJ runes as described by WB4, along
+// with the index of the rune found
+func wordPrev(input *InputReader, index int) (wb cWordBreak, start int, ok bool) {
+	for start = index; ; {
+		var r rune
+		var size int
+		if r, size, ok = input.Prev(start); !ok {
+			return wbOther, start, false
+		}
+		start -= size
+		if wb = wordBreakProperty(r); !wordIgnored(wb) {
+			return
+		}
+		// WB4 does not apply after sot, CR, LF or Newline
+		if pr, _, present := input.Prev(start); !present {
+			return
+		} else if pb := wordBreakProperty(pr); pb == wbCR || pb == wbLF || pb == wbNewline {
+			return
+		}
+	}
+}
+
+// wordNext returns the Word_Break property of the rune after the one at the
+// index given, ignoring the Extend, Format and ZWJ runes as described by WB4
+func wordNext(input *InputReader, index int) (wb cWordBreak) {
+	_, size, ok := input.Get(index)
+	for index += size; ok; index += size {
+		var r rune
+		if r, size, ok = input.Get(index); ok {
+			if wb = wordBreakProperty(r); !wordIgnored(wb) {
+				return
+			}
+		}
+	}
+	return wbOther
+}
+
+// wordBoundary returns true if the UAX #29 word boundary rules allow a break
+// at the index given
+func wordBoundary(input *InputReader, index int) bool {
+	nr, _, ok := input.Get(index)
+	if !ok {
+		return true // WB2
+	}
+	pr, _, ok := input.Prev(index)
+	if !ok {
+		return true // WB1
+	}
+
+	prev, next := wordBreakProperty(pr), wordBreakProperty(nr)
+	switch {
+	case prev == wbCR && next == wbLF: // WB3
+		return false
+	case prev == wbCR || prev == wbLF || prev == wbNewline: // WB3a
+		return true
+	case next == wbCR || next == wbLF || next == wbNewline: // WB3b
+		return true
+	case prev == wbZWJ && RuneIsExtendedPictographic(nr): // WB3c
+		return false
+	case prev == wbWSegSpace && next == wbWSegSpace: // WB3d
+		return false
+	case wordIgnored(next): // WB4
+		return false
+	}
+
+	prev, start, _ := wordPrev(input, index)
+	before, _, _ := wordPrev(input, start)
+	after := wordNext(input, index)
+
+	isAHLetter := func(wb cWordBreak) bool {
+		return wb == wbALetter || wb == wbHebrewLetter
+	}
+	isMidNumLetQ := func(wb cWordBreak) bool {
+		return wb == wbMidNumLet || wb == wbSingleQuote
+	}
+
+	switch {
+	case isAHLetter(prev) && isAHLetter(next): // WB5
+		return false
+	case isAHLetter(prev) && (next == wbMidLetter || isMidNumLetQ(next)) && isAHLetter(after): // WB6
+		return false
+	case isAHLetter(before) && (prev == wbMidLetter || isMidNumLetQ(prev)) && isAHLetter(next): // WB7
+		return false
+	case prev == wbHebrewLetter && next == wbSingleQuote: // WB7a
+		return false
+	case prev == wbHebrewLetter && next == wbDoubleQuote && after == wbHebrewLetter: // WB7b
+		return false
+	case before == wbHebrewLetter && prev == wbDoubleQuote && next == wbHebrewLetter: // WB7c
+		return false
+	case prev == wbNumeric && next == wbNumeric: // WB8
+		return false
+	case isAHLetter(prev) && next == wbNumeric: // WB9
+		return false
+	case prev == wbNumeric && isAHLetter(next): // WB10
+		return false
+	case before == wbNumeric && (prev == wbMidNum || isMidNumLetQ(prev)) && next == wbNumeric: // WB11
+		return false
+	case prev == wbNumeric && (next == wbMidNum || isMidNumLetQ(next)) && after == wbNumeric: // WB12
+		return false
+	case prev == wbKatakana && next == wbKatakana: // WB13
+		return false
+	case (isAHLetter(prev) || prev == wbNumeric || prev == wbKatakana || prev == wbExtendNumLet) && next == wbExtendNumLet: // WB13a
+		return false
+	case prev == wbExtendNumLet && (isAHLetter(next) || next == wbNumeric || next == wbKatakana): // WB13b
+		return false
+	case prev == wbRegionalIndicator && next == wbRegionalIndicator: // WB15, WB16
+		regional := 1
+		for wb, idx, present := wordPrev(input, start); present && wb == wbRegionalIndicator; wb, idx, present = wordPrev(input, idx) {
+			regional += 1
+		}
+		return regional%2 == 0
+	}
+
+	return true // WB999
+}
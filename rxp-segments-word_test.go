@@ -0,0 +1,79 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSegmentsWord(t *testing.T) {
+	c.Convey("SplitWordsString", t, func() {
+
+		for idx, test := range []struct {
+			input  string
+			output []string
+		}{
+			{"", nil},
+			{"The quick (\"brown\") fox can't jump 32.3 feet, right?", []string{"The", " ", "quick", " ", "(", "\"", "brown", "\"", ")", " ", "fox", " ", "can't", " ", "jump", " ", "32.3", " ", "feet", ",", " ", "right", "?"}},
+			// numbers with separators, letters and numbers, connectors
+			{"1,000.50 a1 snake_case e.g", []string{"1,000.50", " ", "a1", " ", "snake_case", " ", "e.g"}},
+			// trailing separators are not part of the word
+			{"end. 3,", []string{"end", ".", " ", "3", ","}},
+			// ideographs are each a word, katakana runs are one word
+			{"中文 カタカナ ひら", []string{"中", "文", " ", "カタカナ", " ", "ひ", "ら"}},
+			// combining marks, emoji sequences and flags stay whole
+			{"cafe\u0301 \U0001F468\u200d\U0001F469\u200d\U0001F467 \U0001F1EF\U0001F1F5\U0001F1FA\U0001F1F8", []string{"cafe\u0301", " ", "\U0001F468\u200d\U0001F469\u200d\U0001F467", " ", "\U0001F1EF\U0001F1F5", "\U0001F1FA\U0001F1F8"}},
+			// hebrew letters with gershayim
+			{"שה״ח", []string{"שה״ח"}},
+			// spaces and newlines
+			{"a  b\r\nc", []string{"a", "  ", "b", "\r\n", "c"}},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), SplitWordsString(test.input, -1), c.ShouldEqual, test.output)
+		}
+
+		c.So(SplitWordsString("one two three", 0), c.ShouldBeNil)
+		c.So(SplitWordsString("one two three", 2), c.ShouldEqual, []string{"one", " two three"})
+		c.So(SplitWordsBytes([]byte("can't stop"), -1), c.ShouldEqual, [][]byte{[]byte("can't"), []byte(" "), []byte("stop")})
+		c.So(SplitWordsRunes([]rune("can't stop"), -1), c.ShouldEqual, [][]rune{[]rune("can't"), []rune(" "), []rune("stop")})
+	})
+
+	c.Convey("wordBreakProperty", t, func() {
+		for idx, test := range []struct {
+			input  rune
+			output cWordBreak
+		}{
+			{'a', wbALetter},
+			{'é', wbALetter},
+			{'가', wbALetter},
+			{'中', wbOther},
+			{'ก', wbOther},
+			{'カ', wbKatakana},
+			{'ー', wbKatakana},
+			{'א', wbHebrewLetter},
+			{'٣', wbNumeric},
+			{'\u0301', wbExtend},
+			{'\u00ad', wbFormat},
+			{'’', wbMidNumLet},
+			{'\u00a0', wbOther},
+			{'\u3000', wbWSegSpace},
+			{'\u2028', wbNewline},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), wordBreakProperty(test.input), c.ShouldEqual, test.output)
+		}
+	})
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+// segmentIndexes returns the start and end indexes of the segments of the
+// input between each of the boundaries given, the count works the same as
+// with Pattern.SplitString
+func segmentIndexes(input *InputReader, count int, boundary func(input *InputReader, index int) bool) (segments [][2]int) {
+	if count == 0 {
+		return
+	}
+	var start int
+	for index := 0; index < input.len; {
+		_, size, _ := input.Get(index)
+		if index += size; index < input.len && !boundary(input, index) {
+			continue
+		}
+		if count > 0 && len(segments) == count-1 {
+			return append(segments, [2]int{start, input.len})
+		}
+		segments = append(segments, [2]int{start, index})
+		start = index
+	}
+	return
+}
+
+// SplitWordsString breaks the input into the words, spaces and punctuation
+// found between the WordBoundaryUAX boundaries, the count works the same as
+// with Pattern.SplitString and an empty input returns zero words
+func SplitWordsString(input string, count int) (found []string) {
+	for _, segment := range segmentIndexes(NewInputReader(input), count, wordBoundary) {
+		found = append(found, input[segment[0]:segment[1]])
+	}
+	return
+}
+
+// SplitWordsBytes is the []byte version of SplitWordsString
+func SplitWordsBytes(input []byte, count int) (found [][]byte) {
+	for _, segment := range segmentIndexes(NewInputReader(input), count, wordBoundary) {
+		found = append(found, input[segment[0]:segment[1]])
+	}
+	return
+}
+
+// SplitWordsRunes is the []rune version of SplitWordsString
+func SplitWordsRunes(input []rune, count int) (found [][]rune) {
+	for _, segment := range segmentIndexes(NewInputReader(input), count, wordBoundary) {
+		found = append(found, input[segment[0]:segment[1]])
+	}
+	return
+}
+
+// SplitSentencesString breaks the input into the sentences found between the
+// SentenceBoundary boundaries, the count works the same as with
+// Pattern.SplitString and an empty input returns zero sentences
+func SplitSentencesString(input string, count int) (found []string) {
+	for _, segment := range segmentIndexes(NewInputReader(input), count, sentenceBoundary) {
+		found = append(found, input[segment[0]:segment[1]])
+	}
+	return
+}
+
+// SplitSentencesBytes is the []byte version of SplitSentencesString
+func SplitSentencesBytes(input []byte, count int) (found [][]byte) {
+	for _, segment := range segmentIndexes(NewInputReader(input), count, sentenceBoundary) {
+		found = append(found, input[segment[0]:segment[1]])
+	}
+	return
+}
+
+// SplitSentencesRunes is the []rune version of SplitSentencesString
+func SplitSentencesRunes(input []rune, count int) (found [][]rune) {
+	for _, segment := range segmentIndexes(NewInputReader(input), count, sentenceBoundary) {
+		found = append(found, input[segment[0]:segment[1]])
+	}
+	return
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+// gHalfwidthForms maps the halfwidth and fullwidth forms of the Unicode
+// Halfwidth and Fullwidth Forms block, other than the fullwidth ASCII, to
+// their compatibility decomposition, derived from the Unicode Character
+// Database
+var gHalfwidthForms = map[rune]rune{
+	0xff5f: 0x2985, // FULLWIDTH LEFT WHITE PARENTHESIS
+	0xff60: 0x2986, // FULLWIDTH RIGHT WHITE PARENTHESIS
+	0xff61: 0x3002, // HALFWIDTH IDEOGRAPHIC FULL STOP
+	0xff62: 0x300c, // HALFWIDTH LEFT CORNER BRACKET
+	0xff63: 0x300d, // HALFWIDTH RIGHT CORNER BRACKET
+	0xff64: 0x3001, // HALFWIDTH IDEOGRAPHIC COMMA
+	0xff65: 0x30fb, // HALFWIDTH KATAKANA MIDDLE DOT
+	0xff66: 0x30f2, // HALFWIDTH KATAKANA LETTER WO
+	0xff67: 0x30a1, // HALFWIDTH KATAKANA LETTER SMALL A
+	0xff68: 0x30a3, // HALFWIDTH KATAKANA LETTER SMALL I
+	0xff69: 0x30a5, // HALFWIDTH KATAKANA LETTER SMALL U
+	0xff6a: 0x30a7, // HALFWIDTH KATAKANA LETTER SMALL E
+	0xff6b: 0x30a9, // HALFWIDTH KATAKANA LETTER SMALL O
+	0xff6c: 0x30e3, // HALFWIDTH KATAKANA LETTER SMALL YA
+	0xff6d: 0x30e5, // HALFWIDTH KATAKANA LETTER SMALL YU
+	0xff6e: 0x30e7, // HALFWIDTH KATAKANA LETTER SMALL YO
+	0xff6f: 0x30c3, // HALFWIDTH KATAKANA LETTER SMALL TU
+	0xff70: 0x30fc, // HALFWIDTH KATAKANA-HIRAGANA PROLONGED SOUND MARK
+	0xff71: 0x30a2, // HALFWIDTH KATAKANA LETTER A
+	0xff72: 0x30a4, // HALFWIDTH KATAKANA LETTER I
+	0xff73: 0x30a6, // HALFWIDTH KATAKANA LETTER U
+	0xff74: 0x30a8, // HALFWIDTH KATAKANA LETTER E
+	0xff75: 0x30aa, // HALFWIDTH KATAKANA LETTER O
+	0xff76: 0x30ab, // HALFWIDTH KATAKANA LETTER KA
+	0xff77: 0x30ad, // HALFWIDTH KATAKANA LETTER KI
+	0xff78: 0x30af, // HALFWIDTH KATAKANA LETTER KU
+	0xff79: 0x30b1, // HALFWIDTH KATAKANA LETTER KE
+	0xff7a: 0x30b3, // HALFWIDTH KATAKANA LETTER KO
+	0xff7b: 0x30b5, // HALFWIDTH KATAKANA LETTER SA
+	0xff7c: 0x30b7, // HALFWIDTH KATAKANA LETTER SI
+	0xff7d: 0x30b9, // HALFWIDTH KATAKANA LETTER SU
+	0xff7e: 0x30bb, // HALFWIDTH KATAKANA LETTER SE
+	0xff7f: 0x30bd, // HALFWIDTH KATAKANA LETTER SO
+	0xff80: 0x30bf, // HALFWIDTH KATAKANA LETTER TA
+	0xff81: 0x30c1, // HALFWIDTH KATAKANA LETTER TI
+	0xff82: 0x30c4, // HALFWIDTH KATAKANA LETTER TU
+	0xff83: 0x30c6, // HALFWIDTH KATAKANA LETTER TE
+	0xff84: 0x30c8, // HALFWIDTH KATAKANA LETTER TO
+	0xff85: 0x30ca, // HALFWIDTH KATAKANA LETTER NA
+	0xff86: 0x30cb, // HALFWIDTH KATAKANA LETTER NI
+	0xff87: 0x30cc, // HALFWIDTH KATAKANA LETTER NU
+	0xff88: 0x30cd, // HALFWIDTH KATAKANA LETTER NE
+	0xff89: 0x30ce, // HALFWIDTH KATAKANA LETTER NO
+	0xff8a: 0x30cf, // HALFWIDTH KATAKANA LETTER HA
+	0xff8b: 0x30d2, // HALFWIDTH KATAKANA LETTER HI
+	0xff8c: 0x30d5, // HALFWIDTH KATAKANA LETTER HU
+	0xff8d: 0x30d8, // HALFWIDTH KATAKANA LETTER HE
+	0xff8e: 0x30db, // HALFWIDTH KATAKANA LETTER HO
+	0xff8f: 0x30de, // HALFWIDTH KATAKANA LETTER MA
+	0xff90: 0x30df, // HALFWIDTH KATAKANA LETTER MI
+	0xff91: 0x30e0, // HALFWIDTH KATAKANA LETTER MU
+	0xff92: 0x30e1, // HALFWIDTH KATAKANA LETTER ME
+	0xff93: 0x30e2, // HALFWIDTH KATAKANA LETTER MO
+	0xff94: 0x30e4, // HALFWIDTH KATAKANA LETTER YA
+	0xff95: 0x30e6, // HALFWIDTH KATAKANA LETTER YU
+	0xff96: 0x30e8, // HALFWIDTH KATAKANA LETTER YO
+	0xff97: 0x30e9, // HALFWIDTH KATAKANA LETTER RA
+	0xff98: 0x30ea, // HALFWIDTH KATAKANA LETTER RI
+	0xff99: 0x30eb, // HALFWIDTH KATAKANA LETTER RU
+	0xff9a: 0x30ec, // HALFWIDTH KATAKANA LETTER RE
+	0xff9b: 0x30ed, // HALFWIDTH KATAKANA LETTER RO
+	0xff9c: 0x30ef, // HALFWIDTH KATAKANA LETTER WA
+	0xff9d: 0x30f3, // HALFWIDTH KATAKANA LETTER N
+	0xff9e: 0x3099, // HALFWIDTH KATAKANA VOICED SOUND MARK
+	0xff9f: 0x309a, // HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK
+	0xffa0: 0x1160, // HALFWIDTH HANGUL FILLER
+	0xffa1: 0x1100, // HALFWIDTH HANGUL LETTER KIYEOK
+	0xffa2: 0x1101, // HALFWIDTH HANGUL LETTER SSANGKIYEOK
+	0xffa3: 0x11aa, // HALFWIDTH HANGUL LETTER KIYEOK-SIOS
+	0xffa4: 0x1102, // HALFWIDTH HANGUL LETTER NIEUN
+	0xffa5: 0x11ac, // HALFWIDTH HANGUL LETTER NIEUN-CIEUC
+	0xffa6: 0x11ad, // HALFWIDTH HANGUL LETTER NIEUN-HIEUH
+	0xffa7: 0x1103, // HALFWIDTH HANGUL LETTER TIKEUT
+	0xffa8: 0x1104, // HALFWIDTH HANGUL LETTER SSANGTIKEUT
+	0xffa9: 0x1105, // HALFWIDTH HANGUL LETTER RIEUL
+	0xffaa: 0x11b0, // HALFWIDTH HANGUL LETTER RIEUL-KIYEOK
+	0xffab: 0x11b1, // HALFWIDTH HANGUL LETTER RIEUL-MIEUM
+	0xffac: 0x11b2, // HALFWIDTH HANGUL LETTER RIEUL-PIEUP
+	0xffad: 0x11b3, // HALFWIDTH HANGUL LETTER RIEUL-SIOS
+	0xffae: 0x11b4, // HALFWIDTH HANGUL LETTER RIEUL-THIEUTH
+	0xffaf: 0x11b5, // HALFWIDTH HANGUL LETTER RIEUL-PHIEUPH
+	0xffb0: 0x111a, // HALFWIDTH HANGUL LETTER RIEUL-HIEUH
+	0xffb1: 0x1106, // HALFWIDTH HANGUL LETTER MIEUM
+	0xffb2: 0x1107, // HALFWIDTH HANGUL LETTER PIEUP
+	0xffb3: 0x1108, // HALFWIDTH HANGUL LETTER SSANGPIEUP
+	0xffb4: 0x1121, // HALFWIDTH HANGUL LETTER PIEUP-SIOS
+	0xffb5: 0x1109, // HALFWIDTH HANGUL LETTER SIOS
+	0xffb6: 0x110a, // HALFWIDTH HANGUL LETTER SSANGSIOS
+	0xffb7: 0x110b, // HALFWIDTH HANGUL LETTER IEUNG
+	0xffb8: 0x110c, // HALFWIDTH HANGUL LETTER CIEUC
+	0xffb9: 0x110d, // HALFWIDTH HANGUL LETTER SSANGCIEUC
+	0xffba: 0x110e, // HALFWIDTH HANGUL LETTER CHIEUCH
+	0xffbb: 0x110f, // HALFWIDTH HANGUL LETTER KHIEUKH
+	0xffbc: 0x1110, // HALFWIDTH HANGUL LETTER THIEUTH
+	0xffbd: 0x1111, // HALFWIDTH HANGUL LETTER PHIEUPH
+	0xffbe: 0x1112, // HALFWIDTH HANGUL LETTER HIEUH
+	0xffc2: 0x1161, // HALFWIDTH HANGUL LETTER A
+	0xffc3: 0x1162, // HALFWIDTH HANGUL LETTER AE
+	0xffc4: 0x1163, // HALFWIDTH HANGUL LETTER YA
+	0xffc5: 0x1164, // HALFWIDTH HANGUL LETTER YAE
+	0xffc6: 0x1165, // HALFWIDTH HANGUL LETTER EO
+	0xffc7: 0x1166, // HALFWIDTH HANGUL LETTER E
+	0xffca: 0x1167, // HALFWIDTH HANGUL LETTER YEO
+	0xffcb: 0x1168, // HALFWIDTH HANGUL LETTER YE
+	0xffcc: 0x1169, // HALFWIDTH HANGUL LETTER O
+	0xffcd: 0x116a, // HALFWIDTH HANGUL LETTER WA
+	0xffce: 0x116b, // HALFWIDTH HANGUL LETTER WAE
+	0xffcf: 0x116c, // HALFWIDTH HANGUL LETTER OE
+	0xffd2: 0x116d, // HALFWIDTH HANGUL LETTER YO
+	0xffd3: 0x116e, // HALFWIDTH HANGUL LETTER U
+	0xffd4: 0x116f, // HALFWIDTH HANGUL LETTER WEO
+	0xffd5: 0x1170, // HALFWIDTH HANGUL LETTER WE
+	0xffd6: 0x1171, // HALFWIDTH HANGUL LETTER WI
+	0xffd7: 0x1172, // HALFWIDTH HANGUL LETTER YU
+	0xffda: 0x1173, // HALFWIDTH HANGUL LETTER EU
+	0xffdb: 0x1174, // HALFWIDTH HANGUL LETTER YI
+	0xffdc: 0x1175, // HALFWIDTH HANGUL LETTER I
+	0xffe0: 0x00a2, // FULLWIDTH CENT SIGN
+	0xffe1: 0x00a3, // FULLWIDTH POUND SIGN
+	0xffe2: 0x00ac, // FULLWIDTH NOT SIGN
+	0xffe3: 0x00af, // FULLWIDTH MACRON
+	0xffe4: 0x00a6, // FULLWIDTH BROKEN BAR
+	0xffe5: 0x00a5, // FULLWIDTH YEN SIGN
+	0xffe6: 0x20a9, // FULLWIDTH WON SIGN
+	0xffe8: 0x2502, // HALFWIDTH FORMS LIGHT VERTICAL
+	0xffe9: 0x2190, // HALFWIDTH LEFTWARDS ARROW
+	0xffea: 0x2191, // HALFWIDTH UPWARDS ARROW
+	0xffeb: 0x2192, // HALFWIDTH RIGHTWARDS ARROW
+	0xffec: 0x2193, // HALFWIDTH DOWNWARDS ARROW
+	0xffed: 0x25a0, // HALFWIDTH BLACK SQUARE
+	0xffee: 0x25cb, // HALFWIDTH WHITE CIRCLE
+}
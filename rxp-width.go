@@ -0,0 +1,114 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"sync"
+)
+
+// gWidthFlags are the Flags which change how the text matchers compare the
+// width and kana variants of runes
+const gWidthFlags = WidthFlag | KanaFlag
+
+// gHalfwidthFormsReverse is the reverse lookup of gHalfwidthForms, built on
+// first use
+var gHalfwidthFormsReverse = sync.OnceValue(func() map[rune]rune {
+	reverse := make(map[rune]rune, len(gHalfwidthForms))
+	for form, r := range gHalfwidthForms {
+		reverse[r] = form
+	}
+	return reverse
+})
+
+// widthFold returns the narrow form of the fullwidth ASCII and the wide form
+// of the halfwidth runes when the scope has WidthFlag set, and the katakana
+// of the hiragana runes when the scope has KanaFlag set
+func widthFold(r rune, scope Flags) rune {
+	if r < 0x3000 {
+		return r
+	}
+	if scope&WidthFlag == WidthFlag {
+		switch {
+		case r == 0x3000:
+			return ' '
+		case 0xff01 <= r && r <= 0xff5e:
+			return r - 0xfee0
+		case 0xff5f <= r && r <= 0xffee:
+			if wide, ok := gHalfwidthForms[r]; ok {
+				r = wide
+			}
+		}
+	}
+	if scope&KanaFlag == KanaFlag {
+		if (0x3041 <= r && r <= 0x3096) || r == 0x309d || r == 0x309e {
+			return r + 0x60
+		}
+	}
+	return r
+}
+
+// appendWidthKey appends the width folding of r to the key given, with the
+// voiced kana decomposed so that the halfwidth katakana followed by a
+// halfwidth voiced sound mark compare equal with their wide forms
+func appendWidthKey(key []rune, r rune, scope Flags) []rune {
+	if r = widthFold(r, scope); 0x3040 <= r && r <= 0x30ff {
+		if decomposed, ok := gCanonicalDecomposition[r]; ok {
+			for _, d := range decomposed {
+				key = append(key, widthFold(d, scope))
+			}
+			return key
+		}
+	}
+	return append(key, r)
+}
+
+// widthForms appends the width folding of r to dst, along with the other
+// width and kana forms equivalent to it for the Flags in the scope given
+func widthForms(dst []rune, r rune, scope Flags) []rune {
+	folded := widthFold(r, scope)
+	dst = append(dst, folded)
+	if scope&WidthFlag == WidthFlag {
+		switch {
+		case 0x21 <= folded && folded <= 0x7e:
+			dst = append(dst, folded+0xfee0)
+		case folded == ' ':
+			dst = append(dst, 0x3000)
+		default:
+			if form, ok := gHalfwidthFormsReverse()[folded]; ok {
+				dst = append(dst, form)
+			}
+		}
+	}
+	if scope&KanaFlag == KanaFlag {
+		if (0x30a1 <= folded && folded <= 0x30f6) || folded == 0x30fd || folded == 0x30fe {
+			dst = append(dst, folded-0x60)
+		}
+	}
+	return dst
+}
+
+// widthVoiced returns the composition of the halfwidth katakana r with the
+// halfwidth voiced or semi-voiced sound mark following it in the input, when
+// the scope has WidthFlag set
+func widthVoiced(r rune, scope Flags, input *InputReader, index int) (composed rune, size int, ok bool) {
+	if scope&WidthFlag == WidthFlag && 0xff66 <= r && r <= 0xff9d {
+		if next, ns, present := input.Get(index); present && (next == 0xff9e || next == 0xff9f) {
+			if composed, ok = composePair(widthFold(r, scope), widthFold(next, scope)); ok {
+				return composed, ns, true
+			}
+		}
+	}
+	return r, 0, false
+}
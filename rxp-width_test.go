@@ -0,0 +1,56 @@
+// Copyright (c) 2024  The Go-CoreLibs Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rxp
+
+import (
+	"fmt"
+	"testing"
+
+	c "github.com/smartystreets/goconvey/convey"
+)
+
+func TestWidth(t *testing.T) {
+	c.Convey("widthFold", t, func() {
+		for idx, test := range []struct {
+			input  rune
+			scope  Flags
+			output rune
+		}{
+			{'Ａ', WidthFlag, 'A'},
+			{'Ａ', KanaFlag, 'Ａ'},
+			{'　', WidthFlag, ' '},
+			{'ｶ', WidthFlag, 'カ'},
+			{'\uff9e', WidthFlag, '\u3099'},
+			{'￥', WidthFlag, '¥'},
+			{'か', WidthFlag, 'か'},
+			{'か', KanaFlag, 'カ'},
+			{'カ', KanaFlag, 'カ'},
+		} {
+			c.SoMsg(fmt.Sprintf("test #%d - %q", idx, test.input), widthFold(test.input, test.scope), c.ShouldEqual, test.output)
+		}
+	})
+
+	c.Convey("widthForms", t, func() {
+		c.So(string(widthForms(nil, 'a', WidthFlag)), c.ShouldEqual, "aａ")
+		c.So(string(widthForms(nil, 'ａ', WidthFlag)), c.ShouldEqual, "aａ")
+		c.So(string(widthForms(nil, 'カ', WidthFlag)), c.ShouldEqual, "カｶ")
+		c.So(string(widthForms(nil, 'か', WidthFlag|KanaFlag)), c.ShouldEqual, "カｶか")
+	})
+
+	c.Convey("appendWidthKey", t, func() {
+		c.So(string(appendWidthKey(nil, '\u30ac', WidthFlag)), c.ShouldEqual, "\u30ab\u3099")
+		c.So(string(appendWidthKey(nil, '\u304c', KanaFlag)), c.ShouldEqual, "\u30ab\u3099")
+	})
+}
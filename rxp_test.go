@@ -108,3 +108,210 @@ func Benchmark_Replace_ToUpper_Rxp(b *testing.B) {
 			ReplaceAllString(gTestDataRandomString, Replace[string]{}.ToUpper())
 	}
 }
+
+// gBenchmarkClassSpec is an email address local-part class, large enough for
+// the linear class checks to be noticeably slower
+const gBenchmarkClassSpec = "a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-"
+
+// baselineWrapMatcher is the original WrapMatcher implementation, without
+// any of the Unicode, folding or grapheme support
+func baselineWrapMatcher(matcher RuneMatcher, flags ...string) Matcher {
+	return MakeMatcher(func(scope Flags, reps Reps, input *InputReader, index int, sm [][2]int) (scoped Flags, consumed int, proceed bool) {
+		scoped = scope
+		if 0 <= index && index < input.len {
+			r, size, _ := input.Get(index)
+			if proceed = matcher(r); scoped&NegatedFlag == NegatedFlag {
+				proceed = !proceed
+			}
+			if proceed {
+				consumed += size
+			}
+		}
+		return
+	}, flags...)
+}
+
+// baselineR is the original R implementation
+func baselineR(characters string, flags ...string) Matcher {
+	return baselineWrapMatcher(baselineClassMatcher(characters), flags...)
+}
+
+// baselineClassMatcher is the RuneMatcher of the original R implementation,
+// which checks each of the ranges and then each of the runes on every call
+func baselineClassMatcher(characters string) RuneMatcher {
+	var runes []rune
+	var ranges [][]rune
+	chars := []rune(characters)
+	charsLen := len(chars)
+
+	for idx := 0; idx < charsLen; idx++ {
+		this := chars[idx]
+		if idx == 0 && this == '-' {
+			runes = append(runes, this)
+			continue
+		}
+		if idx+2 < charsLen && chars[idx+1] == '-' {
+			if chars[idx] > chars[idx+2] {
+				ranges = append(ranges, []rune{chars[idx+2], chars[idx]})
+			} else {
+				ranges = append(ranges, []rune{chars[idx], chars[idx+2]})
+			}
+			idx += 2
+			continue
+		}
+		runes = append(runes, this)
+	}
+
+	hasRunes, hasRanges := len(runes) > 0, len(ranges) > 0
+	return func(r rune) bool {
+		if hasRanges {
+			for _, check := range ranges {
+				if check[0] <= r && r <= check[1] {
+					return true
+				}
+			}
+		}
+		if hasRunes {
+			for _, check := range runes {
+				if check == r {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// the RuneMatcher benchmarks measure only the class checks while the Matcher
+// benchmarks include the Pattern and Matcher loops, counting the matches
+// because FindAllString spends most of its time making the matched strings,
+// which costs the same for both implementations
+
+func Benchmark_R_RuneMatcher_Baseline(b *testing.B) {
+	matcher := baselineClassMatcher(gBenchmarkClassSpec)
+	input := []rune(gTestDataRandomString)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, r := range input {
+			_ = matcher(r)
+		}
+	}
+}
+
+func Benchmark_R_RuneMatcher_Compiled(b *testing.B) {
+	class, _ := ParseClass(gBenchmarkClassSpec)
+	matcher := compileRuneClass(class).contains
+	input := []rune(gTestDataRandomString)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, r := range input {
+			_ = matcher(r)
+		}
+	}
+}
+
+func Benchmark_R_Class_Baseline(b *testing.B) {
+	m := baselineR(gBenchmarkClassSpec, "+", "c")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Pattern{m}.CountString(gTestDataRandomString, -1)
+	}
+}
+
+func Benchmark_R_Class_Compiled(b *testing.B) {
+	m := R(gBenchmarkClassSpec, "+", "c")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Pattern{m}.CountString(gTestDataRandomString, -1)
+	}
+}
+
+func Benchmark_R_Unicode_Baseline(b *testing.B) {
+	m := baselineR("α-ωΑ-Ωа-яА-Я一-龥", "+", "c")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Pattern{m}.CountString(gTestDataRandomString, -1)
+	}
+}
+
+func Benchmark_R_Unicode_Compiled(b *testing.B) {
+	m := R("α-ωΑ-Ωа-яА-Я一-龥", "+", "c")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Pattern{m}.CountString(gTestDataRandomString, -1)
+	}
+}
+
+func Benchmark_Punct_Baseline(b *testing.B) {
+	m := baselineWrapMatcher(RuneIsPUNCT, "+", "c")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Pattern{m}.CountString(gTestDataRandomString, -1)
+	}
+}
+
+func Benchmark_Punct_Compiled(b *testing.B) {
+	m := Punct("+", "c")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Pattern{m}.CountString(gTestDataRandomString, -1)
+	}
+}
+
+// gBenchmarkLiterals are the distinct words of the test data, for comparing
+// Literals with the equivalent Or of Text matchers
+var gBenchmarkLiterals = func() (words []string) {
+	seen := map[string]struct{}{}
+	for _, word := range strings.Fields(gTestDataRandomString) {
+		if _, present := seen[word]; !present && len(words) < 1000 {
+			seen[word] = struct{}{}
+			words = append(words, word)
+		}
+	}
+	return
+}()
+
+func Benchmark_Literals_Or(b *testing.B) {
+	var options []interface{}
+	for _, word := range gBenchmarkLiterals {
+		options = append(options, Text(word))
+	}
+	_ = Pattern{Or(append(options, "c")...)}.FindAllString(gTestDataRandomString, -1)
+}
+
+func Benchmark_Literals_Trie(b *testing.B) {
+	_ = Pattern{Literals(gBenchmarkLiterals, "c")}.FindAllString(gTestDataRandomString, -1)
+}
+
+func Benchmark_Count_FindAll(b *testing.B) {
+	p := Pattern{IsFieldWord("c")}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = len(p.FindAllStringIndex(gTestDataRandomString, -1))
+	}
+}
+
+func Benchmark_Count_Count(b *testing.B) {
+	p := Pattern{IsFieldWord("c")}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = p.CountString(gTestDataRandomString, -1)
+	}
+}
+
+func Benchmark_FindLast_FindAll(b *testing.B) {
+	p := Pattern{IsFieldWord("c")}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		all := p.FindAllStringIndex(gTestDataRandomString, -1)
+		_ = all[len(all)-1]
+	}
+}
+
+func Benchmark_FindLast_FindLast(b *testing.B) {
+	p := Pattern{IsFieldWord("c")}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = p.FindLastStringIndex(gTestDataRandomString)
+	}
+}